@@ -46,9 +46,8 @@ func explode(s []byte, n int) [][]byte {
 	return a[0:na]
 }
 
-// Count counts the number of non-overlapping instances of sep in s.
-// If sep is an empty slice, Count returns 1 + the number of Unicode code points in s.
-func Count(s, sep []byte) int {
+// countGeneric actually implements Count
+func countGeneric(s, sep []byte) int {
 	n := len(sep)
 	if n == 0 {
 		return utf8.RuneCount(s) + 1
@@ -311,6 +311,28 @@ func TestIndexByteBig(t *testing.T) {
 	}
 }
 
+// test counting a single byte with different alignments and lengths,
+// surrounded by bytes that differ from it in one bit
+func TestCountByte(t *testing.T) {
+	b := make([]byte, 256)
+	for i := range b {
+		b[i] = "x\x00y\xf8xzx\x79"[i%8]
+	}
+	for i := 0; i < 16; i++ {
+		for j := i; j <= len(b); j++ {
+			want := 0
+			for _, c := range b[i:j] {
+				if c == 'x' {
+					want++
+				}
+			}
+			if n := Count(b[i:j], []byte{'x'}); n != want {
+				t.Errorf("Count(%q, \"x\") = %d, want %d", b[i:j], n, want)
+			}
+		}
+	}
+}
+
 // test a small index across all page offsets
 func TestIndexByteSmall(t *testing.T) {
 	b := make([]byte, 5015) // bigger than a page
@@ -504,6 +526,22 @@ func BenchmarkEqual(b *testing.B) {
 	benchBytes(b, sizes, bmEqual(Equal))
 }
 
+func BenchmarkEqualUnaligned(b *testing.B) {
+	sizes := []int{16, 32, 4 << 10, 4 << 20}
+	benchBytes(b, sizes, func(b *testing.B, n int) {
+		if len(bmbuf) < 2*n+1 {
+			bmbuf = make([]byte, 2*n+1)
+		}
+		buf1 := bmbuf[0:n]
+		buf2 := bmbuf[n+1 : 2*n+1]
+		for i := 0; i < b.N; i++ {
+			if !Equal(buf1, buf2) {
+				b.Fatal("bad equal")
+			}
+		}
+	})
+}
+
 func BenchmarkEqualPort(b *testing.B) {
 	sizes := []int{1, 6, 32, 4 << 10, 4 << 20, 64 << 20}
 	benchBytes(b, sizes, bmEqual(EqualPortable))
@@ -589,6 +627,26 @@ func BenchmarkCountEasy(b *testing.B) {
 	})
 }
 
+func BenchmarkCountSingle(b *testing.B) {
+	benchBytes(b, indexSizes, func(b *testing.B, n int) {
+		buf := bmbuf[0:n]
+		step := 8
+		for i := 0; i < len(buf); i += step {
+			buf[i] = 1
+		}
+		expect := (len(buf) + (step - 1)) / step
+		for i := 0; i < b.N; i++ {
+			j := Count(buf, []byte{1})
+			if j != expect {
+				b.Fatal("bad count", j, expect)
+			}
+		}
+		for i := 0; i < len(buf); i++ {
+			buf[i] = 0
+		}
+	})
+}
+
 type ExplodeTest struct {
 	s string
 	n int
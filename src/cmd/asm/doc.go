@@ -16,6 +16,8 @@ Usage:
 The specified file must be a Go assembly file.
 The same assembler is used for all target operating systems and architectures.
 The GOOS and GOARCH environment variables set the desired target.
+On riscv and riscv32, each extension listed in $GORISCV is also
+predefined as a symbol, for example GORISCV_b.
 
 Flags:
 
@@ -31,6 +33,9 @@ Flags:
 		support references to Go symbols defined in other shared libraries
 	-o string
 		output file; default foo.o for /a/b/c/foo.s
+	-rvc
+		emit compressed instructions where possible (riscv only);
+		default true if $GORISCV includes c
 	-shared
 		generate code that can be linked into a shared library
 	-trimpath string
@@ -86,6 +86,10 @@ func Set(GOARCH string) *Arch {
 		return a
 	case "riscv":
 		return archRiscv()
+	case "riscv32":
+		a := archRiscv()
+		a.LinkArch = &riscv.LinkRISCV32
+		return a
 	case "s390x":
 		a := archS390x()
 		a.LinkArch = &s390x.Links390x
@@ -0,0 +1,40 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// This file encapsulates some of the odd characteristics of the RISC-V
+// instruction set, to minimize its interaction with the core of the
+// assembler.
+
+package arch
+
+import (
+	"cmd/internal/obj"
+	"cmd/internal/obj/riscv"
+)
+
+var riscvRoundingModes = map[string]uint8{
+	"RNE": riscv.RM_RNE,
+	"RTZ": riscv.RM_RTZ,
+	"RDN": riscv.RM_RDN,
+	"RUP": riscv.RM_RUP,
+	"RMM": riscv.RM_RMM,
+}
+
+// RISCVSuffix handles the rounding mode suffix for RISC-V floating-point
+// instructions, such as ".RDN" in FCVTLD.RDN. It returns a boolean to
+// indicate success; failure means cond was unrecognized.
+func RISCVSuffix(prog *obj.Prog, cond string) bool {
+	if cond == "" {
+		return true
+	}
+	if cond[0] == '.' {
+		cond = cond[1:]
+	}
+	rm, ok := riscvRoundingModes[cond]
+	if !ok {
+		return false
+	}
+	prog.Scond = rm
+	return true
+}
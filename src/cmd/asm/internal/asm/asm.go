@@ -37,6 +37,12 @@ func (p *Parser) append(prog *obj.Prog, cond string, doLabel bool) {
 				return
 			}
 
+		case sys.RISCV, sys.RISCV32:
+			if !arch.RISCVSuffix(prog, cond) {
+				p.errorf("unrecognized rounding mode .%q", cond)
+				return
+			}
+
 		default:
 			p.errorf("unrecognized suffix .%q", cond)
 			return
@@ -377,7 +383,7 @@ func (p *Parser) asmJump(op obj.As, cond string, a []obj.Addr) {
 			prog.Reg = p.getRegister(prog, op, &a[1])
 			break
 		}
-		if p.arch.Family == sys.RISCV {
+		if p.arch.Family == sys.RISCV || p.arch.Family == sys.RISCV32 {
 			// 3-operand jumps.
 			// First two must be registers
 			target = &a[2]
@@ -620,7 +626,7 @@ func (p *Parser) asmInstruction(op obj.As, cond string, a []obj.Addr) {
 				p.errorf("invalid addressing modes for %s instruction", op)
 				return
 			}
-		case sys.RISCV:
+		case sys.RISCV, sys.RISCV32:
 			prog.From = a[0]
 			prog.From3 = newAddr(a[1])
 			prog.To = a[2]
@@ -675,6 +681,14 @@ func (p *Parser) asmInstruction(op obj.As, cond string, a []obj.Addr) {
 			prog.To = a[3]
 			break
 		}
+		if p.arch.Family == sys.RISCV || p.arch.Family == sys.RISCV32 {
+			// Fused multiply-add: rs3, rs2, rs1, rd.
+			prog.From = a[0]
+			prog.Reg = p.getRegister(prog, op, &a[1])
+			prog.From3 = newAddr(a[2])
+			prog.To = a[3]
+			break
+		}
 		if p.arch.Family == sys.PPC64 {
 			if arch.IsPPC64RLD(op) {
 				prog.From = a[0]
@@ -26,8 +26,15 @@ import (
 // result against a golden file.
 
 func testEndToEnd(t *testing.T, goarch, file string) {
+	testEndToEndCtxt(t, goarch, file, func(*obj.Link) {})
+}
+
+// testEndToEndCtxt is like testEndToEnd, but calls setup to adjust the
+// Link before assembling.
+func testEndToEndCtxt(t *testing.T, goarch, file string, setup func(*obj.Link)) {
 	input := filepath.Join("testdata", file+".s")
 	architecture, ctxt := setArch(goarch)
+	setup(ctxt)
 	lexer := lex.NewLexer(input)
 	parser := NewParser(ctxt, architecture, lexer)
 	pList := obj.Linknewplist(ctxt)
@@ -254,7 +261,7 @@ func isHexes(s string) bool {
 // the standard file:line: prefix,
 // but that's not where we are today.
 // It might be at the beginning but it might be in the middle of the printed instruction.
-var fileLineRE = regexp.MustCompile(`(?:^|\()(testdata[/\\][0-9a-z]+\.s:[0-9]+)(?:$|\))`)
+var fileLineRE = regexp.MustCompile(`(?:^|\()(testdata[/\\][0-9a-z]+\.s:[0-9]+)(?:$|\)|:)`)
 
 // Same as in test/run.go
 var (
@@ -263,8 +270,15 @@ var (
 )
 
 func testErrors(t *testing.T, goarch, file string) {
+	testErrorsCtxt(t, goarch, file, func(*obj.Link) {})
+}
+
+// testErrorsCtxt is like testErrors, but calls setup to adjust the
+// Link before assembling.
+func testErrorsCtxt(t *testing.T, goarch, file string, setup func(*obj.Link)) {
 	input := filepath.Join("testdata", file+".s")
 	architecture, ctxt := setArch(goarch)
+	setup(ctxt)
 	lexer := lex.NewLexer(input)
 	parser := NewParser(ctxt, architecture, lexer)
 	pList := obj.Linknewplist(ctxt)
@@ -274,6 +288,7 @@ func testErrors(t *testing.T, goarch, file string) {
 	defer ctxt.Bso.Flush()
 	failed := false
 	var errBuf bytes.Buffer
+	parser.errorWriter = &errBuf
 	ctxt.DiagFunc = func(format string, args ...interface{}) {
 		failed = true
 		s := fmt.Sprintf(format, args...)
@@ -393,6 +408,22 @@ func TestPPC64EndToEnd(t *testing.T) {
 func TestRISCVEncoder(t *testing.T) {
 	testEndToEnd(t, "riscv", "riscvenc")
 	testEndToEnd(t, "riscv", "riscvfarbranch")
+	testEndToEnd(t, "riscv32", "riscv32enc")
+}
+
+func TestRISCVErrors(t *testing.T) {
+	testErrors(t, "riscv", "riscverror")
+	testErrors(t, "riscv", "riscvparseerror")
+	testErrors(t, "riscv32", "riscv32error")
+	testErrorsCtxt(t, "riscv", "riscvdynlinkerror", func(ctxt *obj.Link) {
+		ctxt.Flag_dynlink = true
+	})
+}
+
+func TestRISCVCompressed(t *testing.T) {
+	testEndToEndCtxt(t, "riscv", "riscvrvc", func(ctxt *obj.Link) {
+		ctxt.Flag_rvc = true
+	})
 }
 
 func TestS390XEndToEnd(t *testing.T) {
@@ -134,8 +134,8 @@ func (p *Parser) line() bool {
 		for {
 			tok = p.lex.Next()
 			if len(operands) == 0 && len(items) == 0 {
-				if p.arch.InFamily(sys.ARM, sys.ARM64) && tok == '.' {
-					// ARM conditionals.
+				if p.arch.InFamily(sys.ARM, sys.ARM64, sys.RISCV, sys.RISCV32) && tok == '.' {
+					// ARM conditionals and RISC-V rounding modes.
 					tok = p.lex.Next()
 					str := p.lex.Text()
 					if tok != scanner.Ident {
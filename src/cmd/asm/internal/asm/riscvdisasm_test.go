@@ -0,0 +1,134 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package asm
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"cmd/asm/internal/lex"
+	"cmd/internal/obj"
+	"cmd/internal/riscv64asm"
+)
+
+// testRISCVDisasm checks that the disassembler and the assembler agree:
+// every instruction encoded in the test file, disassembled into Go
+// syntax and assembled again, must give back the same encoding.
+// Instructions with PC-relative operands are skipped, as the
+// disassembler prints their targets as absolute addresses.
+func testRISCVDisasm(t *testing.T, goarch, file string) {
+	input := filepath.Join("testdata", file+".s")
+	data, err := ioutil.ReadFile(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	xlen := 64
+	if goarch == "riscv32" {
+		xlen = 32
+	}
+
+	var src bytes.Buffer
+	src.WriteString("TEXT asmtest(SB),7,$0\n")
+	want := map[int]string{} // line in src -> hex encoding
+	from := map[int]string{} // line in src -> line in input
+	line := 1
+	for lineno, l := range strings.Split(string(data), "\n") {
+		parts := strings.Split(l, "//")
+		if len(parts) != 2 || strings.Contains(parts[0], "(SB)") {
+			continue
+		}
+		hexes := strings.TrimSpace(parts[1])
+		code, err := hex.DecodeString(hexes)
+		if !isHexes(hexes) || err != nil {
+			continue
+		}
+	Insts:
+		for len(code) > 0 {
+			inst, err := riscv64asm.Decode(code, xlen)
+			if err != nil {
+				t.Errorf("%s:%d: decoding %x: %v", input, lineno+1, code, err)
+				break
+			}
+			enc := code[:inst.Len]
+			code = code[inst.Len:]
+			for _, a := range inst.Args {
+				if _, ok := a.(riscv64asm.PCRel); ok {
+					continue Insts
+				}
+			}
+			line++
+			fmt.Fprintf(&src, "\t%s\n", riscv64asm.GoSyntax(inst, 0, nil))
+			want[line] = fmt.Sprintf("%x", enc)
+			from[line] = fmt.Sprintf("%s:%d", input, lineno+1)
+		}
+	}
+
+	f, err := ioutil.TempFile("", "riscvdisasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(src.Bytes()); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(src.String(), "\n")
+
+	architecture, ctxt := setArch(goarch)
+	lexer := lex.NewLexer(f.Name())
+	parser := NewParser(ctxt, architecture, lexer)
+	pList := obj.Linknewplist(ctxt)
+	testOut = new(bytes.Buffer) // The assembler writes test output to this buffer.
+	failed := false
+	ctxt.DiagFunc = func(format string, args ...interface{}) {
+		failed = true
+		t.Errorf(format, args...)
+	}
+	var ok bool
+	pList.Firstpc, ok = parser.Parse()
+	if !ok || failed {
+		t.Fatalf("asm: %s assembly of disassembled %s failed", goarch, input)
+	}
+	obj.FlushplistNoFree(ctxt)
+
+	var text *obj.LSym
+	for p := pList.Firstpc; p != nil; p = p.Link {
+		if p.As == obj.ATEXT {
+			text = p.From.Sym
+			continue
+		}
+		n := int(p.Ctxt.PosTable.Pos(p.Pos).Line())
+		hexes, ok := want[n]
+		if !ok {
+			continue
+		}
+		delete(want, n)
+		size := int64(len(text.P)) - p.Pc
+		if p.Link != nil {
+			size = p.Link.Pc - p.Pc
+		}
+		code := fmt.Sprintf("%x", text.P[p.Pc:p.Pc+size])
+		if code != hexes {
+			t.Errorf("%s: %s disassembles as %q, which assembles to %s", from[n], hexes, strings.TrimSpace(lines[n-1]), code)
+		}
+	}
+	for n := range want {
+		t.Errorf("%s: %q did not assemble", from[n], strings.TrimSpace(lines[n-1]))
+	}
+}
+
+func TestRISCVDisasm(t *testing.T) {
+	testRISCVDisasm(t, "riscv", "riscvenc")
+	testRISCVDisasm(t, "riscv32", "riscv32enc")
+}
@@ -0,0 +1,37 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Encodings that differ on RV32.
+
+TEXT asmtest(SB),7,$0
+start:
+	// MOV moves a word.
+	MOV	T0, T1				// 13830200
+	MOV	(T0), T1			// 03a30200
+	MOV	4(T0), T1			// 03a34200
+	MOV	T0, (T1)			// 23205300
+	MOV	T0, 4(T1)			// 23225300
+	MOVW	4(T0), T1			// 03a34200
+	MOVWU	4(T0), T1			// 03a34200
+
+	// Constants are built with ADDI, not ADDIW.
+	MOV	$2047, T0			// 9302f07f
+	MOV	$-2048, T0			// 93020080
+	MOV	$-1, T0				// 9302f0ff
+	MOV	$4096, T0			// b7120000
+	MOV	$305419896, T0			// b7523412
+	MOV	$4294967295, T0			// 9302f0ff
+
+	// Shift amounts go up to 31.
+	SLLI	$31, T0, T1			// 1393f201
+	SRLI	$31, T0, T1			// 13d3f201
+	SRAI	$31, T0, T1			// 13d3f241
+	RORI	$31, T0, T1			// 13d3f261
+
+	RDCYCLEH	T0			// f32200c8
+	RDTIMEH		T0			// f32210c8
+	RDINSTRETH	T0			// f32220c8
+
+	ZEXTH	T0, T1				// 33c30208
+	REV8	T0, T1				// 13d38269
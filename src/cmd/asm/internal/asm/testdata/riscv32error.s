@@ -0,0 +1,13 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+TEXT errors(SB),$0
+	SLLI	$32, T0, T1			// ERROR "shift amount out of range 0 to 31"
+	RORI	$32, T0, T1			// ERROR "shift amount out of range 0 to 31"
+	LD	$0, T0, T1			// ERROR "instruction not available on RV32"
+	ADDW	T0, T1, T2			// ERROR "instruction not available on RV32"
+	AMOSWAPD	T0, (T1), T2		// ERROR "instruction not available on RV32"
+	FCVTLD	FT0, T0				// ERROR "instruction not available on RV32"
+	MOV	$4294967296, T0			// ERROR "does not fit in a 32-bit register"
+	RET
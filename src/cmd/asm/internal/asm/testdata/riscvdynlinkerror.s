@@ -0,0 +1,11 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Errors that only occur when assembling with -dynlink.
+
+TEXT errors(SB),$0
+start:
+	MOV	TMP, runtime·writeBarrier(SB)	// ERROR "cannot store TMP to runtime.writeBarrier with -dynlink"
+	MOV	$runtime·writeBarrier(SB), T0	// no error
+	RET
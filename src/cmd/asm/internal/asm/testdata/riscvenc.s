@@ -6,6 +6,8 @@ start:
 	ADD	$-2048, T0, T1			// 13830280
 	ADD	$2047, T0			// 9382f27f
 	ADD	$-2048, T0			// 93820280
+	ADDI	$2047, T0, T1			// 1383f27f
+	ADDI	$-2048, T0, T1			// 13830280
 
 	SUB	T1, T0, T2			// b3836240
 	SUB	T0, T1				// 33035340
@@ -22,6 +24,10 @@ start:
 	SRA	T0, T1				// 33535340
 	SRA	$1, T0, T1			// 13d31240
 	SRA	$1, T0				// 93d21240
+	SLL	$0, T0, T1			// 13930200
+	SLLI	$63, T0, T1			// 1393f203
+	SRLI	$63, T0, T1			// 13d3f203
+	SRAI	$63, T0, T1			// 13d3f243
 
 	AND	T1, T0, T2			// b3f36200
 	AND	T0, T1				// 33735300
@@ -35,18 +41,36 @@ start:
 	XOR	T0, T1				// 33435300
 	XOR	$1, T0, T1			// 13c31200
 	XOR	$1, T0				// 93c21200
+	ANDI	$-2048, T0, T1			// 13f30280
+	ORI	$2047, T0, T1			// 13e3f27f
+	XORI	$-1, T0, T1			// 13c3f2ff
+
+	ADDW	T1, T0, T2			// bb836200
+	ADDW	T0, T1				// 3b035300
+	ADDW	$1, T0, T1			// 1b831200
+	ADDIW	$-2048, T0, T1			// 1b830280
+	SUBW	T1, T0, T2			// bb836240
+	SLLW	T1, T0, T2			// bb936200
+	SLLW	$1, T0, T1			// 1b931200
+	SLLIW	$31, T0, T1			// 1b93f201
+	SRLW	T1, T0, T2			// bbd36200
+	SRLW	$1, T0, T1			// 1bd31200
+	SRLIW	$31, T0, T1			// 1bd3f201
+	SRAW	T1, T0, T2			// bbd36240
+	SRAW	$1, T0, T1			// 1bd31240
+	SRAIW	$31, T0, T1			// 1bd3f241
 
 	// These jumps can get printed as jumps to 2 because they go to the
 	// second instruction in the function.  (The first instruction is an
 	// invisible stack pointer adjustment.)
-	JMP	start		// JMP	2	// 6ff01ff8
-	JAL	T0, start	// JAL T0, 2	// eff2dff7
-	BEQ	T0, T1, start	// BEQ T0, T1, 2	// e38c62f6
-	BNE	T0, T1, start	// BNE T0, T1, 2	// e39a62f6
-	BLT	T0, T1, start	// BLT T0, T1, 2	// e3c862f6
-	BGE	T0, T1, start	// BGE T0, T1, 2	// e3d662f6
-	BLTU	T0, T1, start	// BLTU T0, T1, 2	// e3e462f6
-	BGEU	T0, T1, start	// BGEU T0, T1, 2	// e3f262f6
+	JMP	start		// JMP	2	// 6ff05ff2
+	JAL	T0, start	// JAL T0, 2	// eff21ff2
+	BEQ	T0, T1, start	// BEQ T0, T1, 2	// e38e62f0
+	BNE	T0, T1, start	// BNE T0, T1, 2	// e39c62f0
+	BLT	T0, T1, start	// BLT T0, T1, 2	// e3ca62f0
+	BGE	T0, T1, start	// BGE T0, T1, 2	// e3d862f0
+	BLTU	T0, T1, start	// BLTU T0, T1, 2	// e3e662f0
+	BGEU	T0, T1, start	// BGEU T0, T1, 2	// e3f462f0
 
 	JMP	(T0)				// 67800200
 	JMP	4(T0)				// 67804200
@@ -72,19 +96,56 @@ start:
 
 	ECALL					// 73000000
 	SCALL					// 73000000
+	EBREAK					// 73001000
+	UNDEF					// 73001000
+	SRET					// 73002010
+	WFI					// 73005010
 	RDCYCLE	T0				// f32200c0
 	RDTIME	T0				// f32210c0
 	RDINSTRET	T0			// f32220c0
+	SBREAK					// 73001000
+
+	CSRRW	$3, T0, T1			// 73933200
+	CSRRS	$3072, ZERO, T1		// 732300c0
+	CSRRC	$4095, T0, T1			// 73b3f2ff
+	CSRRS	$0, T0, T1			// 73a30200
+	CSRRWI	$2, $31, T1			// 73d32f00
+	CSRRSI	$1, $0, T1			// 73631000
+	CSRRCI	$2048, $17, T1			// 73f30880
+
+	FRCSR	T0				// f3223000
+	FSCSR	T0, T1				// 73933200
+	FSCSR	T0				// 73903200
+	FRRM	T0				// f3222000
+	FSRM	T0, T1				// 73932200
+	FSRM	T0				// 73902200
+	FRFLAGS	T0				// f3221000
+	FSFLAGS	T0, T1				// 73931200
+	FSFLAGS	T0				// 73901200
+	FSRMI	$1, T0				// f3d22000
+	FSRMI	$4				// 73502200
+	FSFLAGSI	$31, T0			// f3d21f00
+	FSFLAGSI	$0			// 73501000
 
 	AUIPC	$0, A0 				// 17050000
 	AUIPC	$0, A1 				// 97050000
 	AUIPC	$1, A0				// 17150000
+	AUIPC	$-1, A0				// 17f5ffff
 
 	LUI	$167, A5			// b7770a00
+	LUI	$524287, A5			// b7f7ff7f
+	LUI	$-524288, A5			// b7070080
 
 	MOV	T0, T1				// 13830200
 	MOV	$2047, T0			// 9b02f07f
 	MOV	$-2048, T0			// 9b020080
+	MOV	$0, T0				// 9b020000
+	MOV	$-1, T0				// 9b02f0ff
+	MOV	$4096, T0			// b7120000
+	MOV	$4294967296, T0			// 9b021000
+	MOV	$-9223372036854775808, T0	// 9b02f0ff
+	MOV	$1311768467463790320, T0	// 97020000
+	MOVD	$1.5, FT0			// MOVD	$(1.5), FT0	// 970f0000
 
 	MOVB	(T0), T1			// 03830200
 	MOVB	4(T0), T1			// 03834200
@@ -94,6 +155,11 @@ start:
 	MOVW	4(T0), T1			// 03a34200
 	MOV	(T0), T1			// 03b30200
 	MOV	4(T0), T1			// 03b34200
+	MOVB	2047(T0), T1			// 0383f27f
+	MOVB	-2048(T0), T1			// 03830280
+	MOVBU	4(T0), T1			// 03c34200
+	MOVHU	4(T0), T1			// 03d34200
+	MOVWU	4(T0), T1			// 03e34200
 	MOVB	T0, (T1)			// 23005300
 	MOVB	T0, 4(T1)			// 23025300
 	MOVH	T0, (T1)			// 23105300
@@ -102,18 +168,25 @@ start:
 	MOVW	T0, 4(T1)			// 23225300
 	MOV	T0, (T1)			// 23305300
 	MOV	T0, 4(T1)			// 23325300
+	MOV	T0, 2047(T1)			// a33f537e
+	MOV	T0, -2048(T1)			// 23305380
 
 	SLT	T1, T0, T2			// b3a36200
 	SLT	$55, T0, T2			// 93a37203
 	SLTU	T1, T0, T2			// b3b36200
 	SLTU	$55, T0, T2			// 93b37203
+	SLTI	$-1, T0, T1			// 13a3f2ff
+	SLTIU	$2047, T0, T1			// 13b3f27f
 
 	SEQZ	A5, A5				// 93b71700
 	SNEZ	A5, A5				// b337f000
+	SEQZ	T0, T1				// 13b31200
+	SNEZ	T0, T1				// 33335000
 
 	// Arbitrary bytes (entered in little-endian mode)
 	WORD	$0x12345678	// WORD $305419896	// 78563412
 	WORD	$0x9abcdef0	// WORD $2596069104	// f0debc9a
+	WORD	$-1		// WORD $-1		// ffffffff
 
 
 	// M extension
@@ -133,12 +206,46 @@ start:
 	REMUW	T0, T1, T2			// bb735302
 
 
+	// A extension
+	LRW	(A0), A1			// af250516
+	LRD	(A0), A1			// af350516
+	SCW	A2, (A0), A1			// af25c51e
+	SCD	A2, (A0), A1			// af35c51e
+	AMOSWAPW	A2, (A0), A1		// af25c50e
+	AMOSWAPD	A2, (A0), A1		// af35c50e
+	AMOADDW	A2, (A0), A1			// af25c506
+	AMOADDD	A2, (A0), A1			// af35c506
+	AMOANDW	A2, (A0), A1			// af25c566
+	AMOANDD	A2, (A0), A1			// af35c566
+	AMOORW	A2, (A0), A1			// af25c546
+	AMOORD	A2, (A0), A1			// af35c546
+	AMOXORW	A2, (A0), A1			// af25c526
+	AMOXORD	A2, (A0), A1			// af35c526
+	AMOMAXW	A2, (A0), A1			// af25c5a6
+	AMOMAXD	A2, (A0), A1			// af35c5a6
+	AMOMAXUW	A2, (A0), A1		// af25c5e6
+	AMOMAXUD	A2, (A0), A1		// af35c5e6
+	AMOMINW	A2, (A0), A1			// af25c586
+	AMOMIND	A2, (A0), A1			// af35c586
+	AMOMINUW	A2, (A0), A1		// af25c5c6
+	AMOMINUD	A2, (A0), A1		// af35c5c6
+	FENCE					// 0f00f00f
+	FENCEI					// 0f100000
+
+
 	// F extension
 	FADDS	FT1, FT0, FT2			// 53011000
 	FSUBS	FT1, FT0, FT2			// 53011008
 	FMULS	FT1, FT0, FT2			// 53011010
 	FDIVS	FT1, FT0, FT2			// 53011018
+	FMINS	FT1, FT0, FT2			// 53011028
+	FMAXS	FT1, FT0, FT2			// 53111028
 	FSQRTS	FT0, FT1			// d3000058
+	FMADDS	FT3, FT2, FT1, FT0		// 43802018
+	FMSUBS	FT3, FT2, FT1, FT0		// 47802018
+	FNMSUBS	FT3, FT2, FT1, FT0		// 4b802018
+	FNMADDS	FT3, FT2, FT1, FT0		// 4f802018
+	FABSS	FT0, FT1			// d3200020
 	FNEGS	FT0, FT1			// d3100020
 	FSGNJS	FT1, FT0, FT2			// 53011020
 	FSGNJNS	FT1, FT0, FT2			// 53111020
@@ -147,8 +254,17 @@ start:
 	FCVTSL	T0, FT0				// 538022d0
 	FCVTWS	FT0, T0				// d31200c0
 	FCVTLS	FT0, T0				// d31220c0
+	FCVTSWU	T0, FT0				// 538012d0
+	FCVTSLU	T0, FT0				// 538032d0
+	FCVTWUS	FT0, T0				// d31210c0
+	FCVTLUS	FT0, T0				// d31230c0
+	FMVXS	FT0, T0				// d30200e0
+	FMVSX	T0, FT0				// 538002f0
+	FCLASSS	FT0, T0				// d31200e0
 	MOVF	4(T0), FT0			// 07a04200
 	MOVF	FT0, 4(T0)			// 27a20200
+	MOVF	-2048(T0), FT0			// 07a00280
+	MOVF	FT0, 2047(T0)			// a7af027e
 	MOVF	FT0, FT1			// d3000020
 	FEQS	FT0, FT1, T2			// d3a300a0
 	FNES	FT0, FT1, T2			// d3a300a0
@@ -158,10 +274,18 @@ start:
 
 	// D extension
 	FADDD	FT1, FT0, FT2			// 53011002
+	FADDD.RDN	FT1, FT0, FT2		// 53211002
 	FSUBD	FT1, FT0, FT2			// 5301100a
 	FMULD	FT1, FT0, FT2			// 53011012
 	FDIVD	FT1, FT0, FT2			// 5301101a
+	FMIND	FT1, FT0, FT2			// 5301102a
+	FMAXD	FT1, FT0, FT2			// 5311102a
 	FSQRTD	FT0, FT1			// d300005a
+	FMADDD	FT3, FT2, FT1, FT0		// 4380201a
+	FMSUBD	FT3, FT2, FT1, FT0		// 4780201a
+	FNMSUBD	FT3, FT2, FT1, FT0		// 4b80201a
+	FNMADDD	FT3, FT2, FT1, FT0		// 4f80201a
+	FABSD	FT0, FT1			// d3200022
 	FNEGD	FT0, FT1			// d3100022
 	FSGNJD	FT1, FT0, FT2			// 53011022
 	FSGNJND	FT1, FT0, FT2			// 53111022
@@ -170,10 +294,83 @@ start:
 	FCVTDL	T0, FT0				// 538022d2
 	FCVTWD	FT0, T0				// d31200c2
 	FCVTLD	FT0, T0				// d31220c2
+	FCVTLD.RNE	FT0, T0			// d30220c2
+	FCVTLD.RDN	FT0, T0			// d32220c2
+	FCVTLD.RUP	FT0, T0			// d33220c2
+	FCVTDL.RUP	T0, FT0			// 53b022d2
+	FCVTDWU	T0, FT0				// 538012d2
+	FCVTDLU	T0, FT0				// 538032d2
+	FCVTWUD	FT0, T0				// d31210c2
+	FCVTLUD	FT0, T0				// d31230c2
+	FCVTSD	FT0, FT1			// d3001040
+	FCVTDS	FT0, FT1			// d3000042
+	FMVXD	FT0, T0				// d30200e2
+	FMVDX	T0, FT0				// 538002f2
+	FCLASSD	FT0, T0				// d31200e2
 	MOVD	4(T0), FT0			// 07b04200
 	MOVD	FT0, 4(T0)			// 27b20200
+	MOVD	-2048(T0), FT0			// 07b00280
+	MOVD	FT0, 2047(T0)			// a7bf027e
 	MOVD	FT0, FT1			// d3000022
 	FEQD	FT0, FT1, T0			// d3a200a2
 	FNED	FT0, FT1, T0			// d3a200a2
 	FLTD	FT0, FT1, T0			// d39200a2
 	FLED	FT0, FT1, T0			// d38200a2
+
+	// Bit-manipulation extension
+	ADDUW	T1, T0, T2		// bb836208
+	SH1ADD	T1, T0, T2		// b3a36220
+	SH1ADDUW	T1, T0, T2	// bba36220
+	SH2ADD	T1, T0, T2		// b3c36220
+	SH2ADDUW	T1, T0, T2	// bbc36220
+	SH3ADD	T1, T0, T2		// b3e36220
+	SH3ADDUW	T1, T0, T2	// bbe36220
+	SLLIUW	$31, T0, T1		// 1b93f209
+	ANDN	T1, T0, T2		// b3f36240
+	ORN	T1, T0, T2		// b3e36240
+	XNOR	T1, T0, T2		// b3c36240
+	CLZ	T0, T1			// 13930260
+	CLZW	T0, T1			// 1b930260
+	CTZ	T0, T1			// 13931260
+	CTZW	T0, T1			// 1b931260
+	CPOP	T0, T1			// 13932260
+	CPOPW	T0, T1			// 1b932260
+	MAX	T1, T0, T2		// b3e3620a
+	MAXU	T1, T0, T2		// b3f3620a
+	MIN	T1, T0, T2		// b3c3620a
+	MINU	T1, T0, T2		// b3d3620a
+	SEXTB	T0, T1			// 13934260
+	SEXTH	T0, T1			// 13935260
+	ZEXTH	T0, T1			// 3bc30208
+	ROL	T1, T0, T2		// b3936260
+	ROLW	T1, T0, T2		// bb936260
+	ROR	T1, T0, T2		// b3d36260
+	RORW	T1, T0, T2		// bbd36260
+	ROR	$63, T0, T1		// 13d3f263
+	RORI	$1, T0, T1		// 13d31260
+	RORW	$31, T0, T1		// 1bd3f261
+	RORIW	$1, T0, T1		// 1bd31260
+	ORCB	T0, T1			// 13d37228
+	REV8	T0, T1			// 13d3826b
+	BCLR	T1, T0, T2		// b3936248
+	BCLR	$63, T0, T1		// 1393f24b
+	BCLRI	$1, T0, T1		// 13931248
+	BEXT	T1, T0, T2		// b3d36248
+	BEXT	$63, T0, T1		// 13d3f24b
+	BEXTI	$1, T0, T1		// 13d31248
+	BINV	T1, T0, T2		// b3936268
+	BINV	$63, T0, T1		// 1393f26b
+	BINVI	$1, T0, T1		// 13931268
+	BSET	T1, T0, T2		// b3936228
+	BSET	$63, T0, T1		// 1393f22b
+	BSETI	$1, T0, T1		// 13931228
+
+	// Scalar cryptography extension: Zknh
+	SHA256SIG0	T0, T1		// 13932210
+	SHA256SIG1	T0, T1		// 13933210
+	SHA256SUM0	T0, T1		// 13930210
+	SHA256SUM1	T0, T1		// 13931210
+	SHA512SIG0	T0, T1		// 13936210
+	SHA512SIG1	T0, T1		// 13937210
+	SHA512SUM0	T0, T1		// 13934210
+	SHA512SUM1	T0, T1		// 13935210
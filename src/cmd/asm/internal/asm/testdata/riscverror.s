@@ -0,0 +1,36 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+TEXT errors(SB),$0
+start:
+	SLTI	$2048, T0, T1			// ERROR "immediate in from position cannot be larger than 12 bits but got 2048"
+	SLTIU	$-2049, T0, T1			// ERROR "immediate in from position cannot be larger than 12 bits but got -2049"
+	LUI	$524288, A5			// ERROR "immediate in from position cannot be larger than 20 bits but got 524288"
+	LUI	$-524289, A5			// ERROR "immediate in from position cannot be larger than 20 bits but got -524289"
+	SLLI	$64, T0, T1			// ERROR "shift amount out of range 0 to 63"
+	SRAI	$-1, T0, T1			// ERROR "shift amount out of range 0 to 63"
+	SRLIW	$32, T0, T1			// ERROR "shift amount out of range 0 to 31"
+	RORI	$64, T0, T1			// ERROR "shift amount out of range 0 to 63"
+	RORIW	$32, T0, T1			// ERROR "shift amount out of range 0 to 31"
+	CLZ	FT0, T0				// ERROR "expected integer register in from position but got non-integer register FT0"
+	WORD	$-0x80000001			// ERROR "immediate in raw position cannot be larger than 32 bits but got -2147483649"
+	WORD	$0x100000000			// ERROR "immediate in raw position cannot be larger than 32 bits but got 4294967296"
+	ADD	FT0, T0, T1			// ERROR "expected integer register in from position but got non-integer register FT0"
+	SEQZ	FT0, T1				// ERROR "expected integer register in from3 position but got non-integer register FT0"
+	FADDS	T0, FT0, FT1			// ERROR "expected float register in from position but got non-float register T0"
+	FMVXS	FT0, FT1			// ERROR "expected integer register in to position but got non-integer register FT1"
+	MOVF	FT0, T0				// ERROR "expected float register in to position but got non-float register T0"
+	FMADDD	FT3, T2, FT1, FT0		// ERROR "expected float register in reg position but got non-float register T2"
+	RDCYCLEH	T0			// ERROR "instruction not available on RV64"
+	CSRRS	$4096, ZERO, T0			// ERROR "CSR number out of range 0 to 4095"
+	CSRRW	$-1, T1, T0			// ERROR "CSR number out of range 0 to 4095"
+	CSRRWI	$1, $32, T0			// ERROR "CSR immediate out of range 0 to 31"
+	FSRMI	$-1, T0				// ERROR "CSR immediate out of range 0 to 31"
+	MOVB	$1, T0				// ERROR "unsupported constant load"
+	MOVWU	T0, (T1)			// ERROR "unsupported unsigned store"
+	BEQ	T0, start			// ERROR "expected register in reg position but got nothing"
+	BNE	T0, T1, 4(T0)			// ERROR "branch needs a label as its destination"
+	JAL	T0, errors(SB)			// ERROR "jump needs a label as its destination"
+	JMP	$4				// ERROR "unsupported destination type"
+	RET
@@ -0,0 +1,17 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// The parser stops before assembly when it finds an error, so these
+// are kept apart from the errors in riscverror.s.
+
+TEXT errors(SB),$0
+	// Privileged instructions from an old draft of the privileged
+	// specification, whose encodings were changed or removed by later
+	// versions.
+	ERET					// ERROR "unrecognized instruction"
+	SFENCEVM				// ERROR "unrecognized instruction"
+	MRTS					// ERROR "unrecognized instruction"
+	MRTH					// ERROR "unrecognized instruction"
+	HRTS					// ERROR "unrecognized instruction"
+	RET
@@ -0,0 +1,128 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Compressed instruction encodings, as emitted with -rvc.
+TEXT asmtest(SB),7,$0
+	ADD	$1, A0				// 0505
+	ADD	$-32, A0			// 0115
+	ADD	$32, A0				// 13050502
+	ADD	$16, X2				// ADD $16, SP	// 4101
+	ADD	$-64, X2			// ADD $-64, SP	// 3971
+	ADD	$8, X2, A0			// ADD $8, SP, A0	// 2800
+	MOV	A0, S0				// 2a84
+	MOV	$5, A0				// 1545
+	MOV	$0, A0				// 0145
+	LUI	$1, A5				// 8567
+
+	SLL	$3, A0				// 0e05
+	SRL	$3, A0				// 0d81
+	SRA	$3, A0				// 0d85
+	AND	$7, A0				// 1d89
+	SRL	$3, T0				// 93d23200
+
+	ADD	A1, A0				// 2e95
+	SUB	A1, A0				// 0d8d
+	XOR	A1, A0				// 2d8d
+	OR	A1, A0				// 4d8d
+	AND	A1, A0				// 6d8d
+	ADD	T1, T0, T2			// b3836200
+
+	MOV	8(A0), A1			// 0c65
+	MOVW	4(A0), A1			// 4c41
+	MOVD	8(A0), FS0			// 0025
+	MOV	A1, 8(A0)			// 0ce5
+	MOVW	A1, 4(A0)			// 4cc1
+	MOVD	FS0, 8(A0)			// 00a5
+	MOV	256(A0), A1			// 83350510
+
+	MOV	8(X2), RA			// MOV 8(SP), RA	// a260
+	MOV	RA, 8(X2)			// MOV RA, 8(SP)	// 06e4
+	MOVW	4(X2), A0			// MOVW 4(SP), A0	// 1245
+	MOVW	A0, 4(X2)			// MOVW A0, 4(SP)	// 2ac2
+	MOVD	8(X2), FT0			// MOVD 8(SP), FT0	// 2220
+	MOVD	FT0, 8(X2)			// MOVD FT0, 8(SP)	// 02a4
+	MOV	504(X2), A0			// MOV 504(SP), A0	// 7e75
+
+	JMP	(A0)				// 0285
+	JALR	RA, (A0)			// 0295
+	JMP	4(A0)				// 67004500
+	EBREAK					// 0290
+
+	BEQ	A0, ZERO, 2(PC)			// 11c1
+	EBREAK					// 0290
+	BNE	ZERO, A0, 2(PC)			// 11e1
+	EBREAK					// 0290
+	BEQ	A0, A1, 2(PC)			// 6303b500
+	EBREAK					// 0290
+	JMP	2(PC)				// 11a0
+	EBREAK					// 0290
+
+	// Too far for C.BNEZ.
+	BNE	A0, ZERO, 66(PC)		// 63140510
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	WORD	$0
+	RET
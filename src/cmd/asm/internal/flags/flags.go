@@ -11,6 +11,8 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+
+	"cmd/internal/obj"
 )
 
 var (
@@ -20,6 +22,7 @@ var (
 	TrimPath   = flag.String("trimpath", "", "remove prefix from recorded source file paths")
 	Shared     = flag.Bool("shared", false, "generate code that can be linked into a shared library")
 	Dynlink    = flag.Bool("dynlink", false, "support references to Go symbols defined in other shared libraries")
+	RVC        = flag.Bool("rvc", obj.GORISCV.C, "emit compressed instructions where possible (riscv only)")
 	AllErrors  = flag.Bool("e", false, "no limit on number of errors reported")
 )
 
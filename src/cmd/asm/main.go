@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"cmd/asm/internal/arch"
 	"cmd/asm/internal/asm"
@@ -18,6 +19,7 @@ import (
 
 	"cmd/internal/bio"
 	"cmd/internal/obj"
+	"cmd/internal/sys"
 )
 
 func main() {
@@ -33,12 +35,21 @@ func main() {
 
 	flags.Parse()
 
+	// Define GORISCV_b and so on for each extension in $GORISCV,
+	// so that assembly can use #ifdef to select an implementation.
+	if exts := obj.GORISCV.String(); exts != "" && architecture.LinkArch.InFamily(sys.RISCV, sys.RISCV32) {
+		for _, ext := range strings.Split(exts, ",") {
+			flags.D = append(flags.D, "GORISCV_"+ext)
+		}
+	}
+
 	ctxt := obj.Linknew(architecture.LinkArch)
 	if *flags.PrintOut {
 		ctxt.Debugasm = 1
 	}
 	ctxt.Flag_dynlink = *flags.Dynlink
 	ctxt.Flag_shared = *flags.Shared || *flags.Dynlink
+	ctxt.Flag_rvc = *flags.RVC && architecture.LinkArch.InFamily(sys.RISCV, sys.RISCV32)
 	ctxt.Bso = bufio.NewWriter(os.Stdout)
 	defer ctxt.Bso.Flush()
 
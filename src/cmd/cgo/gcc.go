@@ -1211,6 +1211,10 @@ func (p *Package) gccMachine() []string {
 		return []string{"-mabi=64"}
 	case "mips", "mipsle":
 		return []string{"-mabi=32"}
+	case "riscv":
+		return []string{"-march=rv64gc", "-mabi=lp64d"}
+	case "riscv32":
+		return []string{"-march=rv32gc", "-mabi=ilp32d"}
 	}
 	return nil
 }
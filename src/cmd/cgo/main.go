@@ -150,6 +150,7 @@ var ptrSizeMap = map[string]int64{
 	"ppc64":    8,
 	"ppc64le":  8,
 	"riscv":    8,
+	"riscv32":  4,
 	"s390":     4,
 	"s390x":    8,
 }
@@ -166,6 +167,7 @@ var intSizeMap = map[string]int64{
 	"ppc64":    8,
 	"ppc64le":  8,
 	"riscv":    8,
+	"riscv32":  4,
 	"s390":     4,
 	"s390x":    8,
 }
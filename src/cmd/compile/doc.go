@@ -86,6 +86,9 @@ Flags:
 		Write a package (archive) file rather than an object file
 	-race
 		Compile with race detector enabled.
+	-rvc
+		Emit compressed instructions where possible (riscv only).
+		Defaults to true if $GORISCV includes c.
 	-trimpath prefix
 		Remove prefix from recorded source file paths.
 	-u
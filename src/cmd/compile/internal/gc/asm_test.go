@@ -51,7 +51,13 @@ func TestAssembly(t *testing.T) {
 
 // compile compiles the package pkg for architecture arch and
 // returns the generated assembly.  dir is a scratch directory.
+// An arch of the form riscv/b also sets $GORISCV to b.
 func compileToAsm(t *testing.T, dir, goarch, goos, pkg string) string {
+	env := []string{"GOARCH=" + goarch, "GOOS=" + goos}
+	if i := strings.Index(goarch, "/"); i >= 0 {
+		env = []string{"GOARCH=" + goarch[:i], "GOOS=" + goos, "GORISCV=" + goarch[i+1:]}
+	}
+
 	// Create source.
 	src := filepath.Join(dir, "test.go")
 	f, err := os.Create(src)
@@ -66,7 +72,7 @@ func compileToAsm(t *testing.T, dir, goarch, goos, pkg string) string {
 	// TODO: extract dependencies automatically?
 	var stdout, stderr bytes.Buffer
 	cmd := exec.Command(testenv.GoToolPath(t), "build", "-o", filepath.Join(dir, "encoding/binary.a"), "encoding/binary")
-	cmd.Env = mergeEnvLists([]string{"GOARCH=" + goarch, "GOOS=" + goos}, os.Environ())
+	cmd.Env = mergeEnvLists(env, os.Environ())
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 	if err := cmd.Run(); err != nil {
@@ -81,7 +87,7 @@ func compileToAsm(t *testing.T, dir, goarch, goos, pkg string) string {
 
 	// Now, compile the individual file for which we want to see the generated assembly.
 	cmd = exec.Command(testenv.GoToolPath(t), "tool", "compile", "-I", dir, "-S", "-o", filepath.Join(dir, "out.o"), src)
-	cmd.Env = mergeEnvLists([]string{"GOARCH=" + goarch, "GOOS=" + goos}, os.Environ())
+	cmd.Env = mergeEnvLists(env, os.Environ())
 	cmd.Stdout = &stdout
 	cmd.Stderr = &stderr
 	if err := cmd.Run(); err != nil {
@@ -440,6 +446,426 @@ func f(t *T) {
 `,
 		[]string{"\tROLL\t[$]7,"},
 	},
+
+	// math/bits intrinsics.
+	{"amd64", "linux", `
+import "math/bits"
+func f(x uint64) int {
+	return bits.Len64(x)
+}
+`,
+		[]string{"\tBSRQ\t"},
+	},
+	{"amd64", "linux", `
+import "math/bits"
+func f(x uint32) int {
+	return bits.TrailingZeros32(x)
+}
+`,
+		[]string{"\tBSFL\t"},
+	},
+	{"arm64", "linux", `
+import "math/bits"
+func f(x uint64) int {
+	return bits.LeadingZeros64(x)
+}
+`,
+		[]string{"\tCLZ\t"},
+	},
+	{"arm64", "linux", `
+import "math/bits"
+func f(x uint32) uint32 {
+	return bits.ReverseBytes32(x)
+}
+`,
+		[]string{"\tREVW\t"},
+	},
+
+	// runtime/internal/atomic on RISC-V. Loads are fenced on both sides;
+	// everything else is an AMO or an LR/SC loop, with both the aq and
+	// rl bits (26 and 25) set. The second regexp matches the instruction
+	// bytes with the registers left out: opcode, funct3 (2 for W, 3 for
+	// D), then funct5 and aq|rl in the top byte.
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint32) uint32 {
+		return atomic.Load(p)
+	}
+`,
+		[]string{"\tFENCE\t.*\n.*\tLWU\t.*\n.*\tFENCE\t"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint64) uint64 {
+		return atomic.Load64(p)
+	}
+`,
+		[]string{"\tFENCE\t.*\n.*\tLD\t.*\n.*\tFENCE\t"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint32, v uint32) {
+		atomic.Store(p, v)
+	}
+`,
+		[]string{"\tAMOSWAPW\t.*, ZERO\n", "[2a]f [2a][0-9a-f] [0-9a-f]{2} 0[ef]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint64, v uint64) {
+		atomic.Store64(p, v)
+	}
+`,
+		[]string{"\tAMOSWAPD\t.*, ZERO\n", "[2a]f [3b][0-9a-f] [0-9a-f]{2} 0[ef]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint32, v int32) uint32 {
+		return atomic.Xadd(p, v)
+	}
+`,
+		[]string{"\tAMOADDW\t", "[2a]f [2a][0-9a-f] [0-9a-f]{2} 0[67]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint64, v int64) uint64 {
+		return atomic.Xadd64(p, v)
+	}
+`,
+		[]string{"\tAMOADDD\t", "[2a]f [3b][0-9a-f] [0-9a-f]{2} 0[67]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint64, v uint64) uint64 {
+		return atomic.Xchg64(p, v)
+	}
+`,
+		[]string{"\tAMOSWAPD\t", "[2a]f [3b][0-9a-f] [0-9a-f]{2} 0[ef]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint32, old, new uint32) bool {
+		return atomic.Cas(p, old, new)
+	}
+`,
+		[]string{"\tLRW\t", "\tSCW\t", "[2a]f [2a][0-9a-f] [0-9a-f]{2} 1[67]", "[2a]f [2a][0-9a-f] [0-9a-f]{2} 1[ef]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint64, old, new uint64) bool {
+		return atomic.Cas64(p, old, new)
+	}
+`,
+		[]string{"\tLRD\t", "\tSCD\t", "[2a]f [3b][0-9a-f] [0-9a-f]{2} 1[67]", "[2a]f [3b][0-9a-f] [0-9a-f]{2} 1[ef]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint8, v uint8) {
+		atomic.And8(p, v)
+	}
+`,
+		[]string{"\tAMOANDW\t", "[2a]f [2a][0-9a-f] [0-9a-f]{2} 6[67]"},
+	},
+	{"riscv", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint8, v uint8) {
+		atomic.Or8(p, v)
+	}
+`,
+		[]string{"\tAMOORW\t", "[2a]f [2a][0-9a-f] [0-9a-f]{2} 4[67]"},
+	},
+
+	// Compare-and-branch on RISC-V should not materialize a boolean.
+	{"riscv", "linux", `
+	func f(x, y int) int {
+		if x < y {
+			return 7
+		}
+		return 9
+	}
+`,
+		[]string{"\tBGE\t"},
+	},
+	{"riscv", "linux", `
+	func f(x, y uint) int {
+		if x >= y {
+			return 7
+		}
+		return 9
+	}
+`,
+		[]string{"\tBLTU\t"},
+	},
+	{"riscv", "linux", `
+	func f(x int) int {
+		if x == 0 {
+			return 7
+		}
+		return 9
+	}
+`,
+		[]string{"\tBNE\t.*, ZERO,"},
+	},
+	{"riscv", "linux", `
+	func f(p *[5]int64) {
+		*p = [5]int64{}
+	}
+`,
+		[]string{"\tADDI\t\\$-984, T0, T0\n", "runtime.duffzero\\+492\n"},
+	},
+	{"riscv", "linux", `
+	func f(p, q *[5]int64) {
+		*p = *q
+	}
+`,
+		[]string{"runtime.duffcopy\\+984\n"},
+	},
+	// Moves too large for duffcopy use a loop, not memmove.
+	{"riscv", "linux", `
+	func f(p, q *[256]int64) {
+		*p = *q
+	}
+`,
+		[]string{"\tLD\t\\$0, ", "\tSD\t\\$0, ", "\tBGEU\t"},
+	},
+	{"riscv", "linux", `
+	func f() uint64 {
+		return 1 << 40
+	}
+`,
+		[]string{"\tADDIW\t\\$1, ZERO, ", "\tSLLI\t\\$40, "},
+	},
+	{"riscv", "linux", `
+	func f() uint64 {
+		return 0x123456789abcdef0
+	}
+`,
+		[]string{"\tLD\t", "\\$i64.123456789abcdef0\\+0\n"},
+	},
+	// Values that RISC-V loads and W instructions have already extended
+	// are not extended again; other extensions use ANDI and ADDIW where
+	// they can.
+	{"riscv", "linux", `
+	func f(p *uint8) uint64 {
+		return uint64(*p)
+	}
+`,
+		[]string{"\tLBU\t.*\n.*\tSD\t"},
+	},
+	{"riscv", "linux", `
+	func f(p *int32) int64 {
+		return int64(*p)
+	}
+`,
+		[]string{"\tLW\t.*\n.*\tSD\t"},
+	},
+	{"riscv", "linux", `
+	func f(x, y int32) int64 {
+		return int64(x * y)
+	}
+`,
+		[]string{"\tMULW\t.*\n.*\tSD\t"},
+	},
+	{"riscv", "linux", `
+	func f(x, y uint8) uint64 {
+		return uint64(x + y)
+	}
+`,
+		[]string{"\tANDI\t\\$255, "},
+	},
+	{"riscv", "linux", `
+	func f(x, y int32) int64 {
+		return int64(x + y)
+	}
+`,
+		[]string{"\tADDIW\t\\$0, "},
+	},
+	// Small constants are folded into RISC-V I-type instructions, and
+	// zero comes from the zero register.
+	{"riscv", "linux", `
+	func f(x int) int {
+		return x + 5
+	}
+`,
+		[]string{"\tADDI\t\\$5, "},
+	},
+	{"riscv", "linux", `
+	func f(x int) bool {
+		return x == 7
+	}
+`,
+		[]string{"\tADDI\t\\$-7, "},
+	},
+	{"riscv", "linux", `
+	func f(x int) bool {
+		return x < 10
+	}
+`,
+		[]string{"\tSLTI\t\\$10, "},
+	},
+	{"riscv", "linux", `
+	func f(x uint) bool {
+		return x <= 100
+	}
+`,
+		[]string{"\tSLTIU\t\\$101, "},
+	},
+	{"riscv", "linux", `
+	func f(p *[4]int) {
+		p[2] = 0
+	}
+`,
+		[]string{"\tSD\t\\$16, ZERO, "},
+	},
+	{"riscv", "linux", `
+	func f(x int) int {
+		return -x
+	}
+`,
+		[]string{"\tSUB\t.*, ZERO, "},
+	},
+	// math intrinsics on RISC-V. x*y+z is not fused.
+	{"riscv", "linux", `
+	func f(x, y, z float64) float64 {
+		return x*y + z
+	}
+`,
+		[]string{"\tFMULD\t", "\tFADDD\t"},
+	},
+	{"riscv", "linux", `
+	import "math"
+	func f(x float64) float64 {
+		return math.Floor(x)
+	}
+`,
+		[]string{"\tFCVTLD.RDN\t"},
+	},
+	{"riscv", "linux", `
+	import "math"
+	func f(x, y float64) float64 {
+		return math.Copysign(math.Abs(x), y)
+	}
+`,
+		[]string{"\tFSGNJXD\t", "\tFSGNJD\t"},
+	},
+	// RV32 words and pointers are 4 bytes; 64-bit arithmetic is done in
+	// register pairs, and constants are built without the W instructions.
+	{"riscv32", "linux", `
+	func f(p *[4]int) {
+		p[2] = 0
+	}
+`,
+		[]string{"\tSW\t\\$8, ZERO, "},
+	},
+	{"riscv32", "linux", `
+	func f(x, y uint64) uint64 {
+		return x + y
+	}
+`,
+		[]string{"\tSLTU\t"},
+	},
+	{"riscv32", "linux", `
+	func f(x, y uint32) uint64 {
+		return uint64(x) * uint64(y)
+	}
+`,
+		[]string{"\tMUL\t", "\tMULHU\t"},
+	},
+	{"riscv32", "linux", `
+	func f() uint32 {
+		return 0x12345678
+	}
+`,
+		[]string{"\tLUI\t\\$74565, ", "\tADDI\t\\$1656, "},
+	},
+	{"riscv32", "linux", `
+	import "runtime/internal/atomic"
+	func f(p *uint32) uint32 {
+		return atomic.Xadd(p, 1)
+	}
+`,
+		[]string{"\tAMOADDW\t"},
+	},
+	// math/bits on RISC-V uses shifts and masks, or the B extension
+	// instructions with GORISCV=b.
+	{"riscv", "linux", `
+	import "math/bits"
+	func f(x uint64) int {
+		return bits.OnesCount64(x)
+	}
+`,
+		[]string{"\tMUL\t", "\tSRLI\t\\$56, "},
+	},
+	{"riscv", "linux", `
+	import "math/bits"
+	func f(x uint64, k int) uint64 {
+		return bits.RotateLeft64(x, k)
+	}
+`,
+		[]string{"\tSLL\t", "\tSRL\t"},
+	},
+	{"riscv/b", "linux", `
+	import "math/bits"
+	func f(x uint64) int {
+		return bits.LeadingZeros64(x)
+	}
+`,
+		[]string{"\tCLZ\t"},
+	},
+	{"riscv/b", "linux", `
+	import "math/bits"
+	func f(x uint64) int {
+		return bits.TrailingZeros64(x)
+	}
+`,
+		[]string{"\tCTZ\t"},
+	},
+	{"riscv/b", "linux", `
+	import "math/bits"
+	func f(x uint64) int {
+		return bits.OnesCount64(x)
+	}
+`,
+		[]string{"\tCPOP\t"},
+	},
+	{"riscv/b", "linux", `
+	import "math/bits"
+	func f(x uint64) uint64 {
+		return bits.ReverseBytes64(x)
+	}
+`,
+		[]string{"\tREV8\t"},
+	},
+	{"riscv/b", "linux", `
+	import "math/bits"
+	func f(x uint64, k int) uint64 {
+		return bits.RotateLeft64(x, k)
+	}
+`,
+		[]string{"\tROL\t"},
+	},
+	{"riscv/b", "linux", `
+	func f(x uint64) uint64 {
+		return x<<13 | x>>51
+	}
+`,
+		[]string{"\tRORI\t\\$51, "},
+	},
+	{"riscv/b", "linux", `
+	func f(x, y uint64) uint64 {
+		return x &^ y
+	}
+`,
+		[]string{"\tANDN\t"},
+	},
+	{"riscv32/b", "linux", `
+	import "math/bits"
+	func f(x uint32) int {
+		return bits.TrailingZeros32(x)
+	}
+`,
+		[]string{"\tCTZ\t"},
+	},
 }
 
 // mergeEnvLists merges the two environment lists such that
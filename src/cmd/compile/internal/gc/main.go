@@ -94,7 +94,7 @@ func doversion() {
 // supportsDynlink reports whether or not the code generator for the given
 // architecture supports the -shared and -dynlink flags.
 func supportsDynlink(arch *sys.Arch) bool {
-	return arch.InFamily(sys.AMD64, sys.ARM, sys.ARM64, sys.I386, sys.PPC64, sys.S390X)
+	return arch.InFamily(sys.AMD64, sys.ARM, sys.ARM64, sys.I386, sys.PPC64, sys.RISCV, sys.S390X)
 }
 
 // timing data for compiler phases
@@ -202,6 +202,10 @@ func Main() {
 	if Thearch.LinkArch.Family == sys.AMD64 {
 		flag.BoolVar(&flag_largemodel, "largemodel", false, "generate code that assumes a large memory model")
 	}
+	var flag_rvc bool
+	if Thearch.LinkArch.InFamily(sys.RISCV, sys.RISCV32) {
+		flag.BoolVar(&flag_rvc, "rvc", obj.GORISCV.C, "emit compressed instructions where possible")
+	}
 	flag.StringVar(&cpuprofile, "cpuprofile", "", "write cpu profile to `file`")
 	flag.StringVar(&memprofile, "memprofile", "", "write memory profile to `file`")
 	flag.Int64Var(&memprofilerate, "memprofilerate", 0, "set runtime.MemProfileRate to `rate`")
@@ -211,6 +215,7 @@ func Main() {
 
 	Ctxt.Flag_shared = flag_dynlink || flag_shared
 	Ctxt.Flag_dynlink = flag_dynlink
+	Ctxt.Flag_rvc = flag_rvc
 	Ctxt.Flag_optimize = Debug['N'] == 0
 
 	Ctxt.Debugasm = int32(Debug['S'])
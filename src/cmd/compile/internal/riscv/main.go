@@ -6,6 +6,7 @@ package riscv
 
 import (
 	"cmd/compile/internal/gc"
+	"cmd/internal/obj"
 	"cmd/internal/obj/riscv"
 )
 
@@ -16,6 +17,10 @@ func Main() {
 	// TODO(prattmic): all the other arches use 50 bits, even though
 	// they have 48-bit vaddrs. why?
 	gc.Thearch.MAXWIDTH = 1 << 50
+	if obj.GOARCH == "riscv32" {
+		gc.Thearch.LinkArch = &riscv.LinkRISCV32
+		gc.Thearch.MAXWIDTH = (1 << 31) - 1
+	}
 
 	gc.Thearch.Defframe = defframe
 	gc.Thearch.Proginfo = proginfo
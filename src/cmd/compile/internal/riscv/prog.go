@@ -25,6 +25,8 @@ var progmap = map[obj.As]gc.ProgInfo{
 	obj.ARET:      {Flags: gc.Break},
 	obj.AJMP:      {Flags: gc.Jump | gc.Break | gc.KillCarry},
 	obj.ACALL:     {Flags: gc.RightAddr | gc.Call | gc.KillCarry},
+	obj.ADUFFZERO: {Flags: gc.Call},
+	obj.ADUFFCOPY: {Flags: gc.Call},
 
 	// NOP is an internal no-op that also stands for USED and SET
 	// annotations.
@@ -64,6 +66,7 @@ var progmap = map[obj.As]gc.ProgInfo{
 	riscv.ASRL:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 	riscv.ASUB:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 	riscv.ASRA:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AADDIW: {Flags: gc.LeftRead | gc.RightWrite},
 
 	// 4.3: Load and Store Instructions
 	riscv.ALD:    {Flags: gc.LeftRead | gc.RightWrite | gc.Move},
@@ -76,6 +79,9 @@ var progmap = map[obj.As]gc.ProgInfo{
 	riscv.AMOVB:  {Flags: gc.LeftRead | gc.RightWrite | gc.Move},
 	riscv.AMOVBU: {Flags: gc.LeftRead | gc.RightWrite | gc.Move},
 
+	// 4.3: Memory Model
+	riscv.AFENCE: {Flags: gc.OK},
+
 	// 5.1: Multiplication Operations
 	riscv.AMUL:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 	riscv.AMULH:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
@@ -90,6 +96,36 @@ var progmap = map[obj.As]gc.ProgInfo{
 	riscv.AREMW:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 	riscv.AREMUW: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 
+	// 6.2: Load-Reserved/Store-Conditional Instructions
+	riscv.ALRW: {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ALRD: {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ASCW: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.ASCD: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+
+	// 6.3: Atomic Memory Operations
+	riscv.AAMOSWAPW: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AAMOSWAPD: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AAMOADDW:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AAMOADDD:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AAMOANDW:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AAMOORW:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+
+	// Bitmanip 2.2: Zbb: Basic bit-manipulation
+	riscv.AANDN:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AORN:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AXNOR:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.ACLZ:   {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ACLZW:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ACTZ:   {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ACTZW:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ACPOP:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ACPOPW: {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AREV8:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AROL:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AROLW:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.ARORI:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.ARORIW: {Flags: gc.LeftRead | gc.RightWrite},
+
 	// 7.5: Single-Precision Load and Store Instructions
 	riscv.AMOVF: {Flags: gc.LeftRead | gc.RightWrite | gc.Move},
 
@@ -109,6 +145,8 @@ var progmap = map[obj.As]gc.ProgInfo{
 	riscv.AFCVTLS:  {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFCVTSW:  {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFCVTSL:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFCVTWUS: {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFCVTSWU: {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFMVSX:   {Flags: gc.LeftRead | gc.RightWrite},
 
 	// 7.8: Single-Precision Floating-Point Compare Instructions
@@ -121,24 +159,32 @@ var progmap = map[obj.As]gc.ProgInfo{
 	riscv.AMOVD: {Flags: gc.LeftRead | gc.RightWrite | gc.Move},
 
 	// 8.3: Double-Precision Floating-Point Computational Instructions
-	riscv.AFADDD:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
-	riscv.AFSUBD:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
-	riscv.AFMULD:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
-	riscv.AFDIVD:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
-	riscv.AFSQRTD: {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFADDD:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AFSUBD:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AFMULD:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AFDIVD:   {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
+	riscv.AFSQRTD:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFMADDD:  {Flags: gc.LeftRead | gc.RegRead | gc.From3Read | gc.RightWrite},
+	riscv.AFMSUBD:  {Flags: gc.LeftRead | gc.RegRead | gc.From3Read | gc.RightWrite},
+	riscv.AFNMADDD: {Flags: gc.LeftRead | gc.RegRead | gc.From3Read | gc.RightWrite},
+	riscv.AFNMSUBD: {Flags: gc.LeftRead | gc.RegRead | gc.From3Read | gc.RightWrite},
 
 	// 8.4: Double-Precision Floating-Point Conversion and Move Instructions
 	riscv.AFSGNJD:  {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 	riscv.AFSGNJND: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 	riscv.AFNEGD:   {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFABSD:   {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFSGNJXD: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
 	riscv.AFCVTWD:  {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFCVTLD:  {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFCVTDW:  {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFCVTDL:  {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFCVTWUD: {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFCVTDWU: {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFCVTSD:  {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFCVTDS:  {Flags: gc.LeftRead | gc.RightWrite},
 	riscv.AFMVDX:   {Flags: gc.LeftRead | gc.RightWrite},
+	riscv.AFMVXD:   {Flags: gc.LeftRead | gc.RightWrite},
 
 	// 8.5: Double-Precision Floating-Point Compare Instructions
 	riscv.AFEQD: {Flags: gc.LeftRead | gc.RegRead | gc.RightWrite},
@@ -166,7 +166,7 @@ func storeByType(t ssa.Type) obj.As {
 // This matches the calculation in ssa.moveSize.
 func largestMove(alignment int64) (obj.As, int64) {
 	switch {
-	case alignment%8 == 0:
+	case alignment%8 == 0 && gc.Widthreg == 8:
 		return riscv.AMOV, 8
 	case alignment%4 == 0:
 		return riscv.AMOVW, 4
@@ -177,6 +177,38 @@ func largestMove(alignment int64) (obj.As, int64) {
 	}
 }
 
+// extendMove extends the low bits bits of v's argument into v's
+// register: SLLI moves them to the top of the register and shr (SRAI or
+// SRLI) moves them back down. A zero shr means the argument is already
+// extended and only needs copying.
+func extendMove(v *ssa.Value, shr obj.As, bits int64) {
+	rs, rd := v.Args[0].Reg(), v.Reg()
+	if shr == 0 {
+		if rs == rd {
+			return
+		}
+		p := gc.Prog(riscv.AMOV)
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = rs
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = rd
+		return
+	}
+	shift := int64(gc.Widthreg)*8 - bits
+	p := gc.Prog(riscv.ASLLI)
+	p.From.Type = obj.TYPE_CONST
+	p.From.Offset = shift
+	p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: rs}
+	p.To.Type = obj.TYPE_REG
+	p.To.Reg = rd
+	p = gc.Prog(shr)
+	p.From.Type = obj.TYPE_CONST
+	p.From.Offset = shift
+	p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: rd}
+	p.To.Type = obj.TYPE_REG
+	p.To.Reg = rd
+}
+
 // markMoves marks any MOVXconst ops that need to avoid clobbering flags.
 // RISC-V has no flags, so this is a no-op.
 func ssaMarkMoves(s *gc.SSAGenState, b *ssa.Block) {}
@@ -191,7 +223,7 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		// input args need no code
 	case ssa.OpPhi:
 		gc.CheckLoweredPhi(v)
-	case ssa.OpCopy, ssa.OpRISCVMOVconvert:
+	case ssa.OpCopy, ssa.OpRISCVMOVconvert, ssa.OpRISCVMOVDreg:
 		if v.Type.IsMemory() {
 			return
 		}
@@ -235,7 +267,7 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		gc.Gvarlive(v.Aux.(*gc.Node))
 	case ssa.OpKeepAlive:
 		gc.KeepAlive(v)
-	case ssa.OpSP, ssa.OpSB, ssa.OpGetG:
+	case ssa.OpSP, ssa.OpSB, ssa.OpGetG, ssa.OpSelect0, ssa.OpSelect1:
 		// nothing to do
 	case ssa.OpRISCVADD, ssa.OpRISCVSUB, ssa.OpRISCVXOR, ssa.OpRISCVOR, ssa.OpRISCVAND,
 		ssa.OpRISCVSLL, ssa.OpRISCVSRA, ssa.OpRISCVSRL,
@@ -243,10 +275,12 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		ssa.OpRISCVMULHU, ssa.OpRISCVDIV, ssa.OpRISCVDIVU, ssa.OpRISCVDIVW,
 		ssa.OpRISCVDIVUW, ssa.OpRISCVREM, ssa.OpRISCVREMU, ssa.OpRISCVREMW,
 		ssa.OpRISCVREMUW,
+		ssa.OpRISCVANDN, ssa.OpRISCVORN, ssa.OpRISCVXNOR, ssa.OpRISCVROL, ssa.OpRISCVROLW,
 		ssa.OpRISCVFADDS, ssa.OpRISCVFSUBS, ssa.OpRISCVFMULS, ssa.OpRISCVFDIVS,
 		ssa.OpRISCVFEQS, ssa.OpRISCVFNES, ssa.OpRISCVFLTS, ssa.OpRISCVFLES,
 		ssa.OpRISCVFADDD, ssa.OpRISCVFSUBD, ssa.OpRISCVFMULD, ssa.OpRISCVFDIVD,
-		ssa.OpRISCVFEQD, ssa.OpRISCVFNED, ssa.OpRISCVFLTD, ssa.OpRISCVFLED:
+		ssa.OpRISCVFEQD, ssa.OpRISCVFNED, ssa.OpRISCVFLTD, ssa.OpRISCVFLED,
+		ssa.OpRISCVFSGNJD:
 		r := v.Reg()
 		r1 := v.Args[0].Reg()
 		r2 := v.Args[1].Reg()
@@ -259,25 +293,93 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		}
 		p.To.Type = obj.TYPE_REG
 		p.To.Reg = r
-	case ssa.OpRISCVFSQRTS, ssa.OpRISCVFNEGS, ssa.OpRISCVFSQRTD, ssa.OpRISCVFNEGD,
+	case ssa.OpRISCVFMADDD, ssa.OpRISCVFMSUBD, ssa.OpRISCVFNMADDD, ssa.OpRISCVFNMSUBD:
+		p := gc.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = v.Args[2].Reg()
+		p.Reg = v.Args[1].Reg()
+		p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: v.Args[0].Reg()}
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg()
+	case ssa.OpRISCVFSQRTS, ssa.OpRISCVFNEGS, ssa.OpRISCVFSQRTD, ssa.OpRISCVFNEGD, ssa.OpRISCVFABSD,
 		ssa.OpRISCVFMVSX, ssa.OpRISCVFMVDX,
 		ssa.OpRISCVFCVTSW, ssa.OpRISCVFCVTSL, ssa.OpRISCVFCVTWS, ssa.OpRISCVFCVTLS,
-		ssa.OpRISCVFCVTDW, ssa.OpRISCVFCVTDL, ssa.OpRISCVFCVTWD, ssa.OpRISCVFCVTLD, ssa.OpRISCVFCVTDS, ssa.OpRISCVFCVTSD:
+		ssa.OpRISCVFCVTDW, ssa.OpRISCVFCVTDL, ssa.OpRISCVFCVTWD, ssa.OpRISCVFCVTLD, ssa.OpRISCVFCVTDS, ssa.OpRISCVFCVTSD,
+		ssa.OpRISCVFCVTSWU, ssa.OpRISCVFCVTWUS, ssa.OpRISCVFCVTDWU, ssa.OpRISCVFCVTWUD:
 		p := gc.Prog(v.Op.Asm())
 		p.From.Type = obj.TYPE_REG
 		p.From.Reg = v.Args[0].Reg()
 		p.To.Type = obj.TYPE_REG
 		p.To.Reg = v.Reg()
-	case ssa.OpRISCVADDI, ssa.OpRISCVXORI, ssa.OpRISCVORI, ssa.OpRISCVANDI,
+	case ssa.OpRISCVFMOVDconst:
+		// The assembler turns this into a load from a literal pool symbol.
+		p := gc.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_FCONST
+		p.From.Val = math.Float64frombits(uint64(v.AuxInt))
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg()
+	case ssa.OpRISCVMOVBreg, ssa.OpRISCVMOVHreg, ssa.OpRISCVMOVWreg,
+		ssa.OpRISCVMOVBUreg, ssa.OpRISCVMOVHUreg, ssa.OpRISCVMOVWUreg:
+		a := v.Args[0]
+		for a.Op == ssa.OpCopy || a.Op == ssa.OpRISCVMOVDreg {
+			a = a.Args[0]
+		}
+		if a.Op == ssa.OpLoadReg {
+			t := a.Type
+			switch {
+			case v.Op == ssa.OpRISCVMOVBreg && t.Size() == 1 && t.IsSigned(),
+				v.Op == ssa.OpRISCVMOVHreg && t.Size() == 2 && t.IsSigned(),
+				v.Op == ssa.OpRISCVMOVWreg && t.Size() == 4 && t.IsSigned(),
+				v.Op == ssa.OpRISCVMOVBUreg && t.Size() == 1 && !t.IsSigned(),
+				v.Op == ssa.OpRISCVMOVHUreg && t.Size() == 2 && !t.IsSigned(),
+				v.Op == ssa.OpRISCVMOVWUreg && t.Size() == 4 && !t.IsSigned():
+				// arg is a proper-typed load, already zero/sign-extended, don't extend again
+				extendMove(v, 0, 0)
+				return
+			}
+		}
+		switch v.Op {
+		case ssa.OpRISCVMOVBreg:
+			extendMove(v, riscv.ASRAI, 8)
+		case ssa.OpRISCVMOVHreg:
+			extendMove(v, riscv.ASRAI, 16)
+		case ssa.OpRISCVMOVWreg:
+			if gc.Widthreg == 8 {
+				// ADDIW sign extends its 32-bit result.
+				p := gc.Prog(riscv.AADDIW)
+				p.From.Type = obj.TYPE_CONST
+				p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: v.Args[0].Reg()}
+				p.To.Type = obj.TYPE_REG
+				p.To.Reg = v.Reg()
+			} else {
+				extendMove(v, 0, 0)
+			}
+		case ssa.OpRISCVMOVBUreg:
+			p := gc.Prog(riscv.AANDI)
+			p.From.Type = obj.TYPE_CONST
+			p.From.Offset = 0xff
+			p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: v.Args[0].Reg()}
+			p.To.Type = obj.TYPE_REG
+			p.To.Reg = v.Reg()
+		case ssa.OpRISCVMOVHUreg:
+			extendMove(v, riscv.ASRLI, 16)
+		case ssa.OpRISCVMOVWUreg:
+			if gc.Widthreg == 8 {
+				extendMove(v, riscv.ASRLI, 32)
+			} else {
+				extendMove(v, 0, 0)
+			}
+		}
+	case ssa.OpRISCVADDI, ssa.OpRISCVADDIW, ssa.OpRISCVXORI, ssa.OpRISCVORI, ssa.OpRISCVANDI,
 		ssa.OpRISCVSLLI, ssa.OpRISCVSRAI, ssa.OpRISCVSRLI, ssa.OpRISCVSLTI,
-		ssa.OpRISCVSLTIU:
+		ssa.OpRISCVSLTIU, ssa.OpRISCVRORI, ssa.OpRISCVRORIW:
 		p := gc.Prog(v.Op.Asm())
 		p.From.Type = obj.TYPE_CONST
 		p.From.Offset = v.AuxInt
 		p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: v.Args[0].Reg()}
 		p.To.Type = obj.TYPE_REG
 		p.To.Reg = v.Reg()
-	case ssa.OpRISCVMOVBconst, ssa.OpRISCVMOVHconst, ssa.OpRISCVMOVWconst, ssa.OpRISCVMOVDconst:
+	case ssa.OpRISCVMOVDconst:
 		p := gc.Prog(v.Op.Asm())
 		p.From.Type = obj.TYPE_CONST
 		p.From.Offset = v.AuxInt
@@ -334,7 +436,23 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		p.To.Type = obj.TYPE_MEM
 		p.To.Reg = v.Args[0].Reg()
 		gc.AddAux(&p.To, v)
-	case ssa.OpRISCVSEQZ, ssa.OpRISCVSNEZ:
+	case ssa.OpRISCVMOVBstorezero, ssa.OpRISCVMOVHstorezero, ssa.OpRISCVMOVWstorezero, ssa.OpRISCVMOVDstorezero:
+		p := gc.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = riscv.REG_ZERO
+		p.To.Type = obj.TYPE_MEM
+		p.To.Reg = v.Args[0].Reg()
+		gc.AddAux(&p.To, v)
+	case ssa.OpRISCVNEG:
+		p := gc.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = v.Args[0].Reg()
+		p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: riscv.REG_ZERO}
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg()
+	case ssa.OpRISCVSEQZ, ssa.OpRISCVSNEZ,
+		ssa.OpRISCVCLZ, ssa.OpRISCVCLZW, ssa.OpRISCVCTZ, ssa.OpRISCVCTZW,
+		ssa.OpRISCVCPOP, ssa.OpRISCVCPOPW, ssa.OpRISCVREV8:
 		p := gc.Prog(v.Op.Asm())
 		p.From.Type = obj.TYPE_REG
 		p.From.Reg = v.Args[0].Reg()
@@ -372,6 +490,38 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 			gc.Maxarg = v.AuxInt
 		}
 
+	case ssa.OpRISCVDUFFZERO:
+		// runtime·duffzero stores a word per 4-byte instruction, at
+		// offsets starting from 0(T0). Skipping instructions skips
+		// the lowest offsets, so move T0 back to compensate.
+		p := gc.Prog(riscv.AADDI)
+		p.From.Type = obj.TYPE_CONST
+		p.From.Offset = -v.AuxInt / 4 * int64(gc.Widthreg)
+		p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: riscv.REG_T0}
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = riscv.REG_T0
+		p = gc.Prog(obj.ADUFFZERO)
+		p.To.Type = obj.TYPE_MEM
+		p.To.Name = obj.NAME_EXTERN
+		p.To.Sym = gc.Linksym(gc.Pkglookup("duffzero", gc.Runtimepkg))
+		p.To.Offset = v.AuxInt
+
+	case ssa.OpRISCVDUFFCOPY:
+		// As for duffzero, but each word takes a load and a store.
+		for _, r := range []int16{riscv.REG_T0, riscv.REG_T1} {
+			p := gc.Prog(riscv.AADDI)
+			p.From.Type = obj.TYPE_CONST
+			p.From.Offset = -v.AuxInt / 8 * int64(gc.Widthreg)
+			p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: r}
+			p.To.Type = obj.TYPE_REG
+			p.To.Reg = r
+		}
+		p := gc.Prog(obj.ADUFFCOPY)
+		p.To.Type = obj.TYPE_MEM
+		p.To.Name = obj.NAME_EXTERN
+		p.To.Sym = gc.Linksym(gc.Pkglookup("duffcopy", gc.Runtimepkg))
+		p.To.Offset = v.AuxInt
+
 	case ssa.OpRISCVLoweredZero:
 		mov, sz := largestMove(v.AuxInt)
 
@@ -438,6 +588,190 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 		p5.From.Reg = v.Args[2].Reg()
 		gc.Patch(p5, p)
 
+	case ssa.OpRISCVLoweredAtomicLoad32, ssa.OpRISCVLoweredAtomicLoad64:
+		as := riscv.AMOV
+		if v.Op == ssa.OpRISCVLoweredAtomicLoad32 {
+			as = riscv.AMOVWU
+		}
+		gc.Prog(riscv.AFENCE)
+		p := gc.Prog(as)
+		p.From.Type = obj.TYPE_MEM
+		p.From.Reg = v.Args[0].Reg()
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg0()
+		gc.Prog(riscv.AFENCE)
+
+	case ssa.OpRISCVLoweredAtomicStore32, ssa.OpRISCVLoweredAtomicStore64,
+		ssa.OpRISCVLoweredAtomicAnd32, ssa.OpRISCVLoweredAtomicOr32:
+		p := gc.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = v.Args[1].Reg()
+		p.From3 = &obj.Addr{Type: obj.TYPE_MEM, Reg: v.Args[0].Reg()}
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = riscv.REG_ZERO
+
+	case ssa.OpRISCVLoweredAtomicExchange32, ssa.OpRISCVLoweredAtomicExchange64:
+		p := gc.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = v.Args[1].Reg()
+		p.From3 = &obj.Addr{Type: obj.TYPE_MEM, Reg: v.Args[0].Reg()}
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg0()
+
+	case ssa.OpRISCVLoweredAtomicAdd32, ssa.OpRISCVLoweredAtomicAdd64:
+		// The AMO returns the old value; add arg1 again for the new one.
+		p := gc.Prog(v.Op.Asm())
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = v.Args[1].Reg()
+		p.From3 = &obj.Addr{Type: obj.TYPE_MEM, Reg: v.Args[0].Reg()}
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg0()
+
+		p2 := gc.Prog(riscv.AADD)
+		p2.From.Type = obj.TYPE_REG
+		p2.From.Reg = v.Args[1].Reg()
+		p2.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: v.Reg0()}
+		p2.To.Type = obj.TYPE_REG
+		p2.To.Reg = v.Reg0()
+
+	case ssa.OpRISCVLoweredAtomicCas32, ssa.OpRISCVLoweredAtomicCas64:
+		//	MOV	ZERO, Rout
+		//	LR	(Rarg0), Rtmp
+		//	BNE	Rtmp, Rarg1, 3(PC)
+		//	SC	Rarg2, (Rarg0), Rtmp
+		//	BNE	Rtmp, ZERO, -3(PC)
+		//	MOV	$1, Rout
+
+		lr, sc := riscv.ALRD, riscv.ASCD
+		if v.Op == ssa.OpRISCVLoweredAtomicCas32 {
+			lr, sc = riscv.ALRW, riscv.ASCW
+		}
+
+		p := gc.Prog(riscv.AMOV)
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = riscv.REG_ZERO
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg0()
+
+		p1 := gc.Prog(lr)
+		p1.From.Type = obj.TYPE_MEM
+		p1.From.Reg = v.Args[0].Reg()
+		p1.To.Type = obj.TYPE_REG
+		p1.To.Reg = riscv.REG_TMP
+
+		p2 := gc.Prog(riscv.ABNE)
+		p2.From.Type = obj.TYPE_REG
+		p2.From.Reg = riscv.REG_TMP
+		p2.Reg = v.Args[1].Reg()
+		p2.To.Type = obj.TYPE_BRANCH
+
+		p3 := gc.Prog(sc)
+		p3.From.Type = obj.TYPE_REG
+		p3.From.Reg = v.Args[2].Reg()
+		p3.From3 = &obj.Addr{Type: obj.TYPE_MEM, Reg: v.Args[0].Reg()}
+		p3.To.Type = obj.TYPE_REG
+		p3.To.Reg = riscv.REG_TMP
+
+		p4 := gc.Prog(riscv.ABNE)
+		p4.From.Type = obj.TYPE_REG
+		p4.From.Reg = riscv.REG_TMP
+		p4.Reg = riscv.REG_ZERO
+		p4.To.Type = obj.TYPE_BRANCH
+		gc.Patch(p4, p1)
+
+		p5 := gc.Prog(riscv.AMOV)
+		p5.From.Type = obj.TYPE_CONST
+		p5.From.Offset = 1
+		p5.To.Type = obj.TYPE_REG
+		p5.To.Reg = v.Reg0()
+
+		p6 := gc.Prog(obj.ANOP)
+		gc.Patch(p2, p6)
+
+	case ssa.OpRISCVLoweredFloorD, ssa.OpRISCVLoweredCeilD, ssa.OpRISCVLoweredTruncD:
+		// Values with an exponent of at least 52 are already integers,
+		// and so are infinities and NaNs. Others fit in an int64.
+		//
+		//	MOVD	Rarg0, Rout
+		//	FMVXD	Rarg0, TMP
+		//	SRLI	$52, TMP, TMP
+		//	ANDI	$0x7ff, TMP, TMP
+		//	ADDI	$-(1023+52), TMP, TMP
+		//	BGE	TMP, ZERO, 4(PC)
+		//	FCVTLD.rm	Rarg0, TMP
+		//	FCVTDL	TMP, Rout
+		//	FSGNJD	Rarg0, Rout, Rout	// keep the sign of -0.5 and the like
+
+		rm := uint8(riscv.RM_RDN)
+		switch v.Op {
+		case ssa.OpRISCVLoweredCeilD:
+			rm = riscv.RM_RUP
+		case ssa.OpRISCVLoweredTruncD:
+			rm = riscv.RM_RTZ
+		}
+
+		p := gc.Prog(riscv.AMOVD)
+		p.From.Type = obj.TYPE_REG
+		p.From.Reg = v.Args[0].Reg()
+		p.To.Type = obj.TYPE_REG
+		p.To.Reg = v.Reg()
+
+		p1 := gc.Prog(riscv.AFMVXD)
+		p1.From.Type = obj.TYPE_REG
+		p1.From.Reg = v.Args[0].Reg()
+		p1.To.Type = obj.TYPE_REG
+		p1.To.Reg = riscv.REG_TMP
+
+		p2 := gc.Prog(riscv.ASRLI)
+		p2.From.Type = obj.TYPE_CONST
+		p2.From.Offset = 52
+		p2.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: riscv.REG_TMP}
+		p2.To.Type = obj.TYPE_REG
+		p2.To.Reg = riscv.REG_TMP
+
+		p3 := gc.Prog(riscv.AANDI)
+		p3.From.Type = obj.TYPE_CONST
+		p3.From.Offset = 0x7ff
+		p3.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: riscv.REG_TMP}
+		p3.To.Type = obj.TYPE_REG
+		p3.To.Reg = riscv.REG_TMP
+
+		p4 := gc.Prog(riscv.AADDI)
+		p4.From.Type = obj.TYPE_CONST
+		p4.From.Offset = -(1023 + 52)
+		p4.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: riscv.REG_TMP}
+		p4.To.Type = obj.TYPE_REG
+		p4.To.Reg = riscv.REG_TMP
+
+		p5 := gc.Prog(riscv.ABGE)
+		p5.From.Type = obj.TYPE_REG
+		p5.From.Reg = riscv.REG_TMP
+		p5.Reg = riscv.REG_ZERO
+		p5.To.Type = obj.TYPE_BRANCH
+
+		p6 := gc.Prog(riscv.AFCVTLD)
+		p6.Scond = rm
+		p6.From.Type = obj.TYPE_REG
+		p6.From.Reg = v.Args[0].Reg()
+		p6.To.Type = obj.TYPE_REG
+		p6.To.Reg = riscv.REG_TMP
+
+		p7 := gc.Prog(riscv.AFCVTDL)
+		p7.From.Type = obj.TYPE_REG
+		p7.From.Reg = riscv.REG_TMP
+		p7.To.Type = obj.TYPE_REG
+		p7.To.Reg = v.Reg()
+
+		p8 := gc.Prog(riscv.AFSGNJD)
+		p8.From.Type = obj.TYPE_REG
+		p8.From.Reg = v.Args[0].Reg()
+		p8.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: v.Reg()}
+		p8.To.Type = obj.TYPE_REG
+		p8.To.Reg = v.Reg()
+
+		p9 := gc.Prog(obj.ANOP)
+		gc.Patch(p5, p9)
+
 	case ssa.OpRISCVLoweredNilCheck:
 		// Issue a load which will fault if arg is nil.
 		// TODO: optimizations. See arm and amd64 LoweredNilCheck.
@@ -458,6 +792,24 @@ func ssaGenValue(s *gc.SSAGenState, v *ssa.Value) {
 	}
 }
 
+// blockBranch maps a conditional block kind to the branch instruction
+// that implements it. The zero-compare kinds use the ZERO register as one
+// of the operands: BLEZ x is BGE ZERO, x and BGTZ x is BLT ZERO, x.
+var blockBranch = map[ssa.BlockKind]obj.As{
+	ssa.BlockRISCVBEQ:  riscv.ABEQ,
+	ssa.BlockRISCVBNE:  riscv.ABNE,
+	ssa.BlockRISCVBLT:  riscv.ABLT,
+	ssa.BlockRISCVBGE:  riscv.ABGE,
+	ssa.BlockRISCVBLTU: riscv.ABLTU,
+	ssa.BlockRISCVBGEU: riscv.ABGEU,
+	ssa.BlockRISCVBEQZ: riscv.ABEQ,
+	ssa.BlockRISCVBNEZ: riscv.ABNE,
+	ssa.BlockRISCVBLEZ: riscv.ABGE,
+	ssa.BlockRISCVBGEZ: riscv.ABGE,
+	ssa.BlockRISCVBLTZ: riscv.ABLT,
+	ssa.BlockRISCVBGTZ: riscv.ABLT,
+}
+
 func ssaGenBlock(s *gc.SSAGenState, b, next *ssa.Block) {
 	s.SetPos(b.Pos)
 
@@ -492,25 +844,40 @@ func ssaGenBlock(s *gc.SSAGenState, b, next *ssa.Block) {
 		p.To.Type = obj.TYPE_MEM
 		p.To.Name = obj.NAME_EXTERN
 		p.To.Sym = gc.Linksym(b.Aux.(*gc.Sym))
-	case ssa.BlockRISCVBNE:
-		// Conditional branch if Control != 0.
-		p := gc.Prog(riscv.ABNE)
-		p.To.Type = obj.TYPE_BRANCH
-		p.Reg = b.Control.Reg()
-		p.From.Type = obj.TYPE_REG
-		p.From.Reg = riscv.REG_ZERO
+	case ssa.BlockRISCVBEQ, ssa.BlockRISCVBNE, ssa.BlockRISCVBLT, ssa.BlockRISCVBGE,
+		ssa.BlockRISCVBLTU, ssa.BlockRISCVBGEU, ssa.BlockRISCVBEQZ, ssa.BlockRISCVBNEZ,
+		ssa.BlockRISCVBLEZ, ssa.BlockRISCVBGEZ, ssa.BlockRISCVBLTZ, ssa.BlockRISCVBGTZ:
+		as := blockBranch[b.Kind]
+		var p *obj.Prog
 		switch next {
 		case b.Succs[0].Block():
-			p.As = riscv.InvertBranch(p.As)
+			p = gc.Prog(riscv.InvertBranch(as))
 			s.Branches = append(s.Branches, gc.Branch{P: p, B: b.Succs[1].Block()})
 		case b.Succs[1].Block():
+			p = gc.Prog(as)
 			s.Branches = append(s.Branches, gc.Branch{P: p, B: b.Succs[0].Block()})
 		default:
+			p = gc.Prog(as)
 			s.Branches = append(s.Branches, gc.Branch{P: p, B: b.Succs[0].Block()})
 			q := gc.Prog(obj.AJMP)
 			q.To.Type = obj.TYPE_BRANCH
 			s.Branches = append(s.Branches, gc.Branch{P: q, B: b.Succs[1].Block()})
 		}
+		p.To.Type = obj.TYPE_BRANCH
+		p.From.Type = obj.TYPE_REG
+
+		// The branch compares From against Reg.
+		switch b.Kind {
+		case ssa.BlockRISCVBEQZ, ssa.BlockRISCVBNEZ, ssa.BlockRISCVBLTZ, ssa.BlockRISCVBGEZ:
+			p.From.Reg = b.Control.Reg()
+			p.Reg = riscv.REG_ZERO
+		case ssa.BlockRISCVBLEZ, ssa.BlockRISCVBGTZ:
+			p.From.Reg = riscv.REG_ZERO
+			p.Reg = b.Control.Reg()
+		default:
+			p.From.Reg = b.Control.Reg()
+			p.Reg = b.Control2.Reg()
+		}
 
 	default:
 		b.Fatalf("Unhandled kind %v", b.Kind)
@@ -45,6 +45,10 @@ type Block struct {
 	// has a memory control value.
 	Control *Value
 
+	// A second control value, used only by block kinds that compare two
+	// values, such as RISC-V's BLT. It is nil for all other kinds.
+	Control2 *Value
+
 	// Auxiliary info for the block. Its value depends on the Kind.
 	Aux interface{}
 
@@ -118,6 +122,9 @@ func (b *Block) LongString() string {
 	if b.Control != nil {
 		s += fmt.Sprintf(" %s", b.Control)
 	}
+	if b.Control2 != nil {
+		s += fmt.Sprintf(" %s", b.Control2)
+	}
 	if len(b.Succs) > 0 {
 		s += " ->"
 		for _, c := range b.Succs {
@@ -143,6 +150,16 @@ func (b *Block) SetControl(v *Value) {
 	}
 }
 
+func (b *Block) SetControl2(v *Value) {
+	if w := b.Control2; w != nil {
+		w.Uses--
+	}
+	b.Control2 = v
+	if v != nil {
+		v.Uses++
+	}
+}
+
 // AddEdgeTo adds an edge from block b to block c. Used during building of the
 // SSA graph; do not use on an already-completed SSA graph.
 func (b *Block) AddEdgeTo(c *Block) {
@@ -98,6 +98,13 @@ func checkFunc(f *Func) {
 				f.Fatalf("plain/dead block %s has a control value", b)
 			}
 		}
+		if b.Kind.twoControls() {
+			if b.Control == nil || b.Control2 == nil {
+				f.Fatalf("block %s kind %s needs two control values", b, b.Kind)
+			}
+		} else if b.Control2 != nil {
+			f.Fatalf("block %s kind %s has second control value %s", b, b.Kind, b.Control2.LongString())
+		}
 		if len(b.Succs) > 2 && b.Likely != BranchUnknown {
 			f.Fatalf("likeliness prediction %d for block %s with %d successors", b.Likely, b, len(b.Succs))
 		}
@@ -239,6 +246,9 @@ func checkFunc(f *Func) {
 		if b.Control != nil && !valueMark[b.Control.ID] {
 			f.Fatalf("control value for %s is missing: %v", b, b.Control)
 		}
+		if b.Control2 != nil && !valueMark[b.Control2.ID] {
+			f.Fatalf("second control value for %s is missing: %v", b, b.Control2)
+		}
 	}
 	for b := f.freeBlocks; b != nil; b = b.succstorage[0].b {
 		if blockMark[b.ID] {
@@ -272,6 +282,9 @@ func checkFunc(f *Func) {
 			if b.Control != nil && !domCheck(f, sdom, b.Control.Block, b) {
 				f.Fatalf("control value %s for %s doesn't dominate", b.Control, b)
 			}
+			if b.Control2 != nil && !domCheck(f, sdom, b.Control2.Block, b) {
+				f.Fatalf("second control value %s for %s doesn't dominate", b.Control2, b)
+			}
 		}
 	}
 
@@ -286,6 +299,9 @@ func checkFunc(f *Func) {
 		if b.Control != nil {
 			uses[b.Control.ID]++
 		}
+		if b.Control2 != nil {
+			uses[b.Control2.ID]++
+		}
 	}
 	for _, b := range f.Blocks {
 		for _, v := range b.Values {
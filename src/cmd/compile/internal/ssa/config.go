@@ -35,6 +35,7 @@ type Config struct {
 	noDuffDevice    bool                       // Don't use Duff's device
 	nacl            bool                       // GOOS=nacl
 	use387          bool                       // GO386=387
+	riscvB          bool                       // GORISCV includes the B extension
 	OldArch         bool                       // True for older versions of architecture, e.g. true for PPC64BE, false for PPC64LE
 	NeedsFpScratch  bool                       // No direct move between GP and FP register sets
 	BigEndian       bool                       //
@@ -281,6 +282,19 @@ func NewConfig(arch string, fe Frontend, ctxt *obj.Link, optimize bool) *Config
 		c.fpRegMask = fpRegMaskRISCV
 		c.FPReg = framepointerRegRISCV
 		c.hasGReg = true
+		c.riscvB = obj.GORISCV.B
+	case "riscv32":
+		c.IntSize = 4
+		c.PtrSize = 4
+		c.RegSize = 4
+		c.lowerBlock = rewriteBlockRISCV
+		c.lowerValue = rewriteValueRISCV
+		c.registers = registersRISCV[:]
+		c.gpRegMask = gpRegMaskRISCV
+		c.fpRegMask = fpRegMaskRISCV
+		c.FPReg = framepointerRegRISCV
+		c.hasGReg = true
+		c.riscvB = obj.GORISCV.B
 	default:
 		fe.Fatalf(src.NoXPos, "arch %s not implemented", arch)
 	}
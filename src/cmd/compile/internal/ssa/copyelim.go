@@ -20,6 +20,9 @@ func copyelim(f *Func) {
 		if v := b.Control; v != nil && v.Op == OpCopy {
 			b.SetControl(v.Args[0])
 		}
+		if v := b.Control2; v != nil && v.Op == OpCopy {
+			b.SetControl2(v.Args[0])
+		}
 	}
 
 	// Update named values.
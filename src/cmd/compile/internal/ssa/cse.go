@@ -245,6 +245,11 @@ func cse(f *Func) {
 				b.SetControl(x)
 			}
 		}
+		if v := b.Control2; v != nil {
+			if x := rewrite[v.ID]; x != nil {
+				b.SetControl2(x)
+			}
+		}
 	}
 	if f.pass.stats > 0 {
 		f.LogStat("CSE REWRITES", rewrites)
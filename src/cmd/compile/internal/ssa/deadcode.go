@@ -63,6 +63,10 @@ func liveValues(f *Func, reachable []bool) []bool {
 			live[v.ID] = true
 			q = append(q, v)
 		}
+		if v := b.Control2; v != nil && !live[v.ID] {
+			live[v.ID] = true
+			q = append(q, v)
+		}
 		for _, v := range b.Values {
 			if opcodeTable[v.Op].call && !live[v.ID] {
 				live[v.ID] = true
@@ -177,6 +181,7 @@ func deadcode(f *Func) {
 	for _, b := range f.Blocks {
 		if !reachable[b.ID] {
 			b.SetControl(nil)
+			b.SetControl2(nil)
 		}
 		for _, v := range b.Values {
 			if !live[v.ID] {
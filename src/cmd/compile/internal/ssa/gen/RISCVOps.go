@@ -39,8 +39,9 @@ func init() {
 
 		// Add general purpose registers to gpMask.
 		switch r {
-		// ZERO, g, and TMP are not in any gp mask.
-		case riscv.REG_ZERO, riscv.REG_G, riscv.REG_TMP:
+		// ZERO, g, and TMP are not in any gp mask. GP and TP
+		// belong to the C ABI and must survive calls into Go.
+		case riscv.REG_ZERO, riscv.REG_GP, riscv.REG_TP, riscv.REG_G, riscv.REG_TMP:
 		case riscv.REG_SP:
 			gpspMask |= mask
 			gpspsbMask |= mask
@@ -70,15 +71,24 @@ func init() {
 	callerSave := gpMask | fpMask | regNamed["g"]
 
 	var (
-		gpstore = regInfo{inputs: []regMask{gpspsbMask, gpspMask, 0}} // SB in first input so we can load from a global, but not in second to avoid using SB as a temporary register
-		gp01    = regInfo{outputs: []regMask{gpMask}}
-		gp11    = regInfo{inputs: []regMask{gpMask}, outputs: []regMask{gpMask}}
-		gp21    = regInfo{inputs: []regMask{gpMask, gpMask}, outputs: []regMask{gpMask}}
-		gpload  = regInfo{inputs: []regMask{gpspsbMask, 0}, outputs: []regMask{gpMask}}
-		gp11sb  = regInfo{inputs: []regMask{gpspsbMask}, outputs: []regMask{gpMask}}
-
+		gpstore  = regInfo{inputs: []regMask{gpspsbMask, gpspMask, 0}} // SB in first input so we can load from a global, but not in second to avoid using SB as a temporary register
+		gpstore0 = regInfo{inputs: []regMask{gpspsbMask, 0}}
+		gp01     = regInfo{outputs: []regMask{gpMask}}
+		gp11     = regInfo{inputs: []regMask{gpMask}, outputs: []regMask{gpMask}}
+		gp21     = regInfo{inputs: []regMask{gpMask, gpMask}, outputs: []regMask{gpMask}}
+		gpload   = regInfo{inputs: []regMask{gpspsbMask, 0}, outputs: []regMask{gpMask}}
+		gp11sb   = regInfo{inputs: []regMask{gpspsbMask}, outputs: []regMask{gpMask}}
+
+		// Atomic loads and stores take the address in a register; AMOs
+		// have no offset field, so SB is never a valid base.
+		gpxchg   = regInfo{inputs: []regMask{gpspMask, gpMask}, outputs: []regMask{gpMask}}
+		gpcas    = regInfo{inputs: []regMask{gpspMask, gpMask, gpMask}, outputs: []regMask{gpMask}}
+		gpatomic = regInfo{inputs: []regMask{gpspMask, gpMask}}
+
+		fp01    = regInfo{outputs: []regMask{fpMask}}
 		fp11    = regInfo{inputs: []regMask{fpMask}, outputs: []regMask{fpMask}}
 		fp21    = regInfo{inputs: []regMask{fpMask, fpMask}, outputs: []regMask{fpMask}}
+		fp31    = regInfo{inputs: []regMask{fpMask, fpMask, fpMask}, outputs: []regMask{fpMask}}
 		gpfp    = regInfo{inputs: []regMask{gpMask}, outputs: []regMask{fpMask}}
 		fpgp    = regInfo{inputs: []regMask{fpMask}, outputs: []regMask{gpMask}}
 		fpstore = regInfo{inputs: []regMask{gpspsbMask, fpMask, 0}}
@@ -93,7 +103,9 @@ func init() {
 	RISCVops := []opData{
 		{name: "ADD", argLength: 2, reg: gp21, asm: "ADD", commutative: true}, // arg0 + arg1
 		{name: "ADDI", argLength: 1, reg: gp11sb, asm: "ADDI", aux: "Int64"},  // arg0 + auxint
+		{name: "ADDIW", argLength: 1, reg: gp11, asm: "ADDIW", aux: "Int64"},  // 32 low bits of arg0 + auxint, sign extended to 64 bits
 		{name: "SUB", argLength: 2, reg: gp21, asm: "SUB"},                    // arg0 - arg1
+		{name: "NEG", argLength: 1, reg: gp11, asm: "SUB"},                    // -arg0, as ZERO - arg0
 
 		// M extension. H means high (i.e., it returns the top bits of
 		// the result). U means unsigned. W means word (i.e., 32-bit).
@@ -113,9 +125,6 @@ func init() {
 		{name: "MOVaddr", argLength: 1, reg: gp11sb, asm: "MOV", aux: "SymOff", rematerializeable: true}, // arg0 + auxint + offset encoded in aux
 		// auxint+aux == add auxint and the offset of the symbol in aux (if any) to the effective address
 
-		{name: "MOVBconst", reg: gp01, asm: "MOV", typ: "UInt8", aux: "Int8", rematerializeable: true},    // 8 low bits of auxint
-		{name: "MOVHconst", reg: gp01, asm: "MOV", typ: "UInt16", aux: "Int16", rematerializeable: true},  // 16 low bits of auxint
-		{name: "MOVWconst", reg: gp01, asm: "MOV", typ: "UInt32", aux: "Int32", rematerializeable: true},  // 32 low bits of auxint
 		{name: "MOVDconst", reg: gp01, asm: "MOV", typ: "UInt64", aux: "Int64", rematerializeable: true},  // auxint
 		{name: "MOVSconst", reg: gp01, asm: "MOV", typ: "Float32", aux: "Int32", rematerializeable: true}, // auxint as float
 
@@ -128,12 +137,29 @@ func init() {
 		{name: "MOVHUload", argLength: 2, reg: gpload, asm: "MOVHU", aux: "SymOff", typ: "UInt16", faultOnNilArg0: true}, // 16 bits, zero extend
 		{name: "MOVWUload", argLength: 2, reg: gpload, asm: "MOVWU", aux: "SymOff", typ: "UInt32", faultOnNilArg0: true}, // 32 bits, zero extend
 
+		// Extensions of the low <size> bits of arg0 to the full register.
+		// The code generator picks the instructions, which differ between
+		// RV32 and RV64.
+		{name: "MOVBreg", argLength: 1, reg: gp11},             //  8 bits, sign extend
+		{name: "MOVHreg", argLength: 1, reg: gp11},             // 16 bits, sign extend
+		{name: "MOVWreg", argLength: 1, reg: gp11},             // 32 bits, sign extend
+		{name: "MOVBUreg", argLength: 1, reg: gp11},            //  8 bits, zero extend
+		{name: "MOVHUreg", argLength: 1, reg: gp11},            // 16 bits, zero extend
+		{name: "MOVWUreg", argLength: 1, reg: gp11},            // 32 bits, zero extend
+		{name: "MOVDreg", argLength: 1, reg: gp11, asm: "MOV"}, // arg0, which is already extended
+
 		// Stores: store <size> lowest bits in arg1 to arg0+auxint+aux; arg2=mem
 		{name: "MOVBstore", argLength: 3, reg: gpstore, asm: "MOVB", aux: "SymOff", typ: "Mem", faultOnNilArg0: true}, //  8 bits
 		{name: "MOVHstore", argLength: 3, reg: gpstore, asm: "MOVH", aux: "SymOff", typ: "Mem", faultOnNilArg0: true}, // 16 bits
 		{name: "MOVWstore", argLength: 3, reg: gpstore, asm: "MOVW", aux: "SymOff", typ: "Mem", faultOnNilArg0: true}, // 32 bits
 		{name: "MOVDstore", argLength: 3, reg: gpstore, asm: "MOV", aux: "SymOff", typ: "Mem", faultOnNilArg0: true},  // 64 bits
 
+		// Stores of the zero register: store <size> zero bits to arg0+auxint+aux; arg1=mem
+		{name: "MOVBstorezero", argLength: 2, reg: gpstore0, asm: "MOVB", aux: "SymOff", typ: "Mem", faultOnNilArg0: true}, //  8 bits
+		{name: "MOVHstorezero", argLength: 2, reg: gpstore0, asm: "MOVH", aux: "SymOff", typ: "Mem", faultOnNilArg0: true}, // 16 bits
+		{name: "MOVWstorezero", argLength: 2, reg: gpstore0, asm: "MOVW", aux: "SymOff", typ: "Mem", faultOnNilArg0: true}, // 32 bits
+		{name: "MOVDstorezero", argLength: 2, reg: gpstore0, asm: "MOV", aux: "SymOff", typ: "Mem", faultOnNilArg0: true},  // 64 bits
+
 		// Shift ops
 		{name: "SLL", argLength: 2, reg: gp21, asm: "SLL"},                 // arg0 << aux1
 		{name: "SRA", argLength: 2, reg: gp21, asm: "SRA"},                 // arg0 >> aux1, signed
@@ -158,6 +184,24 @@ func init() {
 		{name: "SLTU", argLength: 2, reg: gp21, asm: "SLTU"},                 // arg0 < arg1, unsigned, result is 0 or 1
 		{name: "SLTIU", argLength: 1, reg: gp11, asm: "SLTIU", aux: "Int64"}, // arg0 < auxint, unsigned, result is 0 or 1
 
+		// B extension (Zbb). These are only generated when GORISCV
+		// enables it. W means word: the op works on the low 32 bits of
+		// its inputs.
+		{name: "ANDN", argLength: 2, reg: gp21, asm: "ANDN"},                    // arg0 & ^arg1
+		{name: "ORN", argLength: 2, reg: gp21, asm: "ORN"},                      // arg0 | ^arg1
+		{name: "XNOR", argLength: 2, reg: gp21, asm: "XNOR", commutative: true}, // ^(arg0 ^ arg1)
+		{name: "CLZ", argLength: 1, reg: gp11, asm: "CLZ"},                      // count leading zeros of arg0
+		{name: "CLZW", argLength: 1, reg: gp11, asm: "CLZW"},
+		{name: "CTZ", argLength: 1, reg: gp11, asm: "CTZ"}, // count trailing zeros of arg0
+		{name: "CTZW", argLength: 1, reg: gp11, asm: "CTZW"},
+		{name: "CPOP", argLength: 1, reg: gp11, asm: "CPOP"}, // count one bits of arg0
+		{name: "CPOPW", argLength: 1, reg: gp11, asm: "CPOPW"},
+		{name: "REV8", argLength: 1, reg: gp11, asm: "REV8"}, // arg0 with its bytes reversed
+		{name: "ROL", argLength: 2, reg: gp21, asm: "ROL"},   // arg0 rotated left by arg1
+		{name: "ROLW", argLength: 2, reg: gp21, asm: "ROLW"},
+		{name: "RORI", argLength: 1, reg: gp11, asm: "RORI", aux: "Int64"}, // arg0 rotated right by auxint
+		{name: "RORIW", argLength: 1, reg: gp11, asm: "RORIW", aux: "Int64"},
+
 		// MOVconvert converts between pointers and integers.
 		// We have a special op for this so as to not confuse GC
 		// (particularly stack maps). It takes a memory arg so it
@@ -173,6 +217,50 @@ func init() {
 
 		// Generic moves and zeros
 
+		// duffzero
+		// arg0 = address of memory to zero (in T0, changed as side effect)
+		// arg1 = mem
+		// auxint = offset into duffzero code to start executing
+		// returns mem
+		// runtime·duffzero stores at fixed offsets from T0, so T0 is
+		// first moved back by the size of the stores that are skipped.
+		//	ADDI	$-skipped, T0, T0
+		//	DUFFZERO	runtime·duffzero+auxint(SB)
+		{
+			name:      "DUFFZERO",
+			aux:       "Int64",
+			argLength: 2,
+			reg: regInfo{
+				inputs:   []regMask{regNamed["T0"]},
+				clobbers: regNamed["T0"],
+			},
+			typ:            "Mem",
+			faultOnNilArg0: true,
+		},
+
+		// duffcopy
+		// arg0 = address of dst memory (in T0, changed as side effect)
+		// arg1 = address of src memory (in T1, changed as side effect)
+		// arg2 = mem
+		// auxint = offset into duffcopy code to start executing
+		// clobbers T2 as a tmp register.
+		// returns mem
+		//	ADDI	$-skipped, T0, T0
+		//	ADDI	$-skipped, T1, T1
+		//	DUFFCOPY	runtime·duffcopy+auxint(SB)
+		{
+			name:      "DUFFCOPY",
+			aux:       "Int64",
+			argLength: 3,
+			reg: regInfo{
+				inputs:   []regMask{regNamed["T0"], regNamed["T1"]},
+				clobbers: regNamed["T0"] | regNamed["T1"] | regNamed["T2"],
+			},
+			typ:            "Mem",
+			faultOnNilArg0: true,
+			faultOnNilArg1: true,
+		},
+
 		// general unaligned zeroing
 		// arg0 = address of memory to zero (in T0, changed as side effect)
 		// arg1 = address of the last element to zero
@@ -220,6 +308,57 @@ func init() {
 			faultOnNilArg1: true,
 		},
 
+		// Atomic loads.
+		// load from arg0. arg1=mem.
+		// returns <value,memory> so they can be properly ordered with other loads.
+		// FENCE
+		// MOVW	(Rarg0), Rout
+		// FENCE
+		{name: "LoweredAtomicLoad32", argLength: 2, reg: regInfo{inputs: []regMask{gpspMask}, outputs: []regMask{gpMask}}, faultOnNilArg0: true},
+		{name: "LoweredAtomicLoad64", argLength: 2, reg: regInfo{inputs: []regMask{gpspMask}, outputs: []regMask{gpMask}}, faultOnNilArg0: true},
+
+		// Atomic stores.
+		// store arg1 to arg0. arg2=mem. returns memory.
+		// AMOSWAPW Rarg1, (Rarg0), ZERO
+		{name: "LoweredAtomicStore32", argLength: 3, reg: gpatomic, asm: "AMOSWAPW", faultOnNilArg0: true},
+		{name: "LoweredAtomicStore64", argLength: 3, reg: gpatomic, asm: "AMOSWAPD", faultOnNilArg0: true},
+
+		// Atomic exchange.
+		// store arg1 to arg0. arg2=mem. returns <old content of *arg0, memory>.
+		// AMOSWAPW Rarg1, (Rarg0), Rout
+		{name: "LoweredAtomicExchange32", argLength: 3, reg: gpxchg, asm: "AMOSWAPW", resultNotInArgs: true, faultOnNilArg0: true},
+		{name: "LoweredAtomicExchange64", argLength: 3, reg: gpxchg, asm: "AMOSWAPD", resultNotInArgs: true, faultOnNilArg0: true},
+
+		// Atomic add.
+		// *arg0 += arg1. arg2=mem. returns <new content of *arg0, memory>.
+		// AMOADDW Rarg1, (Rarg0), Rout
+		// ADD	Rarg1, Rout, Rout
+		{name: "LoweredAtomicAdd32", argLength: 3, reg: gpxchg, asm: "AMOADDW", resultNotInArgs: true, faultOnNilArg0: true},
+		{name: "LoweredAtomicAdd64", argLength: 3, reg: gpxchg, asm: "AMOADDD", resultNotInArgs: true, faultOnNilArg0: true},
+
+		// Atomic compare and swap.
+		// arg0 = pointer, arg1 = old value, arg2 = new value, arg3 = memory.
+		// if *arg0 == arg1 {
+		//   *arg0 = arg2
+		//   return (true, memory)
+		// } else {
+		//   return (false, memory)
+		// }
+		// MOV	ZERO, Rout
+		// LR	(Rarg0), Rtmp
+		// BNE	Rtmp, Rarg1, 3(PC)
+		// SC	Rarg2, (Rarg0), Rtmp
+		// BNE	Rtmp, ZERO, -3(PC)
+		// MOV	$1, Rout
+		{name: "LoweredAtomicCas32", argLength: 4, reg: gpcas, resultNotInArgs: true, faultOnNilArg0: true},
+		{name: "LoweredAtomicCas64", argLength: 4, reg: gpcas, resultNotInArgs: true, faultOnNilArg0: true},
+
+		// Atomic 32 bit AND/OR.
+		// *arg0 &= (|=) arg1. arg2=mem. returns memory.
+		// AMOANDW Rarg1, (Rarg0), ZERO
+		{name: "LoweredAtomicAnd32", argLength: 3, reg: gpatomic, asm: "AMOANDW", faultOnNilArg0: true},
+		{name: "LoweredAtomicOr32", argLength: 3, reg: gpatomic, asm: "AMOORW", faultOnNilArg0: true},
+
 		// Lowering pass-throughs
 		{name: "LoweredNilCheck", argLength: 2, faultOnNilArg0: true, nilCheck: true, reg: regInfo{inputs: []regMask{gpspMask}}}, // arg0=ptr,arg1=mem, returns void.  Faults if ptr is nil.
 		{name: "LoweredGetClosurePtr", reg: regInfo{outputs: []regMask{regCtxt}}},                                                // scheduler ensures only at beginning of entry block
@@ -236,6 +375,8 @@ func init() {
 		{name: "FCVTSL", argLength: 1, reg: gpfp, asm: "FCVTSL", typ: "Float32"},                                         // float32(arg0)
 		{name: "FCVTWS", argLength: 1, reg: fpgp, asm: "FCVTWS", typ: "Int32"},                                           // int32(arg0)
 		{name: "FCVTLS", argLength: 1, reg: fpgp, asm: "FCVTLS", typ: "Int64"},                                           // int64(arg0)
+		{name: "FCVTSWU", argLength: 1, reg: gpfp, asm: "FCVTSWU", typ: "Float32"},                                       // float32(uint32(arg0))
+		{name: "FCVTWUS", argLength: 1, reg: fpgp, asm: "FCVTWUS", typ: "UInt32"},                                        // uint32(arg0)
 		{name: "FMOVWload", argLength: 2, reg: fpload, asm: "MOVF", aux: "SymOff", typ: "Float32", faultOnNilArg0: true}, // load float32 from arg0+auxint+aux
 		{name: "FMOVWstore", argLength: 3, reg: fpstore, asm: "MOVF", aux: "SymOff", typ: "Mem", faultOnNilArg0: true},   // store float32 to arg0+auxint+aux
 		{name: "FEQS", argLength: 2, reg: fp2gp, asm: "FEQS", commutative: true},                                         // arg0 == arg1
@@ -250,23 +391,52 @@ func init() {
 		{name: "FDIVD", argLength: 2, reg: fp21, asm: "FDIVD", commutative: false, typ: "Float64"},                       // arg0 / arg1
 		{name: "FSQRTD", argLength: 1, reg: fp11, asm: "FSQRTD", typ: "Float64"},                                         // sqrt(arg0)
 		{name: "FNEGD", argLength: 1, reg: fp11, asm: "FNEGD", typ: "Float64"},                                           // -arg0
+		{name: "FABSD", argLength: 1, reg: fp11, asm: "FABSD", typ: "Float64"},                                           // abs(arg0)
+		{name: "FSGNJD", argLength: 2, reg: fp21, asm: "FSGNJD", typ: "Float64"},                                         // copysign(arg0, arg1)
+		{name: "FMADDD", argLength: 3, reg: fp31, asm: "FMADDD", typ: "Float64"},                                         // arg0 * arg1 + arg2
+		{name: "FMSUBD", argLength: 3, reg: fp31, asm: "FMSUBD", typ: "Float64"},                                         // arg0 * arg1 - arg2
+		{name: "FNMADDD", argLength: 3, reg: fp31, asm: "FNMADDD", typ: "Float64"},                                       // -(arg0 * arg1) - arg2
+		{name: "FNMSUBD", argLength: 3, reg: fp31, asm: "FNMSUBD", typ: "Float64"},                                       // -(arg0 * arg1) + arg2
 		{name: "FMVDX", argLength: 1, reg: gpfp, asm: "FMVDX", typ: "Float64"},                                           // reinterpret arg0 as float
 		{name: "FCVTDW", argLength: 1, reg: gpfp, asm: "FCVTDW", typ: "Float64"},                                         // float64(arg0)
 		{name: "FCVTDL", argLength: 1, reg: gpfp, asm: "FCVTDL", typ: "Float64"},                                         // float64(arg0)
 		{name: "FCVTWD", argLength: 1, reg: fpgp, asm: "FCVTWD", typ: "Int32"},                                           // int32(arg0)
 		{name: "FCVTLD", argLength: 1, reg: fpgp, asm: "FCVTLD", typ: "Int64"},                                           // int64(arg0)
+		{name: "FCVTDWU", argLength: 1, reg: gpfp, asm: "FCVTDWU", typ: "Float64"},                                       // float64(uint32(arg0))
+		{name: "FCVTWUD", argLength: 1, reg: fpgp, asm: "FCVTWUD", typ: "UInt32"},                                        // uint32(arg0)
 		{name: "FCVTDS", argLength: 1, reg: fp11, asm: "FCVTDS", typ: "Float64"},                                         // float64(arg0)
 		{name: "FCVTSD", argLength: 1, reg: fp11, asm: "FCVTSD", typ: "Float32"},                                         // float32(arg0)
+		{name: "FMOVDconst", reg: fp01, asm: "MOVD", typ: "Float64", aux: "Float64", rematerializeable: true},            // auxint as float64, loaded from memory
 		{name: "FMOVDload", argLength: 2, reg: fpload, asm: "MOVD", aux: "SymOff", typ: "Float64", faultOnNilArg0: true}, // load float64 from arg0+auxint+aux
 		{name: "FMOVDstore", argLength: 3, reg: fpstore, asm: "MOVD", aux: "SymOff", typ: "Mem", faultOnNilArg0: true},   // store float6 to arg0+auxint+aux
 		{name: "FEQD", argLength: 2, reg: fp2gp, asm: "FEQD", commutative: true},                                         // arg0 == arg1
 		{name: "FNED", argLength: 2, reg: fp2gp, asm: "FNED", commutative: true},                                         // arg0 != arg1
 		{name: "FLTD", argLength: 2, reg: fp2gp, asm: "FLTD"},                                                            // arg0 < arg1
 		{name: "FLED", argLength: 2, reg: fp2gp, asm: "FLED"},                                                            // arg0 <= arg1
+
+		// Round arg0 to an integer, toward -Inf, +Inf or zero. These go
+		// through an int64, so values that are already integers,
+		// including infinities and NaNs, are passed through unchanged.
+		// Clobbers TMP. RV64 only.
+		{name: "LoweredFloorD", argLength: 1, reg: fp11, resultNotInArgs: true, typ: "Float64"},
+		{name: "LoweredCeilD", argLength: 1, reg: fp11, resultNotInArgs: true, typ: "Float64"},
+		{name: "LoweredTruncD", argLength: 1, reg: fp11, resultNotInArgs: true, typ: "Float64"},
 	}
 
 	RISCVblocks := []blockData{
-		{name: "BNE"}, // Control != 0 (take a register)
+		{name: "BEQ", controls: 2},  // Control == Control2
+		{name: "BNE", controls: 2},  // Control != Control2
+		{name: "BLT", controls: 2},  // Control < Control2
+		{name: "BGE", controls: 2},  // Control >= Control2
+		{name: "BLTU", controls: 2}, // Control < Control2, unsigned
+		{name: "BGEU", controls: 2}, // Control >= Control2, unsigned
+
+		{name: "BEQZ"}, // Control == 0 (take a register)
+		{name: "BNEZ"}, // Control != 0 (take a register)
+		{name: "BLEZ"}, // Control <= 0
+		{name: "BGEZ"}, // Control >= 0
+		{name: "BLTZ"}, // Control < 0
+		{name: "BGTZ"}, // Control > 0
 	}
 
 	archs = append(archs, arch{
@@ -239,10 +239,25 @@ var genericOps = []opData{
 	{name: "Ctz32", argLength: 1}, // Count trailing (low  order) zeroes (returns 0-32)
 	{name: "Ctz64", argLength: 1}, // Count trailing zeroes (returns 0-64)
 
+	{name: "BitLen32", argLength: 1}, // Number of bits needed to represent arg0 (returns 0-32)
+	{name: "BitLen64", argLength: 1}, // Number of bits needed to represent arg0 (returns 0-64)
+
 	{name: "Bswap32", argLength: 1}, // Swap bytes
 	{name: "Bswap64", argLength: 1}, // Swap bytes
 
-	{name: "Sqrt", argLength: 1}, // sqrt(arg0), float64 only
+	{name: "PopCount32", argLength: 1}, // Count one bits (returns 0-32)
+	{name: "PopCount64", argLength: 1}, // Count one bits (returns 0-64)
+
+	{name: "RotateLeft32", argLength: 2}, // Rotate arg0 left by arg1 (modulo 32)
+	{name: "RotateLeft64", argLength: 2}, // Rotate arg0 left by arg1 (modulo 64)
+
+	{name: "Sqrt", argLength: 1},     // sqrt(arg0), float64 only
+	{name: "Floor", argLength: 1},    // floor(arg0), float64 only
+	{name: "Ceil", argLength: 1},     // ceil(arg0), float64 only
+	{name: "Trunc", argLength: 1},    // trunc(arg0), float64 only
+	{name: "Abs", argLength: 1},      // abs(arg0), float64 only
+	{name: "Copysign", argLength: 2}, // copysign(arg0, arg1), float64 only
+	{name: "FMA", argLength: 3},      // arg0 * arg1 + arg2, rounded only once, float64 only
 
 	// Data movement, max argument length for Phi is indefinite so just pick
 	// a really large number
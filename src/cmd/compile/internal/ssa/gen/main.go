@@ -18,6 +18,7 @@ import (
 	"path"
 	"regexp"
 	"sort"
+	"strings"
 )
 
 type arch struct {
@@ -55,7 +56,8 @@ type opData struct {
 }
 
 type blockData struct {
-	name string
+	name     string
+	controls int // number of control values, if more than one
 }
 
 type regInfo struct {
@@ -129,6 +131,23 @@ func genOp() {
 	fmt.Fprintln(w, "}")
 	fmt.Fprintln(w, "func (k BlockKind) String() string {return blockString[k]}")
 
+	// generate the list of blocks that take a second control value
+	var two []string
+	for _, a := range archs {
+		for _, b := range a.blocks {
+			if b.controls == 2 {
+				two = append(two, fmt.Sprintf("Block%s%s", a.Name(), b.name))
+			}
+		}
+	}
+	fmt.Fprintln(w, "// twoControls reports whether blocks of kind k have a second control value, Control2.")
+	fmt.Fprintln(w, "func (k BlockKind) twoControls() bool {")
+	if len(two) > 0 {
+		fmt.Fprintf(w, "switch k {\ncase %s:\nreturn true\n}\n", strings.Join(two, ", "))
+	}
+	fmt.Fprintln(w, "return false")
+	fmt.Fprintln(w, "}")
+
 	// generate Op* declarations
 	fmt.Fprintln(w, "const (")
 	fmt.Fprintln(w, "OpInvalid Op = iota") // make sure OpInvalid is 0.
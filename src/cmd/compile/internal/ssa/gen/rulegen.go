@@ -227,20 +227,36 @@ func genRules(arch arch) {
 			fmt.Fprintf(w, "for {\n")
 
 			s := split(match[1 : len(match)-1]) // remove parens, then split
+			nctl := blockControls(s[0], arch)
 
-			// check match of control value
-			if s[1] != "nil" {
-				fmt.Fprintf(w, "v := b.Control\n")
-				if strings.Contains(s[1], "(") {
-					genMatch0(w, arch, s[1], "v", map[string]struct{}{}, false, rule.loc)
+			// check match of control values
+			m := map[string]struct{}{}
+			for i, c := range s[1 : 1+nctl] {
+				if c == "nil" {
+					continue
+				}
+				field, v := "Control", "v"
+				if i == 1 {
+					field, v = "Control2", "v2"
+				}
+				fmt.Fprintf(w, "%s := b.%s\n", v, field)
+				if strings.Contains(c, "(") {
+					genMatch0(w, arch, c, v, m, false, rule.loc)
 				} else {
-					fmt.Fprintf(w, "_ = v\n") // in case we don't use v
-					fmt.Fprintf(w, "%s := b.Control\n", s[1])
+					fmt.Fprintf(w, "_ = %s\n", v) // in case we don't use v
+					if _, ok := m[c]; ok {
+						// variable already has a definition. Check whether
+						// the old definition and the new definition match.
+						fmt.Fprintf(w, "if %s != %s {\nbreak\n}\n", c, v)
+					} else {
+						m[c] = struct{}{}
+						fmt.Fprintf(w, "%s := b.%s\n", c, field)
+					}
 				}
 			}
 
 			// assign successor names
-			succs := s[2:]
+			succs := s[1+nctl:]
 			for i, a := range succs {
 				if a != "_" {
 					fmt.Fprintf(w, "%s := b.Succs[%d]\n", a, i)
@@ -253,31 +269,38 @@ func genRules(arch arch) {
 
 			// Rule matches. Generate result.
 			t := split(result[1 : len(result)-1]) // remove parens, then split
-			newsuccs := t[2:]
+			newnctl := blockControls(t[0], arch)
+			newsuccs := t[1+newnctl:]
 
 			// Check if newsuccs is the same set as succs.
-			m := map[string]bool{}
+			sm := map[string]bool{}
 			for _, succ := range succs {
-				if m[succ] {
+				if sm[succ] {
 					log.Fatalf("can't have a repeat successor name %s in %s", succ, rule)
 				}
-				m[succ] = true
+				sm[succ] = true
 			}
 			for _, succ := range newsuccs {
-				if !m[succ] {
+				if !sm[succ] {
 					log.Fatalf("unknown successor %s in %s", succ, rule)
 				}
-				delete(m, succ)
+				delete(sm, succ)
 			}
-			if len(m) != 0 {
-				log.Fatalf("unmatched successors %v in %s", m, rule)
+			if len(sm) != 0 {
+				log.Fatalf("unmatched successors %v in %s", sm, rule)
 			}
 
 			fmt.Fprintf(w, "b.Kind = %s\n", blockName(t[0], arch))
+			alloc := new(int)
 			if t[1] == "nil" {
 				fmt.Fprintf(w, "b.SetControl(nil)\n")
 			} else {
-				fmt.Fprintf(w, "b.SetControl(%s)\n", genResult0(w, arch, t[1], new(int), false, false, rule.loc))
+				fmt.Fprintf(w, "b.SetControl(%s)\n", genResult0(w, arch, t[1], alloc, false, false, rule.loc))
+			}
+			if newnctl == 2 {
+				fmt.Fprintf(w, "b.SetControl2(%s)\n", genResult0(w, arch, t[2], alloc, false, false, rule.loc))
+			} else if nctl == 2 {
+				fmt.Fprintf(w, "b.SetControl2(nil)\n")
 			}
 
 			succChanged := false
@@ -575,6 +598,17 @@ func isBlock(name string, arch arch) bool {
 	return false
 }
 
+// blockControls returns the number of control value slots, including
+// nil ones, that a block rule for block kind name has.
+func blockControls(name string, arch arch) int {
+	for _, b := range arch.blocks {
+		if b.name == name && b.controls == 2 {
+			return 2
+		}
+	}
+	return 1
+}
+
 // parseValue parses a parenthesized value from a rule.
 // The value can be from the match or the result side.
 // It returns the op and unparsed strings for typ, auxint, and aux restrictions and for all args.
@@ -385,6 +385,9 @@ func (b *Block) LongHTML() string {
 	if b.Control != nil {
 		s += fmt.Sprintf(" %s", b.Control.HTML())
 	}
+	if b.Control2 != nil {
+		s += fmt.Sprintf(" %s", b.Control2.HTML())
+	}
 	if len(b.Succs) > 0 {
 		s += " &#8594;" // right arrow
 		for _, e := range b.Succs {
@@ -555,6 +555,8 @@ func (s *regAllocState) init(f *Func) {
 			// available for global load/stores. See gen/386.rules (search for Flag_shared).
 		case "s390x":
 			// nothing to do, R10 & R11 already reserved
+		case "riscv":
+			// nothing to do, TMP already reserved
 		default:
 			s.f.Config.fe.Fatalf(src.NoXPos, "arch %s not implemented", s.f.Config.arch)
 		}
@@ -760,9 +762,11 @@ func (s *regAllocState) regalloc(f *Func) {
 			s.addUse(e.ID, int32(len(b.Values))+e.dist, e.pos) // pseudo-uses from beyond end of block
 			liveSet.add(e.ID)
 		}
-		if v := b.Control; v != nil && s.values[v.ID].needReg {
-			s.addUse(v.ID, int32(len(b.Values)), b.Pos) // pseudo-use by control value
-			liveSet.add(v.ID)
+		for _, v := range [...]*Value{b.Control, b.Control2} {
+			if v != nil && s.values[v.ID].needReg {
+				s.addUse(v.ID, int32(len(b.Values)), b.Pos) // pseudo-use by control value
+				liveSet.add(v.ID)
+			}
 		}
 		for i := len(b.Values) - 1; i >= 0; i-- {
 			v := b.Values[i]
@@ -1397,18 +1401,35 @@ func (s *regAllocState) regalloc(f *Func) {
 			}
 		}
 
-		// Load control value into reg.
-		if v := b.Control; v != nil && s.values[v.ID].needReg {
+		// Load control values into regs. Both must be in registers at
+		// the end of the block, so don't let the second one evict the
+		// first.
+		controls := [...]*Value{b.Control, b.Control2}
+		for i, v := range controls {
+			if v == nil || !s.values[v.ID].needReg {
+				continue
+			}
 			if s.f.pass.debug > regDebug {
 				fmt.Printf("  processing control %s\n", v.LongString())
 			}
 			// We assume that a control input can be passed in any
 			// type-compatible register. If this turns out not to be true,
 			// we'll need to introduce a regspec for a block's control value.
-			b.Control = s.allocValToReg(v, s.compatRegs(v.Type), false, b.Pos)
-			if b.Control != v {
+			c := s.allocValToReg(v, s.compatRegs(v.Type), true, b.Pos)
+			if c != v {
 				v.Uses--
-				b.Control.Uses++
+				c.Uses++
+			}
+			if i == 0 {
+				b.Control = c
+			} else {
+				b.Control2 = c
+			}
+		}
+		s.nospill = 0
+		for _, v := range controls {
+			if v == nil || !s.values[v.ID].needReg {
+				continue
 			}
 			// Remove this use from the uses list.
 			vi := &s.values[v.ID]
@@ -1555,9 +1576,11 @@ func (s *regAllocState) regalloc(f *Func) {
 								entryCandidates.setBit(li.ID, uint(whichExit))
 							}
 						}
-						// Control can also be live.
-						if ss.Control != nil && s.orig[ss.Control.ID] != nil && s.isLoopSpillCandidate(loop, s.orig[ss.Control.ID]) {
-							entryCandidates.setBit(s.orig[ss.Control.ID].ID, uint(whichExit))
+						// Controls can also be live.
+						for _, c := range [...]*Value{ss.Control, ss.Control2} {
+							if c != nil && s.orig[c.ID] != nil && s.isLoopSpillCandidate(loop, s.orig[c.ID]) {
+								entryCandidates.setBit(s.orig[c.ID].ID, uint(whichExit))
+							}
 						}
 						// Walk backwards, filling in locally live values, removing those defined.
 						for i := len(ss.Values) - 1; i >= 0; i-- {
@@ -2346,9 +2369,11 @@ func (s *regAllocState) computeLive() {
 				live.set(e.ID, e.dist+int32(len(b.Values)), e.pos)
 			}
 
-			// Mark control value as live
-			if b.Control != nil && s.values[b.Control.ID].needReg {
-				live.set(b.Control.ID, int32(len(b.Values)), b.Pos)
+			// Mark control values as live
+			for _, c := range [...]*Value{b.Control, b.Control2} {
+				if c != nil && s.values[c.ID].needReg {
+					live.set(c.ID, int32(len(b.Values)), b.Pos)
+				}
 			}
 
 			// Propagate backwards to the start of the block
@@ -33,6 +33,11 @@ func applyRewrite(f *Func, rb func(*Block, *Config) bool, rv func(*Value, *Confi
 					b.SetControl(b.Control.Args[0])
 				}
 			}
+			if b.Control2 != nil && b.Control2.Op == OpCopy {
+				for b.Control2.Op == OpCopy {
+					b.SetControl2(b.Control2.Args[0])
+				}
+			}
 			curb = b
 			if rb(b, config) {
 				change = true
@@ -354,6 +359,11 @@ func is20Bit(n int64) bool {
 	return -(1<<19) <= n && n < (1<<19)
 }
 
+// is12Bit reports whether n can be represented as a signed 12 bit integer.
+func is12Bit(n int64) bool {
+	return -(1<<11) <= n && n < (1<<11)
+}
+
 // b2i translates a boolean value to 0 or 1 for assigning to auxInt.
 func b2i(b bool) int64 {
 	if b {
@@ -8,12 +8,16 @@ import "math"
 var _ = math.MinInt8 // in case not otherwise used
 func rewriteValueRISCV(v *Value, config *Config) bool {
 	switch v.Op {
+	case OpAbs:
+		return rewriteValueRISCV_OpAbs(v, config)
 	case OpAdd16:
 		return rewriteValueRISCV_OpAdd16(v, config)
 	case OpAdd32:
 		return rewriteValueRISCV_OpAdd32(v, config)
 	case OpAdd32F:
 		return rewriteValueRISCV_OpAdd32F(v, config)
+	case OpAdd32withcarry:
+		return rewriteValueRISCV_OpAdd32withcarry(v, config)
 	case OpAdd64:
 		return rewriteValueRISCV_OpAdd64(v, config)
 	case OpAdd64F:
@@ -34,8 +38,46 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpAnd8(v, config)
 	case OpAndB:
 		return rewriteValueRISCV_OpAndB(v, config)
+	case OpAtomicAdd32:
+		return rewriteValueRISCV_OpAtomicAdd32(v, config)
+	case OpAtomicAdd64:
+		return rewriteValueRISCV_OpAtomicAdd64(v, config)
+	case OpAtomicAnd8:
+		return rewriteValueRISCV_OpAtomicAnd8(v, config)
+	case OpAtomicCompareAndSwap32:
+		return rewriteValueRISCV_OpAtomicCompareAndSwap32(v, config)
+	case OpAtomicCompareAndSwap64:
+		return rewriteValueRISCV_OpAtomicCompareAndSwap64(v, config)
+	case OpAtomicExchange32:
+		return rewriteValueRISCV_OpAtomicExchange32(v, config)
+	case OpAtomicExchange64:
+		return rewriteValueRISCV_OpAtomicExchange64(v, config)
+	case OpAtomicLoad32:
+		return rewriteValueRISCV_OpAtomicLoad32(v, config)
+	case OpAtomicLoad64:
+		return rewriteValueRISCV_OpAtomicLoad64(v, config)
+	case OpAtomicLoadPtr:
+		return rewriteValueRISCV_OpAtomicLoadPtr(v, config)
+	case OpAtomicOr8:
+		return rewriteValueRISCV_OpAtomicOr8(v, config)
+	case OpAtomicStore32:
+		return rewriteValueRISCV_OpAtomicStore32(v, config)
+	case OpAtomicStore64:
+		return rewriteValueRISCV_OpAtomicStore64(v, config)
+	case OpAtomicStorePtrNoWB:
+		return rewriteValueRISCV_OpAtomicStorePtrNoWB(v, config)
 	case OpAvg64u:
 		return rewriteValueRISCV_OpAvg64u(v, config)
+	case OpBitLen32:
+		return rewriteValueRISCV_OpBitLen32(v, config)
+	case OpBitLen64:
+		return rewriteValueRISCV_OpBitLen64(v, config)
+	case OpBswap32:
+		return rewriteValueRISCV_OpBswap32(v, config)
+	case OpBswap64:
+		return rewriteValueRISCV_OpBswap64(v, config)
+	case OpCeil:
+		return rewriteValueRISCV_OpCeil(v, config)
 	case OpClosureCall:
 		return rewriteValueRISCV_OpClosureCall(v, config)
 	case OpCom16:
@@ -64,12 +106,24 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpConstNil(v, config)
 	case OpConvert:
 		return rewriteValueRISCV_OpConvert(v, config)
+	case OpCopysign:
+		return rewriteValueRISCV_OpCopysign(v, config)
+	case OpCtz32:
+		return rewriteValueRISCV_OpCtz32(v, config)
+	case OpCtz64:
+		return rewriteValueRISCV_OpCtz64(v, config)
 	case OpCvt32Fto32:
 		return rewriteValueRISCV_OpCvt32Fto32(v, config)
+	case OpCvt32Fto32U:
+		return rewriteValueRISCV_OpCvt32Fto32U(v, config)
 	case OpCvt32Fto64:
 		return rewriteValueRISCV_OpCvt32Fto64(v, config)
 	case OpCvt32Fto64F:
 		return rewriteValueRISCV_OpCvt32Fto64F(v, config)
+	case OpCvt32Uto32F:
+		return rewriteValueRISCV_OpCvt32Uto32F(v, config)
+	case OpCvt32Uto64F:
+		return rewriteValueRISCV_OpCvt32Uto64F(v, config)
 	case OpCvt32to32F:
 		return rewriteValueRISCV_OpCvt32to32F(v, config)
 	case OpCvt32to64F:
@@ -78,6 +132,8 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpCvt64Fto32(v, config)
 	case OpCvt64Fto32F:
 		return rewriteValueRISCV_OpCvt64Fto32F(v, config)
+	case OpCvt64Fto32U:
+		return rewriteValueRISCV_OpCvt64Fto32U(v, config)
 	case OpCvt64Fto64:
 		return rewriteValueRISCV_OpCvt64Fto64(v, config)
 	case OpCvt64to32F:
@@ -122,6 +178,10 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpEqB(v, config)
 	case OpEqPtr:
 		return rewriteValueRISCV_OpEqPtr(v, config)
+	case OpFMA:
+		return rewriteValueRISCV_OpFMA(v, config)
+	case OpFloor:
+		return rewriteValueRISCV_OpFloor(v, config)
 	case OpGeq16:
 		return rewriteValueRISCV_OpGeq16(v, config)
 	case OpGeq16U:
@@ -338,32 +398,112 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpOr8(v, config)
 	case OpOrB:
 		return rewriteValueRISCV_OpOrB(v, config)
+	case OpPopCount32:
+		return rewriteValueRISCV_OpPopCount32(v, config)
+	case OpPopCount64:
+		return rewriteValueRISCV_OpPopCount64(v, config)
+	case OpRISCVADD:
+		return rewriteValueRISCV_OpRISCVADD(v, config)
 	case OpRISCVADDI:
 		return rewriteValueRISCV_OpRISCVADDI(v, config)
+	case OpRISCVADDIW:
+		return rewriteValueRISCV_OpRISCVADDIW(v, config)
+	case OpRISCVAND:
+		return rewriteValueRISCV_OpRISCVAND(v, config)
+	case OpRISCVANDI:
+		return rewriteValueRISCV_OpRISCVANDI(v, config)
+	case OpRISCVFMADDD:
+		return rewriteValueRISCV_OpRISCVFMADDD(v, config)
+	case OpRISCVFNMSUBD:
+		return rewriteValueRISCV_OpRISCVFNMSUBD(v, config)
 	case OpRISCVMOVBUload:
 		return rewriteValueRISCV_OpRISCVMOVBUload(v, config)
+	case OpRISCVMOVBUreg:
+		return rewriteValueRISCV_OpRISCVMOVBUreg(v, config)
 	case OpRISCVMOVBload:
 		return rewriteValueRISCV_OpRISCVMOVBload(v, config)
+	case OpRISCVMOVBreg:
+		return rewriteValueRISCV_OpRISCVMOVBreg(v, config)
 	case OpRISCVMOVBstore:
 		return rewriteValueRISCV_OpRISCVMOVBstore(v, config)
-	case OpRISCVMOVDconst:
-		return rewriteValueRISCV_OpRISCVMOVDconst(v, config)
+	case OpRISCVMOVBstorezero:
+		return rewriteValueRISCV_OpRISCVMOVBstorezero(v, config)
 	case OpRISCVMOVDload:
 		return rewriteValueRISCV_OpRISCVMOVDload(v, config)
 	case OpRISCVMOVDstore:
 		return rewriteValueRISCV_OpRISCVMOVDstore(v, config)
+	case OpRISCVMOVDstorezero:
+		return rewriteValueRISCV_OpRISCVMOVDstorezero(v, config)
 	case OpRISCVMOVHUload:
 		return rewriteValueRISCV_OpRISCVMOVHUload(v, config)
+	case OpRISCVMOVHUreg:
+		return rewriteValueRISCV_OpRISCVMOVHUreg(v, config)
 	case OpRISCVMOVHload:
 		return rewriteValueRISCV_OpRISCVMOVHload(v, config)
+	case OpRISCVMOVHreg:
+		return rewriteValueRISCV_OpRISCVMOVHreg(v, config)
 	case OpRISCVMOVHstore:
 		return rewriteValueRISCV_OpRISCVMOVHstore(v, config)
+	case OpRISCVMOVHstorezero:
+		return rewriteValueRISCV_OpRISCVMOVHstorezero(v, config)
 	case OpRISCVMOVWUload:
 		return rewriteValueRISCV_OpRISCVMOVWUload(v, config)
+	case OpRISCVMOVWUreg:
+		return rewriteValueRISCV_OpRISCVMOVWUreg(v, config)
 	case OpRISCVMOVWload:
 		return rewriteValueRISCV_OpRISCVMOVWload(v, config)
+	case OpRISCVMOVWreg:
+		return rewriteValueRISCV_OpRISCVMOVWreg(v, config)
 	case OpRISCVMOVWstore:
 		return rewriteValueRISCV_OpRISCVMOVWstore(v, config)
+	case OpRISCVMOVWstorezero:
+		return rewriteValueRISCV_OpRISCVMOVWstorezero(v, config)
+	case OpRISCVNEG:
+		return rewriteValueRISCV_OpRISCVNEG(v, config)
+	case OpRISCVOR:
+		return rewriteValueRISCV_OpRISCVOR(v, config)
+	case OpRISCVORI:
+		return rewriteValueRISCV_OpRISCVORI(v, config)
+	case OpRISCVROL:
+		return rewriteValueRISCV_OpRISCVROL(v, config)
+	case OpRISCVROLW:
+		return rewriteValueRISCV_OpRISCVROLW(v, config)
+	case OpRISCVRORI:
+		return rewriteValueRISCV_OpRISCVRORI(v, config)
+	case OpRISCVSEQZ:
+		return rewriteValueRISCV_OpRISCVSEQZ(v, config)
+	case OpRISCVSLL:
+		return rewriteValueRISCV_OpRISCVSLL(v, config)
+	case OpRISCVSLLI:
+		return rewriteValueRISCV_OpRISCVSLLI(v, config)
+	case OpRISCVSLT:
+		return rewriteValueRISCV_OpRISCVSLT(v, config)
+	case OpRISCVSLTI:
+		return rewriteValueRISCV_OpRISCVSLTI(v, config)
+	case OpRISCVSLTIU:
+		return rewriteValueRISCV_OpRISCVSLTIU(v, config)
+	case OpRISCVSLTU:
+		return rewriteValueRISCV_OpRISCVSLTU(v, config)
+	case OpRISCVSNEZ:
+		return rewriteValueRISCV_OpRISCVSNEZ(v, config)
+	case OpRISCVSRA:
+		return rewriteValueRISCV_OpRISCVSRA(v, config)
+	case OpRISCVSRAI:
+		return rewriteValueRISCV_OpRISCVSRAI(v, config)
+	case OpRISCVSRL:
+		return rewriteValueRISCV_OpRISCVSRL(v, config)
+	case OpRISCVSRLI:
+		return rewriteValueRISCV_OpRISCVSRLI(v, config)
+	case OpRISCVSUB:
+		return rewriteValueRISCV_OpRISCVSUB(v, config)
+	case OpRISCVXOR:
+		return rewriteValueRISCV_OpRISCVXOR(v, config)
+	case OpRISCVXORI:
+		return rewriteValueRISCV_OpRISCVXORI(v, config)
+	case OpRotateLeft32:
+		return rewriteValueRISCV_OpRotateLeft32(v, config)
+	case OpRotateLeft64:
+		return rewriteValueRISCV_OpRotateLeft64(v, config)
 	case OpRsh16Ux16:
 		return rewriteValueRISCV_OpRsh16Ux16(v, config)
 	case OpRsh16Ux32:
@@ -428,6 +568,10 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpRsh8x64(v, config)
 	case OpRsh8x8:
 		return rewriteValueRISCV_OpRsh8x8(v, config)
+	case OpSelect0:
+		return rewriteValueRISCV_OpSelect0(v, config)
+	case OpSelect1:
+		return rewriteValueRISCV_OpSelect1(v, config)
 	case OpSignExt16to32:
 		return rewriteValueRISCV_OpSignExt16to32(v, config)
 	case OpSignExt16to64:
@@ -440,6 +584,8 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpSignExt8to32(v, config)
 	case OpSignExt8to64:
 		return rewriteValueRISCV_OpSignExt8to64(v, config)
+	case OpSignmask:
+		return rewriteValueRISCV_OpSignmask(v, config)
 	case OpSlicemask:
 		return rewriteValueRISCV_OpSlicemask(v, config)
 	case OpSqrt:
@@ -454,6 +600,8 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpSub32(v, config)
 	case OpSub32F:
 		return rewriteValueRISCV_OpSub32F(v, config)
+	case OpSub32withcarry:
+		return rewriteValueRISCV_OpSub32withcarry(v, config)
 	case OpSub64:
 		return rewriteValueRISCV_OpSub64(v, config)
 	case OpSub64F:
@@ -462,6 +610,8 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpSub8(v, config)
 	case OpSubPtr:
 		return rewriteValueRISCV_OpSubPtr(v, config)
+	case OpTrunc:
+		return rewriteValueRISCV_OpTrunc(v, config)
 	case OpTrunc16to8:
 		return rewriteValueRISCV_OpTrunc16to8(v, config)
 	case OpTrunc32to16:
@@ -496,9 +646,24 @@ func rewriteValueRISCV(v *Value, config *Config) bool {
 		return rewriteValueRISCV_OpZeroExt8to32(v, config)
 	case OpZeroExt8to64:
 		return rewriteValueRISCV_OpZeroExt8to64(v, config)
+	case OpZeromask:
+		return rewriteValueRISCV_OpZeromask(v, config)
 	}
 	return false
 }
+func rewriteValueRISCV_OpAbs(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Abs x)
+	// cond:
+	// result: (FABSD x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVFABSD)
+		v.AddArg(x)
+		return true
+	}
+}
 func rewriteValueRISCV_OpAdd16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
@@ -544,6 +709,26 @@ func rewriteValueRISCV_OpAdd32F(v *Value, config *Config) bool {
 		return true
 	}
 }
+func rewriteValueRISCV_OpAdd32withcarry(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Add32withcarry <t> x y c)
+	// cond:
+	// result: (ADD c (ADD <t> x y))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		c := v.Args[2]
+		v.reset(OpRISCVADD)
+		v.AddArg(c)
+		v0 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		return true
+	}
+}
 func rewriteValueRISCV_OpAdd64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
@@ -694,5179 +879,12157 @@ func rewriteValueRISCV_OpAndB(v *Value, config *Config) bool {
 		return true
 	}
 }
-func rewriteValueRISCV_OpAvg64u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicAdd32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Avg64u <t> x y)
+	// match: (AtomicAdd32 ptr val mem)
 	// cond:
-	// result: (ADD (ADD <t> (SRLI <t> [1] x) (SRLI <t> [1] y)) (ANDI <t> [1] (AND <t> x y)))
+	// result: (LoweredAtomicAdd32 ptr val mem)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVADD)
-		v0 := b.NewValue0(v.Pos, OpRISCVADD, t)
-		v1 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
-		v1.AuxInt = 1
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v2 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
-		v2.AuxInt = 1
-		v2.AddArg(y)
-		v0.AddArg(v2)
-		v.AddArg(v0)
-		v3 := b.NewValue0(v.Pos, OpRISCVANDI, t)
-		v3.AuxInt = 1
-		v4 := b.NewValue0(v.Pos, OpRISCVAND, t)
-		v4.AddArg(x)
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v.AddArg(v3)
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicAdd32)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpClosureCall(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicAdd64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (ClosureCall [argwid] entry closure mem)
+	// match: (AtomicAdd64 ptr val mem)
 	// cond:
-	// result: (CALLclosure [argwid] entry closure mem)
+	// result: (LoweredAtomicAdd64 ptr val mem)
 	for {
-		argwid := v.AuxInt
-		entry := v.Args[0]
-		closure := v.Args[1]
+		ptr := v.Args[0]
+		val := v.Args[1]
 		mem := v.Args[2]
-		v.reset(OpRISCVCALLclosure)
-		v.AuxInt = argwid
-		v.AddArg(entry)
-		v.AddArg(closure)
+		v.reset(OpRISCVLoweredAtomicAdd64)
+		v.AddArg(ptr)
+		v.AddArg(val)
 		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCom16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicAnd8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Com16 x)
+	// match: (AtomicAnd8 ptr val mem)
 	// cond:
-	// result: (XORI [int64(-1)] x)
+	// result: (LoweredAtomicAnd32 (ANDI <config.fe.TypeUInt32().PtrTo()> [^3] ptr) 		(XORI <config.fe.TypeUInt32()> [-1] 			(SLL <config.fe.TypeUInt32()> 				(XORI <config.fe.TypeUInt32()> [0xff] (ZeroExt8to32 val)) 				(SLLI <config.fe.TypeUInt64()> [3] 					(ANDI <config.fe.TypeUInt64()> [3] ptr)))) mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpRISCVXORI)
-		v.AuxInt = int64(-1)
-		v.AddArg(x)
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicAnd32)
+		v0 := b.NewValue0(v.Pos, OpRISCVANDI, config.fe.TypeUInt32().PtrTo())
+		v0.AuxInt = ^3
+		v0.AddArg(ptr)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVXORI, config.fe.TypeUInt32())
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLL, config.fe.TypeUInt32())
+		v3 := b.NewValue0(v.Pos, OpRISCVXORI, config.fe.TypeUInt32())
+		v3.AuxInt = 0xff
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v4.AddArg(val)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v5 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt64())
+		v5.AuxInt = 3
+		v6 := b.NewValue0(v.Pos, OpRISCVANDI, config.fe.TypeUInt64())
+		v6.AuxInt = 3
+		v6.AddArg(ptr)
+		v5.AddArg(v6)
+		v2.AddArg(v5)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCom32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicCompareAndSwap32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Com32 x)
-	// cond:
-	// result: (XORI [int64(-1)] x)
+	// match: (AtomicCompareAndSwap32 ptr old new_ mem)
+	// cond: config.RegSize == 4
+	// result: (LoweredAtomicCas32 ptr old new_ mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpRISCVXORI)
-		v.AuxInt = int64(-1)
-		v.AddArg(x)
+		ptr := v.Args[0]
+		old := v.Args[1]
+		new_ := v.Args[2]
+		mem := v.Args[3]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVLoweredAtomicCas32)
+		v.AddArg(ptr)
+		v.AddArg(old)
+		v.AddArg(new_)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpCom64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Com64 x)
+	// match: (AtomicCompareAndSwap32 ptr old new_ mem)
 	// cond:
-	// result: (XORI [int64(-1)] x)
+	// result: (LoweredAtomicCas32 ptr (SignExt32to64 old) new_ mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpRISCVXORI)
-		v.AuxInt = int64(-1)
-		v.AddArg(x)
+		ptr := v.Args[0]
+		old := v.Args[1]
+		new_ := v.Args[2]
+		mem := v.Args[3]
+		v.reset(OpRISCVLoweredAtomicCas32)
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v0.AddArg(old)
+		v.AddArg(v0)
+		v.AddArg(new_)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCom8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicCompareAndSwap64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Com8  x)
+	// match: (AtomicCompareAndSwap64 ptr old new_ mem)
 	// cond:
-	// result: (XORI [int64(-1)] x)
+	// result: (LoweredAtomicCas64 ptr old new_ mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpRISCVXORI)
-		v.AuxInt = int64(-1)
-		v.AddArg(x)
+		ptr := v.Args[0]
+		old := v.Args[1]
+		new_ := v.Args[2]
+		mem := v.Args[3]
+		v.reset(OpRISCVLoweredAtomicCas64)
+		v.AddArg(ptr)
+		v.AddArg(old)
+		v.AddArg(new_)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConst16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicExchange32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Const16 [val])
+	// match: (AtomicExchange32 ptr val mem)
 	// cond:
-	// result: (MOVHconst [val])
+	// result: (LoweredAtomicExchange32 ptr val mem)
 	for {
-		val := v.AuxInt
-		v.reset(OpRISCVMOVHconst)
-		v.AuxInt = val
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicExchange32)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConst32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicExchange64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Const32 [val])
+	// match: (AtomicExchange64 ptr val mem)
 	// cond:
-	// result: (MOVWconst [val])
+	// result: (LoweredAtomicExchange64 ptr val mem)
 	for {
-		val := v.AuxInt
-		v.reset(OpRISCVMOVWconst)
-		v.AuxInt = val
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicExchange64)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConst32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicLoad32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Const32F [val])
+	// match: (AtomicLoad32  ptr mem)
 	// cond:
-	// result: (FMVSX (MOVSconst [val]))
+	// result: (LoweredAtomicLoad32 ptr mem)
 	for {
-		val := v.AuxInt
-		v.reset(OpRISCVFMVSX)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVSconst, config.fe.TypeFloat32())
-		v0.AuxInt = val
-		v.AddArg(v0)
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		v.reset(OpRISCVLoweredAtomicLoad32)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConst64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicLoad64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Const64 [val])
+	// match: (AtomicLoad64  ptr mem)
 	// cond:
-	// result: (MOVDconst [val])
+	// result: (LoweredAtomicLoad64 ptr mem)
 	for {
-		val := v.AuxInt
-		v.reset(OpRISCVMOVDconst)
-		v.AuxInt = val
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		v.reset(OpRISCVLoweredAtomicLoad64)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConst64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicLoadPtr(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Const64F [val])
-	// cond:
-	// result: (FMVDX (MOVDconst [val]))
+	// match: (AtomicLoadPtr      ptr     mem)
+	// cond: config.PtrSize == 4
+	// result: (LoweredAtomicLoad32  ptr     mem)
 	for {
-		val := v.AuxInt
-		v.reset(OpRISCVFMVDX)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v0.AuxInt = val
-		v.AddArg(v0)
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.PtrSize == 4) {
+			break
+		}
+		v.reset(OpRISCVLoweredAtomicLoad32)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpConst8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Const8 [val])
+	// match: (AtomicLoadPtr ptr mem)
 	// cond:
-	// result: (MOVBconst [val])
+	// result: (LoweredAtomicLoad64 ptr mem)
 	for {
-		val := v.AuxInt
-		v.reset(OpRISCVMOVBconst)
-		v.AuxInt = val
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		v.reset(OpRISCVLoweredAtomicLoad64)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConstBool(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicOr8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (ConstBool [b])
+	// match: (AtomicOr8 ptr val mem)
 	// cond:
-	// result: (MOVBconst [b])
+	// result: (LoweredAtomicOr32 (ANDI <config.fe.TypeUInt32().PtrTo()> [^3] ptr) 		(SLL <config.fe.TypeUInt32()> (ZeroExt8to32 val) 			(SLLI <config.fe.TypeUInt64()> [3] 				(ANDI <config.fe.TypeUInt64()> [3] ptr))) mem)
 	for {
-		b := v.AuxInt
-		v.reset(OpRISCVMOVBconst)
-		v.AuxInt = b
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicOr32)
+		v0 := b.NewValue0(v.Pos, OpRISCVANDI, config.fe.TypeUInt32().PtrTo())
+		v0.AuxInt = ^3
+		v0.AddArg(ptr)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVSLL, config.fe.TypeUInt32())
+		v2 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v2.AddArg(val)
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt64())
+		v3.AuxInt = 3
+		v4 := b.NewValue0(v.Pos, OpRISCVANDI, config.fe.TypeUInt64())
+		v4.AuxInt = 3
+		v4.AddArg(ptr)
+		v3.AddArg(v4)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConstNil(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicStore32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (ConstNil)
+	// match: (AtomicStore32      ptr val mem)
 	// cond:
-	// result: (MOVDconst [0])
+	// result: (LoweredAtomicStore32 ptr val mem)
 	for {
-		v.reset(OpRISCVMOVDconst)
-		v.AuxInt = 0
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicStore32)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpConvert(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicStore64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Convert x mem)
+	// match: (AtomicStore64      ptr val mem)
 	// cond:
-	// result: (MOVconvert x mem)
+	// result: (LoweredAtomicStore64 ptr val mem)
 	for {
-		x := v.Args[0]
-		mem := v.Args[1]
-		v.reset(OpRISCVMOVconvert)
-		v.AddArg(x)
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicStore64)
+		v.AddArg(ptr)
+		v.AddArg(val)
 		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCvt32Fto32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAtomicStorePtrNoWB(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Cvt32Fto32 x)
-	// cond:
-	// result: (FCVTWS x)
+	// match: (AtomicStorePtrNoWB ptr val mem)
+	// cond: config.PtrSize == 4
+	// result: (LoweredAtomicStore32 ptr val mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpRISCVFCVTWS)
-		v.AddArg(x)
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(config.PtrSize == 4) {
+			break
+		}
+		v.reset(OpRISCVLoweredAtomicStore32)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpCvt32Fto64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Cvt32Fto64 x)
+	// match: (AtomicStorePtrNoWB ptr val mem)
 	// cond:
-	// result: (FCVTLS x)
+	// result: (LoweredAtomicStore64 ptr val mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpRISCVFCVTLS)
-		v.AddArg(x)
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredAtomicStore64)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCvt32Fto64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpAvg64u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Cvt32Fto64F x)
+	// match: (Avg64u <t> x y)
 	// cond:
-	// result: (FCVTDS x)
+	// result: (ADD (ADD <t> (SRLI <t> [1] x) (SRLI <t> [1] y)) (ANDI <t> [1] (AND <t> x y)))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTDS)
-		v.AddArg(x)
+		y := v.Args[1]
+		v.reset(OpRISCVADD)
+		v0 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v1.AuxInt = 1
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v2.AuxInt = 1
+		v2.AddArg(y)
+		v0.AddArg(v2)
+		v.AddArg(v0)
+		v3 := b.NewValue0(v.Pos, OpRISCVANDI, t)
+		v3.AuxInt = 1
+		v4 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v4.AddArg(x)
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v.AddArg(v3)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCvt32to32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpBitLen32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Cvt32to32F x)
-	// cond:
-	// result: (FCVTSW x)
+	// match: (BitLen32 <t> x)
+	// cond: config.RegSize == 4
+	// result: (SUB (MOVDconst [32]) (CLZ <t> x))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTSW)
-		v.AddArg(x)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSUB)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v0.AuxInt = 32
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVCLZ, t)
+		v1.AddArg(x)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpCvt32to64F(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Cvt32to64F x)
+	// match: (BitLen32 <t> x)
 	// cond:
-	// result: (FCVTDW x)
+	// result: (SUB (MOVDconst [32]) (CLZW <t> x))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTDW)
-		v.AddArg(x)
+		v.reset(OpRISCVSUB)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v0.AuxInt = 32
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVCLZW, t)
+		v1.AddArg(x)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCvt64Fto32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpBitLen64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Cvt64Fto32 x)
+	// match: (BitLen64 <t> x)
 	// cond:
-	// result: (FCVTWD x)
+	// result: (SUB (MOVDconst [64]) (CLZ <t> x))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTWD)
-		v.AddArg(x)
+		v.reset(OpRISCVSUB)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v0.AuxInt = 64
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVCLZ, t)
+		v1.AddArg(x)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCvt64Fto32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpBswap32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Cvt64Fto32F x)
-	// cond:
-	// result: (FCVTSD x)
+	// match: (Bswap32 x)
+	// cond: config.RegSize == 4 && config.riscvB
+	// result: (REV8 x)
 	for {
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTSD)
+		if !(config.RegSize == 4 && config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVREV8)
 		v.AddArg(x)
 		return true
 	}
-}
-func rewriteValueRISCV_OpCvt64Fto64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Cvt64Fto64 x)
+	// match: (Bswap32 <t> x)
+	// cond: config.RegSize == 4
+	// result: (OR (OR <t> (SLLI <t> [24] x) (AND <t> (MOVDconst [0xff0000]) (SLLI <t> [8] x))) 		(OR <t> (AND <t> (MOVDconst [0xff00]) (SRLI <t> [8] x)) (SRLI <t> [24] x)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVOR)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v1.AuxInt = 24
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AuxInt = 0xff0000
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v4.AuxInt = 8
+		v4.AddArg(x)
+		v2.AddArg(v4)
+		v0.AddArg(v2)
+		v.AddArg(v0)
+		v5 := b.NewValue0(v.Pos, OpRISCVOR, t)
+		v6 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v7 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v7.AuxInt = 0xff00
+		v6.AddArg(v7)
+		v8 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v8.AuxInt = 8
+		v8.AddArg(x)
+		v6.AddArg(v8)
+		v5.AddArg(v6)
+		v9 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v9.AuxInt = 24
+		v9.AddArg(x)
+		v5.AddArg(v9)
+		v.AddArg(v5)
+		return true
+	}
+	// match: (Bswap32 <t> x)
+	// cond: config.riscvB
+	// result: (SRLI [32] (REV8 <t> x))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = 32
+		v0 := b.NewValue0(v.Pos, OpRISCVREV8, t)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Bswap32 <t> x)
 	// cond:
-	// result: (FCVTLD x)
+	// result: (OR (OR <t> (SRLI <t> [32] (SLLI <t> [56] x)) (AND <t> (MOVDconst [0xff0000]) (SLLI <t> [8] x))) 		(OR <t> (AND <t> (MOVDconst [0xff00]) (SRLI <t> [8] x)) (AND <t> (MOVDconst [0xff]) (SRLI <t> [24] x))))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTLD)
+		v.reset(OpRISCVOR)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v1.AuxInt = 32
+		v2 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v2.AuxInt = 56
+		v2.AddArg(x)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v3 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v4.AuxInt = 0xff0000
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v5.AuxInt = 8
+		v5.AddArg(x)
+		v3.AddArg(v5)
+		v0.AddArg(v3)
+		v.AddArg(v0)
+		v6 := b.NewValue0(v.Pos, OpRISCVOR, t)
+		v7 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v8 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v8.AuxInt = 0xff00
+		v7.AddArg(v8)
+		v9 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v9.AuxInt = 8
+		v9.AddArg(x)
+		v7.AddArg(v9)
+		v6.AddArg(v7)
+		v10 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v11 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v11.AuxInt = 0xff
+		v10.AddArg(v11)
+		v12 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v12.AuxInt = 24
+		v12.AddArg(x)
+		v10.AddArg(v12)
+		v6.AddArg(v10)
+		v.AddArg(v6)
+		return true
+	}
+}
+func rewriteValueRISCV_OpBswap64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Bswap64 x)
+	// cond: config.riscvB
+	// result: (REV8 x)
+	for {
+		x := v.Args[0]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVREV8)
 		v.AddArg(x)
 		return true
 	}
-}
-func rewriteValueRISCV_OpCvt64to32F(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Cvt64to32F x)
+	// match: (Bswap64 <t> x)
 	// cond:
-	// result: (FCVTSL x)
+	// result: (OR (SLLI <t> [32] (Bswap32 <t> x)) (Bswap32 <t> (SRLI <t> [32] x)))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTSL)
-		v.AddArg(x)
+		v.reset(OpRISCVOR)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v0.AuxInt = 32
+		v1 := b.NewValue0(v.Pos, OpBswap32, t)
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpBswap32, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v3.AuxInt = 32
+		v3.AddArg(x)
+		v2.AddArg(v3)
+		v.AddArg(v2)
 		return true
 	}
 }
-func rewriteValueRISCV_OpCvt64to64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCeil(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Cvt64to64F x)
+	// match: (Ceil x)
 	// cond:
-	// result: (FCVTDL x)
+	// result: (LoweredCeilD x)
 	for {
 		x := v.Args[0]
-		v.reset(OpRISCVFCVTDL)
+		v.reset(OpRISCVLoweredCeilD)
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpDeferCall(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpClosureCall(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (DeferCall   [argwid]               mem)
+	// match: (ClosureCall [argwid] entry closure mem)
 	// cond:
-	// result: (CALLdefer   [argwid]               mem)
+	// result: (CALLclosure [argwid] entry closure mem)
 	for {
 		argwid := v.AuxInt
-		mem := v.Args[0]
-		v.reset(OpRISCVCALLdefer)
+		entry := v.Args[0]
+		closure := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVCALLclosure)
 		v.AuxInt = argwid
+		v.AddArg(entry)
+		v.AddArg(closure)
 		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCom16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div16 x y)
+	// match: (Com16 x)
 	// cond:
-	// result: (DIVW  (SignExt16to32 x) (SignExt16to32 y))
+	// result: (XORI [int64(-1)] x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIVW)
-		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		v.reset(OpRISCVXORI)
+		v.AuxInt = int64(-1)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv16u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCom32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div16u x y)
+	// match: (Com32 x)
 	// cond:
-	// result: (DIVUW (ZeroExt16to32 x) (ZeroExt16to32 y))
+	// result: (XORI [int64(-1)] x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIVUW)
-		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		v.reset(OpRISCVXORI)
+		v.AuxInt = int64(-1)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCom64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div32 x y)
+	// match: (Com64 x)
 	// cond:
-	// result: (DIVW  x y)
+	// result: (XORI [int64(-1)] x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIVW)
+		v.reset(OpRISCVXORI)
+		v.AuxInt = int64(-1)
 		v.AddArg(x)
-		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCom8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div32F x y)
+	// match: (Com8  x)
 	// cond:
-	// result: (FDIVS x y)
+	// result: (XORI [int64(-1)] x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFDIVS)
+		v.reset(OpRISCVXORI)
+		v.AuxInt = int64(-1)
 		v.AddArg(x)
-		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv32u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConst16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div32u x y)
+	// match: (Const16  [val])
 	// cond:
-	// result: (DIVUW x y)
+	// result: (MOVDconst [val])
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIVUW)
-		v.AddArg(x)
-		v.AddArg(y)
+		val := v.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = val
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConst32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div64 x y)
+	// match: (Const32  [val])
 	// cond:
-	// result: (DIV   x y)
+	// result: (MOVDconst [val])
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIV)
-		v.AddArg(x)
-		v.AddArg(y)
+		val := v.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = val
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConst32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div64F x y)
+	// match: (Const32F [val])
 	// cond:
-	// result: (FDIVD x y)
+	// result: (FMVSX (MOVSconst [val]))
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFDIVD)
-		v.AddArg(x)
-		v.AddArg(y)
+		val := v.AuxInt
+		v.reset(OpRISCVFMVSX)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVSconst, config.fe.TypeFloat32())
+		v0.AuxInt = val
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv64u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConst64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div64u x y)
+	// match: (Const64  [val])
 	// cond:
-	// result: (DIVU  x y)
+	// result: (MOVDconst [val])
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIVU)
-		v.AddArg(x)
-		v.AddArg(y)
+		val := v.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = val
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConst64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div8 x y)
+	// match: (Const64F [val])
 	// cond:
-	// result: (DIVW  (SignExt8to32 x)  (SignExt8to32 y))
+	// result: (FMOVDconst [val])
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIVW)
-		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		val := v.AuxInt
+		v.reset(OpRISCVFMOVDconst)
+		v.AuxInt = val
 		return true
 	}
 }
-func rewriteValueRISCV_OpDiv8u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConst8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Div8u x y)
+	// match: (Const8   [val])
 	// cond:
-	// result: (DIVUW (ZeroExt8to32 x)  (ZeroExt8to32 y))
+	// result: (MOVDconst [val])
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVDIVUW)
-		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		val := v.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = val
 		return true
 	}
 }
-func rewriteValueRISCV_OpEq16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConstBool(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Eq16  x y)
+	// match: (ConstBool [b])
 	// cond:
-	// result: (SEQZ (ZeroExt16to64 (SUB <x.Type> x y)))
+	// result: (MOVDconst [b])
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSEQZ)
-		v0 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
-		v1.AddArg(x)
-		v1.AddArg(y)
-		v0.AddArg(v1)
-		v.AddArg(v0)
+		b := v.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = b
 		return true
 	}
 }
-func rewriteValueRISCV_OpEq32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConstNil(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Eq32  x y)
+	// match: (ConstNil)
 	// cond:
-	// result: (SEQZ (ZeroExt32to64 (SUB <x.Type> x y)))
+	// result: (MOVDconst [0])
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSEQZ)
-		v0 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
-		v1.AddArg(x)
-		v1.AddArg(y)
-		v0.AddArg(v1)
-		v.AddArg(v0)
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
 		return true
 	}
 }
-func rewriteValueRISCV_OpEq32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpConvert(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Eq32F x y)
+	// match: (Convert x mem)
 	// cond:
-	// result: (FEQS x y)
+	// result: (MOVconvert x mem)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFEQS)
+		mem := v.Args[1]
+		v.reset(OpRISCVMOVconvert)
 		v.AddArg(x)
-		v.AddArg(y)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpEq64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCopysign(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Eq64  x y)
+	// match: (Copysign x y)
 	// cond:
-	// result: (SEQZ (SUB <x.Type> x y))
+	// result: (FSGNJD x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSEQZ)
-		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFSGNJD)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpEq64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCtz32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Eq64F x y)
-	// cond:
-	// result: (FEQD x y)
+	// match: (Ctz32 x)
+	// cond: config.RegSize == 4 && config.riscvB
+	// result: (CTZ x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFEQD)
+		if !(config.RegSize == 4 && config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVCTZ)
 		v.AddArg(x)
-		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpEq8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Eq8   x y)
-	// cond:
-	// result: (SEQZ (ZeroExt8to64  (SUB <x.Type> x y)))
+	// match: (Ctz32 <t> x)
+	// cond: config.RegSize == 4
+	// result: (PopCount32 <t> (AND <t> (ADDI <t> [-1] x) (XORI <t> [-1] x)))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSEQZ)
-		v0 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpPopCount32)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, t)
+		v1.AuxInt = -1
 		v1.AddArg(x)
-		v1.AddArg(y)
 		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpRISCVXORI, t)
+		v2.AuxInt = -1
+		v2.AddArg(x)
+		v0.AddArg(v2)
 		v.AddArg(v0)
 		return true
 	}
-}
-func rewriteValueRISCV_OpEqB(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (EqB  x y)
-	// cond:
-	// result: (Eq8  x y)
+	// match: (Ctz32 x)
+	// cond: config.riscvB
+	// result: (CTZW x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpEq8)
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVCTZW)
 		v.AddArg(x)
-		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpEqPtr(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (EqPtr x y)
+	// match: (Ctz32 <t> x)
 	// cond:
-	// result: (SEQZ (SUB <x.Type> x y))
+	// result: (Ctz64 <t> (OR <t> x (MOVDconst [1<<32])))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSEQZ)
-		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v.reset(OpCtz64)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, t)
 		v0.AddArg(x)
-		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AuxInt = 1 << 32
+		v0.AddArg(v1)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCtz64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq16  x y)
+	// match: (Ctz64 x)
+	// cond: config.riscvB
+	// result: (CTZ x)
+	for {
+		x := v.Args[0]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVCTZ)
+		v.AddArg(x)
+		return true
+	}
+	// match: (Ctz64 <t> x)
 	// cond:
-	// result: (Not (Less16  x y))
+	// result: (PopCount64 <t> (AND <t> (ADDI <t> [-1] x) (XORI <t> [-1] x)))
 	for {
+		t := v.Type
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess16, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
+		v.reset(OpPopCount64)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, t)
+		v1.AuxInt = -1
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpRISCVXORI, t)
+		v2.AuxInt = -1
+		v2.AddArg(x)
+		v0.AddArg(v2)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq16U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32Fto32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq16U x y)
+	// match: (Cvt32Fto32 x)
 	// cond:
-	// result: (Not (Less16U x y))
+	// result: (FCVTWS x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess16U, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFCVTWS)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32Fto32U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq32  x y)
+	// match: (Cvt32Fto32U x)
 	// cond:
-	// result: (Not (Less32  x y))
+	// result: (FCVTWUS x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess32, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFCVTWUS)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32Fto64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq32F x y)
+	// match: (Cvt32Fto64 x)
 	// cond:
-	// result: (FLES y x)
+	// result: (FCVTLS x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFLES)
-		v.AddArg(y)
+		v.reset(OpRISCVFCVTLS)
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq32U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32Fto64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq32U x y)
+	// match: (Cvt32Fto64F x)
 	// cond:
-	// result: (Not (Less32U x y))
+	// result: (FCVTDS x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess32U, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFCVTDS)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32Uto32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq64  x y)
+	// match: (Cvt32Uto32F x)
 	// cond:
-	// result: (Not (Less64  x y))
+	// result: (FCVTSWU x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess64, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFCVTSWU)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32Uto64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq64F x y)
+	// match: (Cvt32Uto64F x)
 	// cond:
-	// result: (FLED y x)
+	// result: (FCVTDWU x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFLED)
-		v.AddArg(y)
+		v.reset(OpRISCVFCVTDWU)
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq64U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32to32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq64U x y)
+	// match: (Cvt32to32F x)
 	// cond:
-	// result: (Not (Less64U x y))
+	// result: (FCVTSW x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess64U, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFCVTSW)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt32to64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq8   x y)
+	// match: (Cvt32to64F x)
 	// cond:
-	// result: (Not (Less8   x y))
+	// result: (FCVTDW x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess8, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFCVTDW)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGeq8U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt64Fto32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Geq8U  x y)
+	// match: (Cvt64Fto32 x)
 	// cond:
-	// result: (Not (Less8U  x y))
+	// result: (FCVTWD x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess8U, config.fe.TypeBool())
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		v.reset(OpRISCVFCVTWD)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGetClosurePtr(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt64Fto32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (GetClosurePtr)
+	// match: (Cvt64Fto32F x)
 	// cond:
-	// result: (LoweredGetClosurePtr)
+	// result: (FCVTSD x)
 	for {
-		v.reset(OpRISCVLoweredGetClosurePtr)
+		x := v.Args[0]
+		v.reset(OpRISCVFCVTSD)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGoCall(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt64Fto32U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (GoCall      [argwid]               mem)
+	// match: (Cvt64Fto32U x)
 	// cond:
-	// result: (CALLgo      [argwid]               mem)
+	// result: (FCVTWUD x)
 	for {
-		argwid := v.AuxInt
-		mem := v.Args[0]
-		v.reset(OpRISCVCALLgo)
-		v.AuxInt = argwid
-		v.AddArg(mem)
+		x := v.Args[0]
+		v.reset(OpRISCVFCVTWUD)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt64Fto64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater16  x y)
+	// match: (Cvt64Fto64 x)
 	// cond:
-	// result: (Less16  y x)
+	// result: (FCVTLD x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpLess16)
-		v.AddArg(y)
+		v.reset(OpRISCVFCVTLD)
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater16U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt64to32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater16U x y)
+	// match: (Cvt64to32F x)
 	// cond:
-	// result: (Less16U y x)
+	// result: (FCVTSL x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpLess16U)
-		v.AddArg(y)
+		v.reset(OpRISCVFCVTSL)
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpCvt64to64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater32  x y)
+	// match: (Cvt64to64F x)
 	// cond:
-	// result: (Less32  y x)
+	// result: (FCVTDL x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpLess32)
-		v.AddArg(y)
+		v.reset(OpRISCVFCVTDL)
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDeferCall(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater32F x y)
+	// match: (DeferCall   [argwid]               mem)
 	// cond:
-	// result: (FLTS y x)
+	// result: (CALLdefer   [argwid]               mem)
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFLTS)
-		v.AddArg(y)
-		v.AddArg(x)
+		argwid := v.AuxInt
+		mem := v.Args[0]
+		v.reset(OpRISCVCALLdefer)
+		v.AuxInt = argwid
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater32U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater32U x y)
+	// match: (Div16 x y)
+	// cond: config.RegSize == 4
+	// result: (DIV  (SignExt16to32 x) (SignExt16to32 y))
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVDIV)
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Div16 x y)
 	// cond:
-	// result: (Less32U y x)
+	// result: (DIVW  (SignExt16to32 x) (SignExt16to32 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpLess32U)
-		v.AddArg(y)
-		v.AddArg(x)
+		v.reset(OpRISCVDIVW)
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv16u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater64  x y)
+	// match: (Div16u x y)
+	// cond: config.RegSize == 4
+	// result: (DIVU (ZeroExt16to32 x) (ZeroExt16to32 y))
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVDIVU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Div16u x y)
 	// cond:
-	// result: (Less64  y x)
+	// result: (DIVUW (ZeroExt16to32 x) (ZeroExt16to32 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpLess64)
-		v.AddArg(y)
-		v.AddArg(x)
+		v.reset(OpRISCVDIVUW)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater64F x y)
-	// cond:
-	// result: (FLTD y x)
+	// match: (Div32 x y)
+	// cond: config.RegSize == 4
+	// result: (DIV  x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFLTD)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVDIV)
+		v.AddArg(x)
 		v.AddArg(y)
+		return true
+	}
+	// match: (Div32 x y)
+	// cond:
+	// result: (DIVW  x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVDIVW)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater64U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater64U x y)
+	// match: (Div32F x y)
 	// cond:
-	// result: (Less64U y x)
+	// result: (FDIVS x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpLess64U)
-		v.AddArg(y)
+		v.reset(OpRISCVFDIVS)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv32u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater8   x y)
-	// cond:
-	// result: (Less8   y x)
+	// match: (Div32u x y)
+	// cond: config.RegSize == 4
+	// result: (DIVU x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpLess8)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVDIVU)
+		v.AddArg(x)
 		v.AddArg(y)
+		return true
+	}
+	// match: (Div32u x y)
+	// cond:
+	// result: (DIVUW x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVDIVUW)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpGreater8U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Greater8U  x y)
+	// match: (Div64 x y)
 	// cond:
-	// result: (Less8U  y x)
+	// result: (DIV   x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpLess8U)
-		v.AddArg(y)
+		v.reset(OpRISCVDIV)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpHmul16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Hmul16 x y)
+	// match: (Div64F x y)
 	// cond:
-	// result: (SRAI [16] (MULW (SignExt16to32 x) (SignExt16to32 y)))
+	// result: (FDIVD x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 16
-		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
-		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v2 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
-		v2.AddArg(y)
-		v0.AddArg(v2)
-		v.AddArg(v0)
+		v.reset(OpRISCVFDIVD)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpHmul16u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv64u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Hmul16u x y)
+	// match: (Div64u x y)
 	// cond:
-	// result: (SRLI [16] (MULW (ZeroExt16to32 x) (ZeroExt16to32 y)))
+	// result: (DIVU  x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 16
-		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v2 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
-		v2.AddArg(y)
-		v0.AddArg(v2)
-		v.AddArg(v0)
+		v.reset(OpRISCVDIVU)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpHmul32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Hmul32 x y)
-	// cond:
-	// result: (SRAI [32] (MUL  (SignExt32to64 x) (SignExt32to64 y)))
+	// match: (Div8 x y)
+	// cond: config.RegSize == 4
+	// result: (DIV  (SignExt8to32 x)  (SignExt8to32 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 32
-		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
-		v1 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v2 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
-		v2.AddArg(y)
-		v0.AddArg(v2)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVDIV)
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v0.AddArg(x)
 		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpHmul32u(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Hmul32u x y)
+	// match: (Div8 x y)
 	// cond:
-	// result: (SRLI [32] (MUL  (ZeroExt32to64 x) (ZeroExt32to64 y)))
+	// result: (DIVW  (SignExt8to32 x)  (SignExt8to32 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 32
-		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
-		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v2 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v2.AddArg(y)
-		v0.AddArg(v2)
+		v.reset(OpRISCVDIVW)
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v0.AddArg(x)
 		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpHmul64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpDiv8u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Hmul64 x y)
-	// cond:
-	// result: (MULH  x y)
+	// match: (Div8u x y)
+	// cond: config.RegSize == 4
+	// result: (DIVU (ZeroExt8to32 x)  (ZeroExt8to32 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVMULH)
-		v.AddArg(x)
-		v.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVDIVU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpHmul64u(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Hmul64u x y)
+	// match: (Div8u x y)
 	// cond:
-	// result: (MULHU x y)
+	// result: (DIVUW (ZeroExt8to32 x)  (ZeroExt8to32 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVMULHU)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpRISCVDIVUW)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpHmul8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEq16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Hmul8 x y)
-	// cond:
-	// result: (SRAI [8]  (MULW (SignExt8to32 x)  (SignExt8to32 y)))
+	// match: (Eq16  x y)
+	// cond: config.RegSize == 4
+	// result: (SEQZ (ZeroExt16to32 (SUB <x.Type> x y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 8
-		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
-		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
 		v1.AddArg(x)
+		v1.AddArg(y)
 		v0.AddArg(v1)
-		v2 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
-		v2.AddArg(y)
-		v0.AddArg(v2)
 		v.AddArg(v0)
 		return true
 	}
-}
-func rewriteValueRISCV_OpHmul8u(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Hmul8u x y)
+	// match: (Eq16  x y)
 	// cond:
-	// result: (SRLI [8]  (MULW (ZeroExt8to32 x)  (ZeroExt8to32 y)))
+	// result: (SEQZ (ZeroExt16to64 (SUB <x.Type> x y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 8
-		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
 		v1.AddArg(x)
+		v1.AddArg(y)
 		v0.AddArg(v1)
-		v2 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
-		v2.AddArg(y)
-		v0.AddArg(v2)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpInterCall(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEq32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (InterCall   [argwid] entry         mem)
-	// cond:
-	// result: (CALLinter   [argwid] entry         mem)
+	// match: (Eq32  x y)
+	// cond: config.RegSize == 4
+	// result: (SEQZ (SUB <x.Type> x y))
 	for {
-		argwid := v.AuxInt
-		entry := v.Args[0]
-		mem := v.Args[1]
-		v.reset(OpRISCVCALLinter)
-		v.AuxInt = argwid
-		v.AddArg(entry)
-		v.AddArg(mem)
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
-}
-func rewriteValueRISCV_OpIsInBounds(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (IsInBounds idx len)
+	// match: (Eq32  x y)
 	// cond:
-	// result: (Less64U idx len)
+	// result: (SEQZ (ZeroExt32to64 (SUB <x.Type> x y)))
 	for {
-		idx := v.Args[0]
-		len := v.Args[1]
-		v.reset(OpLess64U)
-		v.AddArg(idx)
-		v.AddArg(len)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpIsNonNil(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEq32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (IsNonNil p)
+	// match: (Eq32F x y)
 	// cond:
-	// result: (NeqPtr (MOVDconst) p)
+	// result: (FEQS x y)
 	for {
-		p := v.Args[0]
-		v.reset(OpNeqPtr)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v.AddArg(v0)
-		v.AddArg(p)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVFEQS)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpIsSliceInBounds(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEq64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (IsSliceInBounds idx len)
+	// match: (Eq64  x y)
 	// cond:
-	// result: (Leq64U idx len)
+	// result: (SEQZ (SUB <x.Type> x y))
 	for {
-		idx := v.Args[0]
-		len := v.Args[1]
-		v.reset(OpLeq64U)
-		v.AddArg(idx)
-		v.AddArg(len)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEq64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq16  x y)
+	// match: (Eq64F x y)
 	// cond:
-	// result: (Not (Less16  y x))
+	// result: (FEQD x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess16, config.fe.TypeBool())
-		v0.AddArg(y)
-		v0.AddArg(x)
-		v.AddArg(v0)
+		v.reset(OpRISCVFEQD)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq16U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEq8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq16U x y)
-	// cond:
-	// result: (Not (Less16U y x))
+	// match: (Eq8   x y)
+	// cond: config.RegSize == 4
+	// result: (SEQZ (ZeroExt8to32  (SUB <x.Type> x y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess16U, config.fe.TypeBool())
-		v0.AddArg(y)
-		v0.AddArg(x)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
 		v.AddArg(v0)
 		return true
 	}
-}
-func rewriteValueRISCV_OpLeq32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Leq32  x y)
+	// match: (Eq8   x y)
 	// cond:
-	// result: (Not (Less32  y x))
+	// result: (SEQZ (ZeroExt8to64  (SUB <x.Type> x y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess32, config.fe.TypeBool())
-		v0.AddArg(y)
-		v0.AddArg(x)
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEqB(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq32F x y)
+	// match: (EqB  x y)
 	// cond:
-	// result: (FLES x y)
+	// result: (Eq8  x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFLES)
+		v.reset(OpEq8)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq32U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpEqPtr(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq32U x y)
+	// match: (EqPtr x y)
 	// cond:
-	// result: (Not (Less32U y x))
+	// result: (SEQZ (SUB <x.Type> x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess32U, config.fe.TypeBool())
-		v0.AddArg(y)
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
 		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpFMA(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq64  x y)
+	// match: (FMA x y z)
 	// cond:
-	// result: (Not (Less64  y x))
+	// result: (FMADDD x y z)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess64, config.fe.TypeBool())
-		v0.AddArg(y)
-		v0.AddArg(x)
-		v.AddArg(v0)
+		z := v.Args[2]
+		v.reset(OpRISCVFMADDD)
+		v.AddArg(x)
+		v.AddArg(y)
+		v.AddArg(z)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpFloor(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq64F x y)
+	// match: (Floor x)
 	// cond:
-	// result: (FLED x y)
+	// result: (LoweredFloorD x)
 	for {
 		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFLED)
+		v.reset(OpRISCVLoweredFloorD)
 		v.AddArg(x)
-		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq64U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq64U x y)
+	// match: (Geq16  x y)
 	// cond:
-	// result: (Not (Less64U y x))
+	// result: (Not (Less16  x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
 		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess64U, config.fe.TypeBool())
-		v0.AddArg(y)
+		v0 := b.NewValue0(v.Pos, OpLess16, config.fe.TypeBool())
 		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq16U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq8   x y)
+	// match: (Geq16U x y)
 	// cond:
-	// result: (Not (Less8   y x))
+	// result: (Not (Less16U x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
 		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess8, config.fe.TypeBool())
-		v0.AddArg(y)
+		v0 := b.NewValue0(v.Pos, OpLess16U, config.fe.TypeBool())
 		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLeq8U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Leq8U  x y)
+	// match: (Geq32  x y)
 	// cond:
-	// result: (Not (Less8U  y x))
+	// result: (Not (Less32  x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
 		v.reset(OpNot)
-		v0 := b.NewValue0(v.Pos, OpLess8U, config.fe.TypeBool())
-		v0.AddArg(y)
+		v0 := b.NewValue0(v.Pos, OpLess32, config.fe.TypeBool())
 		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less16  x y)
+	// match: (Geq32F x y)
 	// cond:
-	// result: (SLT  (SignExt16to64 x) (SignExt16to64 y))
+	// result: (FLES y x)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLT)
-		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		v.reset(OpRISCVFLES)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess16U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq32U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less16U x y)
+	// match: (Geq32U x y)
 	// cond:
-	// result: (SLTU (ZeroExt16to64 x) (ZeroExt16to64 y))
+	// result: (Not (Less32U x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLTU)
-		v0 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess32U, config.fe.TypeBool())
 		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v1.AddArg(y)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less32  x y)
+	// match: (Geq64  x y)
 	// cond:
-	// result: (SLT  (SignExt32to64 x) (SignExt32to64 y))
+	// result: (Not (Less64  x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLT)
-		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess64, config.fe.TypeBool())
 		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
-		v1.AddArg(y)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less32F x y)
+	// match: (Geq64F x y)
 	// cond:
-	// result: (FLTS x y)
+	// result: (FLED y x)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFLTS)
-		v.AddArg(x)
+		v.reset(OpRISCVFLED)
 		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess32U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq64U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less32U x y)
+	// match: (Geq64U x y)
 	// cond:
-	// result: (SLTU (ZeroExt32to64 x) (ZeroExt32to64 y))
+	// result: (Not (Less64U x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLTU)
-		v0 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess64U, config.fe.TypeBool())
 		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1.AddArg(y)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less64  x y)
+	// match: (Geq8   x y)
 	// cond:
-	// result: (SLT  x y)
+	// result: (Not (Less8   x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLT)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess8, config.fe.TypeBool())
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGeq8U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less64F x y)
+	// match: (Geq8U  x y)
 	// cond:
-	// result: (FLTD x y)
+	// result: (Not (Less8U  x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFLTD)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess8U, config.fe.TypeBool())
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess64U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGetClosurePtr(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less64U x y)
+	// match: (GetClosurePtr)
 	// cond:
-	// result: (SLTU x y)
+	// result: (LoweredGetClosurePtr)
+	for {
+		v.reset(OpRISCVLoweredGetClosurePtr)
+		return true
+	}
+}
+func rewriteValueRISCV_OpGoCall(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (GoCall      [argwid]               mem)
+	// cond:
+	// result: (CALLgo      [argwid]               mem)
+	for {
+		argwid := v.AuxInt
+		mem := v.Args[0]
+		v.reset(OpRISCVCALLgo)
+		v.AuxInt = argwid
+		v.AddArg(mem)
+		return true
+	}
+}
+func rewriteValueRISCV_OpGreater16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Greater16  x y)
+	// cond:
+	// result: (Less16  y x)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLTU)
-		v.AddArg(x)
+		v.reset(OpLess16)
 		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater16U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less8   x y)
+	// match: (Greater16U x y)
 	// cond:
-	// result: (SLT  (SignExt8to64  x) (SignExt8to64  y))
+	// result: (Less16U y x)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLT)
-		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		v.reset(OpLess16U)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLess8U(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Less8U  x y)
+	// match: (Greater32  x y)
 	// cond:
-	// result: (SLTU (ZeroExt8to64  x) (ZeroExt8to64  y))
+	// result: (Less32  y x)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSLTU)
-		v0 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		v.reset(OpLess32)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLoad(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Load <t> ptr mem)
-	// cond: t.IsBoolean()
-	// result: (MOVBUload ptr mem)
+	// match: (Greater32F x y)
+	// cond:
+	// result: (FLTS y x)
 	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(t.IsBoolean()) {
-			break
-		}
-		v.reset(OpRISCVMOVBUload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVFLTS)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
-	// match: (Load <t> ptr mem)
-	// cond: ( is8BitInt(t) &&  isSigned(t))
-	// result: (MOVBload  ptr mem)
+}
+func rewriteValueRISCV_OpGreater32U(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Greater32U x y)
+	// cond:
+	// result: (Less32U y x)
 	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is8BitInt(t) && isSigned(t)) {
-			break
-		}
-		v.reset(OpRISCVMOVBload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: ( is8BitInt(t) && !isSigned(t))
-	// result: (MOVBUload ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is8BitInt(t) && !isSigned(t)) {
-			break
-		}
-		v.reset(OpRISCVMOVBUload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: (is16BitInt(t) &&  isSigned(t))
-	// result: (MOVHload  ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is16BitInt(t) && isSigned(t)) {
-			break
-		}
-		v.reset(OpRISCVMOVHload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: (is16BitInt(t) && !isSigned(t))
-	// result: (MOVHUload ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is16BitInt(t) && !isSigned(t)) {
-			break
-		}
-		v.reset(OpRISCVMOVHUload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: (is32BitInt(t) &&  isSigned(t))
-	// result: (MOVWload  ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is32BitInt(t) && isSigned(t)) {
-			break
-		}
-		v.reset(OpRISCVMOVWload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: (is32BitInt(t) && !isSigned(t))
-	// result: (MOVWUload ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is32BitInt(t) && !isSigned(t)) {
-			break
-		}
-		v.reset(OpRISCVMOVWUload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: (is64BitInt(t) || isPtr(t))
-	// result: (MOVDload  ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is64BitInt(t) || isPtr(t)) {
-			break
-		}
-		v.reset(OpRISCVMOVDload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: is32BitFloat(t)
-	// result: (FMOVWload ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is32BitFloat(t)) {
-			break
-		}
-		v.reset(OpRISCVFMOVWload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Load <t> ptr mem)
-	// cond: is64BitFloat(t)
-	// result: (FMOVDload ptr mem)
-	for {
-		t := v.Type
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(is64BitFloat(t)) {
-			break
-		}
-		v.reset(OpRISCVFMOVDload)
-		v.AddArg(ptr)
-		v.AddArg(mem)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpLess32U)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpLsh16x16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh16x16 <t> x y)
+	// match: (Greater64  x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	// result: (Less64  y x)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg16, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpLess64)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh16x32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh16x32 <t> x y)
+	// match: (Greater64F x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// result: (FLTD y x)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg16, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpRISCVFLTD)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh16x64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater64U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh16x64 <t> x y)
+	// match: (Greater64U x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] y)))
+	// result: (Less64U y x)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg16, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v2.AddArg(y)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpLess64U)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh16x8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh16x8  <t> x y)
+	// match: (Greater8   x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// result: (Less8   y x)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg16, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpLess8)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh32x16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpGreater8U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh32x16 <t> x y)
+	// match: (Greater8U  x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	// result: (Less8U  y x)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg32, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpLess8U)
+		v.AddArg(y)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh32x32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh32x32 <t> x y)
-	// cond:
-	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// match: (Hmul16 x y)
+	// cond: config.RegSize == 4
+	// result: (SRAI [16] (MUL (SignExt16to32 x) (SignExt16to32 y)))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg32, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 16
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v2.AddArg(y)
+		v0.AddArg(v2)
+		v.AddArg(v0)
 		return true
 	}
-}
-func rewriteValueRISCV_OpLsh32x64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Lsh32x64 <t> x y)
+	// match: (Hmul16 x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] y)))
+	// result: (SRAI [16] (MULW (SignExt16to32 x) (SignExt16to32 y)))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg32, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 16
+		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
 		v2.AddArg(y)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v0.AddArg(v2)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh32x8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul16u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh32x8  <t> x y)
-	// cond:
-	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// match: (Hmul16u x y)
+	// cond: config.RegSize == 4
+	// result: (SRLI [16] (MUL (ZeroExt16to32 x) (ZeroExt16to32 y)))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = 16
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v2.AddArg(y)
+		v0.AddArg(v2)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg32, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpLsh64x16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Lsh64x16 <t> x y)
+	// match: (Hmul16u x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	// result: (SRLI [16] (MULW (ZeroExt16to32 x) (ZeroExt16to32 y)))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = 16
+		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v2.AddArg(y)
+		v0.AddArg(v2)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh64x32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh64x32 <t> x y)
-	// cond:
-	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// match: (Hmul32 x y)
+	// cond: config.RegSize == 4
+	// result: (MULH  x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMULH)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpLsh64x64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Lsh64x64 <t> x y)
+	// match: (Hmul32 x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] y)))
+	// result: (SRAI [32] (MUL  (SignExt32to64 x) (SignExt32to64 y)))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 32
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
+		v1 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
 		v2.AddArg(y)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v0.AddArg(v2)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh64x8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul32u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh64x8  <t> x y)
-	// cond:
-	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// match: (Hmul32u x y)
+	// cond: config.RegSize == 4
+	// result: (MULHU x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMULHU)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpLsh8x16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Lsh8x16  <t> x y)
+	// match: (Hmul32u x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	// result: (SRLI [32] (MUL  (ZeroExt32to64 x) (ZeroExt32to64 y)))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = 32
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
+		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v2.AddArg(y)
+		v0.AddArg(v2)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg8, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh8x32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh8x32  <t> x y)
+	// match: (Hmul64 x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// result: (MULH  x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg8, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpRISCVMULH)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh8x64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul64u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh8x64  <t> x y)
+	// match: (Hmul64u x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] y)))
+	// result: (MULHU x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg8, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v2.AddArg(y)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpRISCVMULHU)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpLsh8x8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Lsh8x8   <t> x y)
+	// match: (Hmul8 x y)
+	// cond: config.RegSize == 4
+	// result: (SRAI [8]  (MUL (SignExt8to32 x)  (SignExt8to32 y)))
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 8
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v2.AddArg(y)
+		v0.AddArg(v2)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Hmul8 x y)
 	// cond:
-	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// result: (SRAI [8]  (MULW (SignExt8to32 x)  (SignExt8to32 y)))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 8
+		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v2.AddArg(y)
+		v0.AddArg(v2)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg8, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpHmul8u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod16 x y)
+	// match: (Hmul8u x y)
+	// cond: config.RegSize == 4
+	// result: (SRLI [8]  (MUL (ZeroExt8to32 x)  (ZeroExt8to32 y)))
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = 8
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, config.fe.TypeInt64())
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v2.AddArg(y)
+		v0.AddArg(v2)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Hmul8u x y)
 	// cond:
-	// result: (REMW  (SignExt16to32 x) (SignExt16to32 y))
+	// result: (SRLI [8]  (MULW (ZeroExt8to32 x)  (ZeroExt8to32 y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVREMW)
-		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
-		v0.AddArg(x)
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = 8
+		v0 := b.NewValue0(v.Pos, OpRISCVMULW, config.fe.TypeInt32())
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v2.AddArg(y)
+		v0.AddArg(v2)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod16u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpInterCall(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod16u x y)
+	// match: (InterCall   [argwid] entry         mem)
 	// cond:
-	// result: (REMUW (ZeroExt16to32 x) (ZeroExt16to32 y))
+	// result: (CALLinter   [argwid] entry         mem)
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVREMUW)
-		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
-		v0.AddArg(x)
+		argwid := v.AuxInt
+		entry := v.Args[0]
+		mem := v.Args[1]
+		v.reset(OpRISCVCALLinter)
+		v.AuxInt = argwid
+		v.AddArg(entry)
+		v.AddArg(mem)
+		return true
+	}
+}
+func rewriteValueRISCV_OpIsInBounds(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (IsInBounds idx len)
+	// cond:
+	// result: (Less64U idx len)
+	for {
+		idx := v.Args[0]
+		len := v.Args[1]
+		v.reset(OpLess64U)
+		v.AddArg(idx)
+		v.AddArg(len)
+		return true
+	}
+}
+func rewriteValueRISCV_OpIsNonNil(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (IsNonNil p)
+	// cond:
+	// result: (NeqPtr (MOVDconst) p)
+	for {
+		p := v.Args[0]
+		v.reset(OpNeqPtr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
+		v.AddArg(p)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpIsSliceInBounds(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod32 x y)
+	// match: (IsSliceInBounds idx len)
 	// cond:
-	// result: (REMW  x y)
+	// result: (Leq64U idx len)
+	for {
+		idx := v.Args[0]
+		len := v.Args[1]
+		v.reset(OpLeq64U)
+		v.AddArg(idx)
+		v.AddArg(len)
+		return true
+	}
+}
+func rewriteValueRISCV_OpLeq16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Leq16  x y)
+	// cond:
+	// result: (Not (Less16  y x))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVREMW)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess16, config.fe.TypeBool())
+		v0.AddArg(y)
+		v0.AddArg(x)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod32u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLeq16U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod32u x y)
+	// match: (Leq16U x y)
 	// cond:
-	// result: (REMUW x y)
+	// result: (Not (Less16U y x))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVREMUW)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess16U, config.fe.TypeBool())
+		v0.AddArg(y)
+		v0.AddArg(x)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLeq32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod64 x y)
+	// match: (Leq32  x y)
 	// cond:
-	// result: (REM   x y)
+	// result: (Not (Less32  y x))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVREM)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess32, config.fe.TypeBool())
+		v0.AddArg(y)
+		v0.AddArg(x)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod64u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLeq32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod64u x y)
+	// match: (Leq32F x y)
 	// cond:
-	// result: (REMU  x y)
+	// result: (FLES x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVREMU)
+		v.reset(OpRISCVFLES)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLeq32U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod8 x y)
+	// match: (Leq32U x y)
 	// cond:
-	// result: (REMW  (SignExt8to32 x)  (SignExt8to32 y))
+	// result: (Not (Less32U y x))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVREMW)
-		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess32U, config.fe.TypeBool())
+		v0.AddArg(y)
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMod8u(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLeq64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mod8u x y)
+	// match: (Leq64  x y)
 	// cond:
-	// result: (REMUW (ZeroExt8to32 x)  (ZeroExt8to32 y))
+	// result: (Not (Less64  y x))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVREMUW)
-		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess64, config.fe.TypeBool())
+		v0.AddArg(y)
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
-		v1.AddArg(y)
-		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMove(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLeq64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Move [s]   _   _ mem)
-	// cond: SizeAndAlign(s).Size() == 0
-	// result: mem
+	// match: (Leq64F x y)
+	// cond:
+	// result: (FLED x y)
 	for {
-		s := v.AuxInt
-		mem := v.Args[2]
-		if !(SizeAndAlign(s).Size() == 0) {
-			break
-		}
-		v.reset(OpCopy)
-		v.Type = mem.Type
-		v.AddArg(mem)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVFLED)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
-	// match: (Move [s] dst src mem)
-	// cond: SizeAndAlign(s).Size() == 1
-	// result: (MOVBstore dst (MOVBload src mem) mem)
-	for {
-		s := v.AuxInt
-		dst := v.Args[0]
-		src := v.Args[1]
-		mem := v.Args[2]
-		if !(SizeAndAlign(s).Size() == 1) {
-			break
-		}
-		v.reset(OpRISCVMOVBstore)
-		v.AddArg(dst)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
-		v0.AddArg(src)
-		v0.AddArg(mem)
+}
+func rewriteValueRISCV_OpLeq64U(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Leq64U x y)
+	// cond:
+	// result: (Not (Less64U y x))
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess64U, config.fe.TypeBool())
+		v0.AddArg(y)
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v.AddArg(mem)
 		return true
 	}
-	// match: (Move [s] dst src mem)
-	// cond: SizeAndAlign(s).Size() == 2
-	// result: (MOVHstore dst (MOVHload src mem) mem)
+}
+func rewriteValueRISCV_OpLeq8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Leq8   x y)
+	// cond:
+	// result: (Not (Less8   y x))
 	for {
-		s := v.AuxInt
-		dst := v.Args[0]
-		src := v.Args[1]
-		mem := v.Args[2]
-		if !(SizeAndAlign(s).Size() == 2) {
-			break
-		}
-		v.reset(OpRISCVMOVHstore)
-		v.AddArg(dst)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
-		v0.AddArg(src)
-		v0.AddArg(mem)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess8, config.fe.TypeBool())
+		v0.AddArg(y)
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v.AddArg(mem)
 		return true
 	}
-	// match: (Move [s] dst src mem)
-	// cond: SizeAndAlign(s).Size() == 4
-	// result: (MOVWstore dst (MOVWload src mem) mem)
+}
+func rewriteValueRISCV_OpLeq8U(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Leq8U  x y)
+	// cond:
+	// result: (Not (Less8U  y x))
 	for {
-		s := v.AuxInt
-		dst := v.Args[0]
-		src := v.Args[1]
-		mem := v.Args[2]
-		if !(SizeAndAlign(s).Size() == 4) {
-			break
-		}
-		v.reset(OpRISCVMOVWstore)
-		v.AddArg(dst)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
-		v0.AddArg(src)
-		v0.AddArg(mem)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpNot)
+		v0 := b.NewValue0(v.Pos, OpLess8U, config.fe.TypeBool())
+		v0.AddArg(y)
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v.AddArg(mem)
 		return true
 	}
-	// match: (Move [s] dst src mem)
-	// cond: SizeAndAlign(s).Size() == 8
-	// result: (MOVDstore dst (MOVDload src mem) mem)
+}
+func rewriteValueRISCV_OpLess16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Less16  x y)
+	// cond: config.RegSize == 4
+	// result: (SLT  (SignExt16to32 x) (SignExt16to32 y))
 	for {
-		s := v.AuxInt
-		dst := v.Args[0]
-		src := v.Args[1]
-		mem := v.Args[2]
-		if !(SizeAndAlign(s).Size() == 8) {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVDstore)
-		v.AddArg(dst)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
-		v0.AddArg(src)
-		v0.AddArg(mem)
+		v.reset(OpRISCVSLT)
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v.AddArg(mem)
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (Move [s] dst src mem)
+	// match: (Less16  x y)
 	// cond:
-	// result: (LoweredMove [SizeAndAlign(s).Align()] 		dst 		src 		(ADDI <src.Type> [SizeAndAlign(s).Size()-moveSize(SizeAndAlign(s).Align(), config)] src) 		mem)
+	// result: (SLT  (SignExt16to64 x) (SignExt16to64 y))
 	for {
-		s := v.AuxInt
-		dst := v.Args[0]
-		src := v.Args[1]
-		mem := v.Args[2]
-		v.reset(OpRISCVLoweredMove)
-		v.AuxInt = SizeAndAlign(s).Align()
-		v.AddArg(dst)
-		v.AddArg(src)
-		v0 := b.NewValue0(v.Pos, OpRISCVADDI, src.Type)
-		v0.AuxInt = SizeAndAlign(s).Size() - moveSize(SizeAndAlign(s).Align(), config)
-		v0.AddArg(src)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSLT)
+		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v.AddArg(mem)
+		v1 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMul16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess16U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mul16 x y)
+	// match: (Less16U x y)
+	// cond: config.RegSize == 4
+	// result: (SLTU (ZeroExt16to32 x) (ZeroExt16to32 y))
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSLTU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Less16U x y)
 	// cond:
-	// result: (MULW (SignExt16to32 x) (SignExt16to32 y))
+	// result: (SLTU (ZeroExt16to64 x) (ZeroExt16to64 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVMULW)
-		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v.reset(OpRISCVSLTU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
 		v1.AddArg(y)
 		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMul32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mul32 x y)
-	// cond:
-	// result: (MULW x y)
+	// match: (Less32  x y)
+	// cond: config.RegSize == 4
+	// result: (SLT  x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVMULW)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSLT)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpMul32F(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Mul32F x y)
+	// match: (Less32  x y)
 	// cond:
-	// result: (FMULS x y)
+	// result: (SLT  (SignExt32to64 x) (SignExt32to64 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFMULS)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpRISCVSLT)
+		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMul64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mul64 x y)
+	// match: (Less32F x y)
 	// cond:
-	// result: (MUL  x y)
+	// result: (FLTS x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVMUL)
+		v.reset(OpRISCVFLTS)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpMul64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess32U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Mul64F x y)
-	// cond:
-	// result: (FMULD x y)
+	// match: (Less32U x y)
+	// cond: config.RegSize == 4
+	// result: (SLTU x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFMULD)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSLTU)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpMul8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Mul8 x y)
+	// match: (Less32U x y)
 	// cond:
-	// result: (MULW (SignExt8to32 x)  (SignExt8to32 y))
+	// result: (SLTU (ZeroExt32to64 x) (ZeroExt32to64 y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVMULW)
-		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v.reset(OpRISCVSLTU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
 		v1.AddArg(y)
 		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpNeg16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Neg16 x)
+	// match: (Less64  x y)
 	// cond:
-	// result: (SUB (MOVHconst) x)
+	// result: (SLT  x y)
 	for {
 		x := v.Args[0]
-		v.reset(OpRISCVSUB)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVHconst, config.fe.TypeUInt16())
-		v.AddArg(v0)
+		y := v.Args[1]
+		v.reset(OpRISCVSLT)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpNeg32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Neg32 x)
+	// match: (Less64F x y)
 	// cond:
-	// result: (SUB (MOVWconst) x)
+	// result: (FLTD x y)
 	for {
 		x := v.Args[0]
-		v.reset(OpRISCVSUB)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVWconst, config.fe.TypeUInt32())
-		v.AddArg(v0)
+		y := v.Args[1]
+		v.reset(OpRISCVFLTD)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpNeg32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess64U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Neg32F x)
+	// match: (Less64U x y)
 	// cond:
-	// result: (FNEGS x)
+	// result: (SLTU x y)
 	for {
 		x := v.Args[0]
-		v.reset(OpRISCVFNEGS)
+		y := v.Args[1]
+		v.reset(OpRISCVSLTU)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpNeg64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Neg64 x)
-	// cond:
-	// result: (SUB (MOVDconst) x)
-	for {
-		x := v.Args[0]
-		v.reset(OpRISCVSUB)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v.AddArg(v0)
-		v.AddArg(x)
-		return true
-	}
-}
-func rewriteValueRISCV_OpNeg64F(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Neg64F x)
-	// cond:
-	// result: (FNEGD x)
-	for {
-		x := v.Args[0]
-		v.reset(OpRISCVFNEGD)
-		v.AddArg(x)
-		return true
-	}
-}
-func rewriteValueRISCV_OpNeg8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Neg8  x)
-	// cond:
-	// result: (SUB (MOVBconst) x)
-	for {
-		x := v.Args[0]
-		v.reset(OpRISCVSUB)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVBconst, config.fe.TypeUInt8())
-		v.AddArg(v0)
-		v.AddArg(x)
-		return true
-	}
-}
-func rewriteValueRISCV_OpNeq16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Neq16  x y)
-	// cond:
-	// result: (SNEZ (ZeroExt16to64 (SUB <x.Type> x y)))
+	// match: (Less8   x y)
+	// cond: config.RegSize == 4
+	// result: (SLT  (SignExt8to32  x) (SignExt8to32  y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSNEZ)
-		v0 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
-		v1.AddArg(x)
-		v1.AddArg(y)
-		v0.AddArg(v1)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSLT)
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v0.AddArg(x)
 		v.AddArg(v0)
-		return true
-	}
-}
-func rewriteValueRISCV_OpNeq32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Neq32  x y)
-	// cond:
-	// result: (SNEZ (ZeroExt32to64 (SUB <x.Type> x y)))
-	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSNEZ)
-		v0 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
-		v1.AddArg(x)
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
 		v1.AddArg(y)
-		v0.AddArg(v1)
-		v.AddArg(v0)
-		return true
-	}
-}
-func rewriteValueRISCV_OpNeq32F(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Neq32F x y)
-	// cond:
-	// result: (FNES x y)
-	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVFNES)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpNeq64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Neq64  x y)
+	// match: (Less8   x y)
 	// cond:
-	// result: (SNEZ (SUB <x.Type> x y))
+	// result: (SLT  (SignExt8to64  x) (SignExt8to64  y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSNEZ)
-		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v.reset(OpRISCVSLT)
+		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
 		v0.AddArg(x)
-		v0.AddArg(y)
 		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpNeq64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLess8U(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Neq64F x y)
-	// cond:
-	// result: (FNED x y)
+	// match: (Less8U  x y)
+	// cond: config.RegSize == 4
+	// result: (SLTU (ZeroExt8to32  x) (ZeroExt8to32  y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFNED)
-		v.AddArg(x)
-		v.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSLTU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpNeq8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Neq8   x y)
+	// match: (Less8U  x y)
 	// cond:
-	// result: (SNEZ (ZeroExt8to64  (SUB <x.Type> x y)))
+	// result: (SLTU (ZeroExt8to64  x) (ZeroExt8to64  y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSNEZ)
+		v.reset(OpRISCVSLTU)
 		v0 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
-		v1.AddArg(x)
-		v1.AddArg(y)
-		v0.AddArg(v1)
+		v0.AddArg(x)
 		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpNeqB(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (NeqB x y)
-	// cond:
-	// result: (Neq8 x y)
-	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpNeq8)
-		v.AddArg(x)
-		v.AddArg(y)
-		return true
-	}
-}
-func rewriteValueRISCV_OpNeqPtr(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLoad(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (NeqPtr x y)
-	// cond:
-	// result: (SNEZ (SUB <x.Type> x y))
+	// match: (Load <t> ptr mem)
+	// cond: t.IsBoolean()
+	// result: (MOVBUload ptr mem)
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSNEZ)
-		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
+		t := v.Type
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(t.IsBoolean()) {
+			break
+		}
+		v.reset(OpRISCVMOVBUload)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpNilCheck(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (NilCheck ptr mem)
-	// cond:
-	// result: (LoweredNilCheck ptr mem)
+	// match: (Load <t> ptr mem)
+	// cond: ( is8BitInt(t) &&  isSigned(t))
+	// result: (MOVBload  ptr mem)
 	for {
+		t := v.Type
 		ptr := v.Args[0]
 		mem := v.Args[1]
-		v.reset(OpRISCVLoweredNilCheck)
+		if !(is8BitInt(t) && isSigned(t)) {
+			break
+		}
+		v.reset(OpRISCVMOVBload)
 		v.AddArg(ptr)
 		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpNot(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Not  x)
-	// cond:
-	// result: (XORI [1] x)
+	// match: (Load <t> ptr mem)
+	// cond: ( is8BitInt(t) && !isSigned(t))
+	// result: (MOVBUload ptr mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpRISCVXORI)
-		v.AuxInt = 1
-		v.AddArg(x)
+		t := v.Type
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(is8BitInt(t) && !isSigned(t)) {
+			break
+		}
+		v.reset(OpRISCVMOVBUload)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpOffPtr(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (OffPtr [off] ptr:(SP))
-	// cond:
-	// result: (MOVaddr [off] ptr)
+	// match: (Load <t> ptr mem)
+	// cond: (is16BitInt(t) &&  isSigned(t))
+	// result: (MOVHload  ptr mem)
 	for {
-		off := v.AuxInt
+		t := v.Type
 		ptr := v.Args[0]
-		if ptr.Op != OpSP {
+		mem := v.Args[1]
+		if !(is16BitInt(t) && isSigned(t)) {
 			break
 		}
-		v.reset(OpRISCVMOVaddr)
-		v.AuxInt = off
+		v.reset(OpRISCVMOVHload)
 		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
-	// match: (OffPtr [off] ptr)
-	// cond:
-	// result: (ADDI [off] ptr)
+	// match: (Load <t> ptr mem)
+	// cond: (is16BitInt(t) && !isSigned(t))
+	// result: (MOVHUload ptr mem)
 	for {
-		off := v.AuxInt
+		t := v.Type
 		ptr := v.Args[0]
-		v.reset(OpRISCVADDI)
-		v.AuxInt = off
+		mem := v.Args[1]
+		if !(is16BitInt(t) && !isSigned(t)) {
+			break
+		}
+		v.reset(OpRISCVMOVHUload)
 		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpOr16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Or16 x y)
-	// cond:
-	// result: (OR x y)
+	// match: (Load <t> ptr mem)
+	// cond: (is32BitInt(t) &&  isSigned(t))
+	// result: (MOVWload  ptr mem)
 	for {
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVOR)
-		v.AddArg(x)
-		v.AddArg(y)
+		t := v.Type
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(is32BitInt(t) && isSigned(t)) {
+			break
+		}
+		v.reset(OpRISCVMOVWload)
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Load <t> ptr mem)
+	// cond: (is32BitInt(t) && !isSigned(t) || isPtr(t) && config.PtrSize == 4)
+	// result: (MOVWUload ptr mem)
+	for {
+		t := v.Type
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(is32BitInt(t) && !isSigned(t) || isPtr(t) && config.PtrSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMOVWUload)
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Load <t> ptr mem)
+	// cond: (is64BitInt(t) || isPtr(t) && config.PtrSize == 8)
+	// result: (MOVDload  ptr mem)
+	for {
+		t := v.Type
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(is64BitInt(t) || isPtr(t) && config.PtrSize == 8) {
+			break
+		}
+		v.reset(OpRISCVMOVDload)
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Load <t> ptr mem)
+	// cond: is32BitFloat(t)
+	// result: (FMOVWload ptr mem)
+	for {
+		t := v.Type
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(is32BitFloat(t)) {
+			break
+		}
+		v.reset(OpRISCVFMOVWload)
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Load <t> ptr mem)
+	// cond: is64BitFloat(t)
+	// result: (FMOVDload ptr mem)
+	for {
+		t := v.Type
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(is64BitFloat(t)) {
+			break
+		}
+		v.reset(OpRISCVFMOVDload)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
+	return false
 }
-func rewriteValueRISCV_OpOr32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh16x16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Or32 x y)
-	// cond:
-	// result: (OR x y)
+	// match: (Lsh16x16 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [32] (ZeroExt16to32 y))))
 	for {
+		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVOR)
-		v.AddArg(x)
-		v.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg16, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpOr64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Or64 x y)
+	// match: (Lsh16x16 <t> x y)
 	// cond:
-	// result: (OR x y)
+	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
 	for {
+		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVOR)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg16, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpOr8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh16x32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Or8  x y)
-	// cond:
-	// result: (OR x y)
+	// match: (Lsh16x32 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [32] y)))
 	for {
+		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVOR)
-		v.AddArg(x)
-		v.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg16, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpOrB(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (OrB  x y)
+	// match: (Lsh16x32 <t> x y)
 	// cond:
-	// result: (OR   x y)
+	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
 	for {
+		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVOR)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg16, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRISCVADDI(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh16x64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (ADDI [c] (MOVaddr [d] {s} x))
-	// cond: is32Bit(c+d)
-	// result: (MOVaddr [c+d] {s} x)
+	// match: (Lsh16x64  x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 16
+	// result: (SLLI [c] x)
 	for {
-		c := v.AuxInt
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
 			break
 		}
-		d := v_0.AuxInt
-		s := v_0.Aux
-		x := v_0.Args[0]
-		if !(is32Bit(c + d)) {
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 16) {
 			break
 		}
-		v.reset(OpRISCVMOVaddr)
-		v.AuxInt = c + d
-		v.Aux = s
+		v.reset(OpRISCVSLLI)
+		v.AuxInt = c
 		v.AddArg(x)
 		return true
 	}
-	// match: (ADDI [0]  x)
-	// cond:
-	// result: x
+	// match: (Lsh16x64  _ (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 16
+	// result: (MOVDconst [0])
 	for {
-		if v.AuxInt != 0 {
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 16) {
 			break
 		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
+		return true
+	}
+	// match: (Lsh16x64 <t> x y)
+	// cond: config.RegSize == 8
+	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] y)))
+	for {
+		t := v.Type
 		x := v.Args[0]
-		v.reset(OpCopy)
-		v.Type = x.Type
-		v.AddArg(x)
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg16, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
 	return false
 }
-func rewriteValueRISCV_OpRISCVMOVBUload(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh16x8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVBUload [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVBUload [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	// match: (Lsh16x8  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [32] (ZeroExt8to32  y))))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVBUload)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(mem)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg16, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVBUload [off1] {sym} (ADDI [off2] base) mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVBUload [off1+off2] {sym} base mem)
-	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVBUload)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(mem)
+	// match: (Lsh16x8  <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg16, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpRISCVMOVBload(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh32x16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVBload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVBload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	// match: (Lsh32x16 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [32] (ZeroExt16to32 y))))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVBload)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(mem)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVBload  [off1] {sym} (ADDI [off2] base) mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVBload  [off1+off2] {sym} base mem)
+	// match: (Lsh32x16 <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVBload)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpRISCVMOVBstore(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh32x32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVBstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVBstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	// match: (Lsh32x32 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [32] y)))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVBstore)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVBstore [off1] {sym} (ADDI [off2] base) val mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVBstore [off1+off2] {sym} base val mem)
+	// match: (Lsh32x32 <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpLsh32x64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Lsh32x64  x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 32
+	// result: (SLLI [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
 			break
 		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1 + off2)) {
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 32) {
 			break
 		}
-		v.reset(OpRISCVMOVBstore)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
+		v.reset(OpRISCVSLLI)
+		v.AuxInt = c
+		v.AddArg(x)
 		return true
 	}
-	return false
-}
-func rewriteValueRISCV_OpRISCVMOVDconst(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (MOVDconst <t> [c])
-	// cond: !is32Bit(c) && int32(c) <  0
-	// result: (ADD (SLLI <t> [32] (MOVDconst [c>>32+1])) (MOVDconst [int64(int32(c))]))
+	// match: (Lsh32x64  _ (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 32
+	// result: (MOVDconst [0])
 	for {
-		t := v.Type
-		c := v.AuxInt
-		if !(!is32Bit(c) && int32(c) < 0) {
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
 			break
 		}
-		v.reset(OpRISCVADD)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 32
-		v1 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v1.AuxInt = c>>32 + 1
-		v0.AddArg(v1)
-		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v2.AuxInt = int64(int32(c))
-		v.AddArg(v2)
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 32) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
 		return true
 	}
-	// match: (MOVDconst <t> [c])
-	// cond: !is32Bit(c) && int32(c) >= 0
-	// result: (ADD (SLLI <t> [32] (MOVDconst [c>>32+0])) (MOVDconst [int64(int32(c))]))
+	// match: (Lsh32x64 <t> x y)
+	// cond: config.RegSize == 8
+	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] y)))
 	for {
 		t := v.Type
-		c := v.AuxInt
-		if !(!is32Bit(c) && int32(c) >= 0) {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
 			break
 		}
-		v.reset(OpRISCVADD)
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 32
-		v1 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v1.AuxInt = c>>32 + 0
-		v0.AddArg(v1)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v2.AuxInt = int64(int32(c))
-		v.AddArg(v2)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
 	return false
 }
-func rewriteValueRISCV_OpRISCVMOVDload(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh32x8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVDload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVDload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	// match: (Lsh32x8  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [32] (ZeroExt8to32  y))))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
-			break
-		}
-		v.reset(OpRISCVMOVDload)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(mem)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVDload  [off1] {sym} (ADDI [off2] base) mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVDload  [off1+off2] {sym} base mem)
+	// match: (Lsh32x8  <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVDload)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpRISCVMOVDstore(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh64x16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVDstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVDstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	// match: (Lsh64x16 <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
-			break
-		}
-		v.reset(OpRISCVMOVDstore)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVDstore [off1] {sym} (ADDI [off2] base) val mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVDstore [off1+off2] {sym} base val mem)
+}
+func rewriteValueRISCV_OpLsh64x32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Lsh64x32 <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVDstore)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpRISCVMOVHUload(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh64x64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVHUload [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVHUload [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	// match: (Lsh64x64 <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] y)))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
-			break
-		}
-		v.reset(OpRISCVMOVHUload)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVHUload [off1] {sym} (ADDI [off2] base) mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVHUload [off1+off2] {sym} base mem)
+}
+func rewriteValueRISCV_OpLsh64x8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Lsh64x8  <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVHUload)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpRISCVMOVHload(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh8x16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVHload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVHload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	// match: (Lsh8x16  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [32] (ZeroExt16to32 y))))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVHload)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(mem)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg8, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVHload  [off1] {sym} (ADDI [off2] base) mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVHload  [off1+off2] {sym} base mem)
+	// match: (Lsh8x16  <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVHload)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg8, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpRISCVMOVHstore(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh8x32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVHstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVHstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	// match: (Lsh8x32  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [32] y)))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVHstore)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg8, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	// match: (MOVHstore [off1] {sym} (ADDI [off2] base) val mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVHstore [off1+off2] {sym} base val mem)
+	// match: (Lsh8x32  <t> x y)
+	// cond:
+	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVHstore)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg8, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpRISCVMOVWUload(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh8x64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVWUload [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVWUload [off1+off2] {mergeSym(sym1,sym2)} base mem)
-	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
-			break
-		}
-		v.reset(OpRISCVMOVWUload)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (MOVWUload [off1] {sym} (ADDI [off2] base) mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVWUload [off1+off2] {sym} base mem)
+	// match: (Lsh8x64   x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 8
+	// result: (SLLI [c] x)
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
 			break
 		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1 + off2)) {
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 8) {
 			break
 		}
-		v.reset(OpRISCVMOVWUload)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(mem)
+		v.reset(OpRISCVSLLI)
+		v.AuxInt = c
+		v.AddArg(x)
 		return true
 	}
-	return false
-}
-func rewriteValueRISCV_OpRISCVMOVWload(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (MOVWload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVWload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	// match: (Lsh8x64   _ (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 8
+	// result: (MOVDconst [0])
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
 			break
 		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 8) {
 			break
 		}
-		v.reset(OpRISCVMOVWload)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(mem)
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
 		return true
 	}
-	// match: (MOVWload  [off1] {sym} (ADDI [off2] base) mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVWload  [off1+off2] {sym} base mem)
+	// match: (Lsh8x64  <t> x y)
+	// cond: config.RegSize == 8
+	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] y)))
 	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		mem := v.Args[1]
-		if !(is32Bit(off1 + off2)) {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
 			break
 		}
-		v.reset(OpRISCVMOVWload)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(mem)
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg8, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
 	return false
 }
-func rewriteValueRISCV_OpRISCVMOVWstore(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpLsh8x8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (MOVWstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
-	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
-	// result: (MOVWstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	// match: (Lsh8x8   <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [32] (ZeroExt8to32  y))))
 	for {
-		off1 := v.AuxInt
-		sym1 := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVMOVaddr {
-			break
-		}
-		off2 := v_0.AuxInt
-		sym2 := v_0.Aux
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVWstore)
-		v.AuxInt = off1 + off2
-		v.Aux = mergeSym(sym1, sym2)
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (MOVWstore [off1] {sym} (ADDI [off2] base) val mem)
-	// cond: is32Bit(off1+off2)
-	// result: (MOVWstore [off1+off2] {sym} base val mem)
-	for {
-		off1 := v.AuxInt
-		sym := v.Aux
-		v_0 := v.Args[0]
-		if v_0.Op != OpRISCVADDI {
-			break
-		}
-		off2 := v_0.AuxInt
-		base := v_0.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32Bit(off1 + off2)) {
-			break
-		}
-		v.reset(OpRISCVMOVWstore)
-		v.AuxInt = off1 + off2
-		v.Aux = sym
-		v.AddArg(base)
-		v.AddArg(val)
-		v.AddArg(mem)
-		return true
-	}
-	return false
-}
-func rewriteValueRISCV_OpRsh16Ux16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh16Ux16 <t> x y)
-	// cond:
-	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
-	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
 		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
 		v0.AddArg(y)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg16, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
+		v1 := b.NewValue0(v.Pos, OpNeg8, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
 		v2.AddArg(v3)
-		v.AddArg(v2)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh16Ux32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh16Ux32 <t> x y)
+	// match: (Lsh8x8   <t> x y)
 	// cond:
-	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// result: (AND (SLL <t> x y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
 	for {
 		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
 		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
 		v0.AddArg(y)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg16, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
+		v1 := b.NewValue0(v.Pos, OpNeg8, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
 		v2.AddArg(v3)
-		v.AddArg(v2)
+		v1.AddArg(v2)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh16Ux64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh16Ux64 <t> x y)
-	// cond:
-	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] y)))
+	// match: (Mod16 x y)
+	// cond: config.RegSize == 4
+	// result: (REM  (SignExt16to32 x) (SignExt16to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVREM)
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg16, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh16Ux8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh16Ux8  <t> x y)
+	// match: (Mod16 x y)
 	// cond:
-	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// result: (REMW  (SignExt16to32 x) (SignExt16to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
+		v.reset(OpRISCVREMW)
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg16, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh16x16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod16u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh16x16 <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	// match: (Mod16u x y)
+	// cond: config.RegSize == 4
+	// result: (REMU (ZeroExt16to32 x) (ZeroExt16to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVREMU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
 		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh16x32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh16x32 <t> x y)
+	// match: (Mod16u x y)
 	// cond:
-	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	// result: (REMUW (ZeroExt16to32 x) (ZeroExt16to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		v.reset(OpRISCVREMUW)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
 		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
 		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh16x64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh16x64 <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	// match: (Mod32 x y)
+	// cond: config.RegSize == 4
+	// result: (REM  x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVREM)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh16x8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh16x8  <t> x y)
+	// match: (Mod32 x y)
 	// cond:
-	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	// result: (REMW  x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
-		v0.AddArg(x)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		v.reset(OpRISCVREMW)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh32Ux16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod32u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh32Ux16 <t> x y)
-	// cond:
-	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	// match: (Mod32u x y)
+	// cond: config.RegSize == 4
+	// result: (REMU x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg32, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVREMU)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh32Ux32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh32Ux32 <t> x y)
+	// match: (Mod32u x y)
 	// cond:
-	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// result: (REMUW x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg32, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v.reset(OpRISCVREMUW)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh32Ux64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh32Ux64 <t> x y)
+	// match: (Mod64 x y)
 	// cond:
-	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] y)))
+	// result: (REM   x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg32, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v.reset(OpRISCVREM)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh32Ux8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod64u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh32Ux8  <t> x y)
+	// match: (Mod64u x y)
 	// cond:
-	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// result: (REMU  x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg32, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v.reset(OpRISCVREMU)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh32x16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh32x16 <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	// match: (Mod8 x y)
+	// cond: config.RegSize == 4
+	// result: (REM  (SignExt8to32 x)  (SignExt8to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVREM)
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
 		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh32x32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh32x32 <t> x y)
+	// match: (Mod8 x y)
 	// cond:
-	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	// result: (REMW  (SignExt8to32 x)  (SignExt8to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v.reset(OpRISCVREMW)
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
 		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
 		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh32x64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMod8u(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh32x64 <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	// match: (Mod8u x y)
+	// cond: config.RegSize == 4
+	// result: (REMU (ZeroExt8to32 x)  (ZeroExt8to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVREMU)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
 		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh32x8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh32x8  <t> x y)
+	// match: (Mod8u x y)
 	// cond:
-	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	// result: (REMUW (ZeroExt8to32 x)  (ZeroExt8to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v.reset(OpRISCVREMUW)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
 		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
 		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
 		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpRsh64Ux16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMove(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Rsh64Ux16 <t> x y)
-	// cond:
-	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 4 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [4] dst (MOVWload [4] src mem) 		(MOVWstore dst (MOVWload src mem) mem))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 4 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 4
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v0.AuxInt = 4
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v2.AddArg(src)
+		v2.AddArg(mem)
 		v1.AddArg(v2)
+		v1.AddArg(mem)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh64Ux32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh64Ux32 <t> x y)
-	// cond:
-	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 4 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [12] dst (MOVWload [12] src mem) 		(MOVWstore [8] dst (MOVWload [8] src mem) 			(MOVWstore [4] dst (MOVWload [4] src mem) 				(MOVWstore dst (MOVWload src mem) mem))))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 4 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 12
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v0.AuxInt = 12
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AuxInt = 8
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v2.AuxInt = 8
+		v2.AddArg(src)
+		v2.AddArg(mem)
 		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v3.AuxInt = 4
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v4.AuxInt = 4
+		v4.AddArg(src)
+		v4.AddArg(mem)
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v5.AddArg(dst)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v6.AddArg(src)
+		v6.AddArg(mem)
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh64Ux64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh64Ux64 <t> x y)
-	// cond:
-	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] y)))
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 4 && SizeAndAlign(s).Size()%4 == 0 && SizeAndAlign(s).Size() > 16 && SizeAndAlign(s).Size() <= 4*128 	&& SizeAndAlign(s).Align()%4 == 0 && !config.noDuffDevice
+	// result: (DUFFCOPY [8 * (128 - SizeAndAlign(s).Size()/4)] dst src mem)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v2.AddArg(y)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 4 && SizeAndAlign(s).Size()%4 == 0 && SizeAndAlign(s).Size() > 16 && SizeAndAlign(s).Size() <= 4*128 && SizeAndAlign(s).Align()%4 == 0 && !config.noDuffDevice) {
+			break
+		}
+		v.reset(OpRISCVDUFFCOPY)
+		v.AuxInt = 8 * (128 - SizeAndAlign(s).Size()/4)
+		v.AddArg(dst)
+		v.AddArg(src)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh64Ux8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh64Ux8  <t> x y)
-	// cond:
-	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// match: (Move [s]   _   _ mem)
+	// cond: SizeAndAlign(s).Size() == 0
+	// result: mem
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v0.AddArg(x)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpNeg64, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v.AddArg(v1)
+		s := v.AuxInt
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 0) {
+			break
+		}
+		v.reset(OpCopy)
+		v.Type = mem.Type
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh64x16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh64x16 <t> x y)
-	// cond:
-	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 1
+	// result: (MOVBstore dst (MOVBload src mem) mem)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v.AddArg(x)
-		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v0.AddArg(y)
-		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v1.AuxInt = -1
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v0.AddArg(v1)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 1) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh64x32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh64x32 <t> x y)
-	// cond:
-	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 2 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore dst (MOVHload src mem) mem)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v.AddArg(x)
-		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v0.AddArg(y)
-		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v1.AuxInt = -1
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v0.AddArg(v1)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 2 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh64x64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh64x64 <t> x y)
-	// cond:
-	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 2
+	// result: (MOVBstore [1] dst (MOVBload [1] src mem) 		(MOVBstore dst (MOVBload src mem) mem))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v.AddArg(x)
-		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v0.AddArg(y)
-		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v1.AuxInt = -1
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v2.AuxInt = 64
-		v2.AddArg(y)
-		v1.AddArg(v2)
-		v0.AddArg(v1)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 2) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = 1
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v0.AuxInt = 1
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v2.AddArg(src)
+		v2.AddArg(mem)
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh64x8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh64x8  <t> x y)
-	// cond:
-	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore dst (MOVWload src mem) mem)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v.AddArg(x)
-		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v0.AddArg(y)
-		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v1.AuxInt = -1
-		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v2.AuxInt = 64
-		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v0.AddArg(v1)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh8Ux16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8Ux16  <t> x y)
-	// cond:
-	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore [2] dst (MOVHload [2] src mem) 		(MOVHstore dst (MOVHload src mem) mem))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = 2
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v0.AuxInt = 2
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg8, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v2.AddArg(src)
+		v2.AddArg(mem)
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh8Ux32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8Ux32  <t> x y)
-	// cond:
-	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 4
+	// result: (MOVBstore [3] dst (MOVBload [3] src mem) 		(MOVBstore [2] dst (MOVBload [2] src mem) 			(MOVBstore [1] dst (MOVBload [1] src mem) 				(MOVBstore dst (MOVBload src mem) mem))))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 4) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = 3
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v0.AuxInt = 3
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg8, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v1.AuxInt = 2
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v2.AuxInt = 2
+		v2.AddArg(src)
+		v2.AddArg(mem)
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v3.AuxInt = 1
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v4.AuxInt = 1
+		v4.AddArg(src)
+		v4.AddArg(mem)
 		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v.AddArg(v2)
-		return true
-	}
-}
-func rewriteValueRISCV_OpRsh8Ux64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8Ux64  <t> x y)
-	// cond:
-	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] y)))
-	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
-		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg8, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v3.AddArg(y)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v5.AddArg(dst)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v6.AddArg(src)
+		v6.AddArg(mem)
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
+		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh8Ux8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8Ux8   <t> x y)
-	// cond:
-	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore dst (MOVDload src mem) mem)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVAND)
-		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
-		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v1.AddArg(x)
-		v0.AddArg(v1)
-		v0.AddArg(y)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v2 := b.NewValue0(v.Pos, OpNeg8, t)
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
-		v.AddArg(v2)
+		v.AddArg(mem)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh8x16(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8x16  <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [4] dst (MOVWload [4] src mem) 		(MOVWstore dst (MOVWload src mem) mem))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
-		v0.AddArg(x)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 4
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v0.AuxInt = 4
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v2.AddArg(src)
+		v2.AddArg(mem)
 		v1.AddArg(v2)
+		v1.AddArg(mem)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh8x32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8x32  <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore [6] dst (MOVHload [6] src mem) 		(MOVHstore [4] dst (MOVHload [4] src mem) 			(MOVHstore [2] dst (MOVHload [2] src mem) 				(MOVHstore dst (MOVHload src mem) mem))))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
-		v0.AddArg(x)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = 6
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v0.AuxInt = 6
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v1.AuxInt = 4
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v2.AuxInt = 4
+		v2.AddArg(src)
+		v2.AddArg(mem)
 		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v3.AuxInt = 2
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v4.AuxInt = 2
+		v4.AddArg(src)
+		v4.AddArg(mem)
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v5.AddArg(dst)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v6.AddArg(src)
+		v6.AddArg(mem)
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh8x64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8x64  <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 3
+	// result: (MOVBstore [2] dst (MOVBload [2] src mem) 		(MOVBstore [1] dst (MOVBload [1] src mem) 			(MOVBstore dst (MOVBload src mem) mem)))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
-		v0.AddArg(x)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 3) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = 2
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v0.AuxInt = 2
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v3.AddArg(y)
-		v2.AddArg(v3)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v1.AuxInt = 1
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v2.AuxInt = 1
+		v2.AddArg(src)
+		v2.AddArg(mem)
 		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVBload, config.fe.TypeInt8())
+		v4.AddArg(src)
+		v4.AddArg(mem)
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpRsh8x8(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Rsh8x8   <t> x y)
-	// cond:
-	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 6 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore [4] dst (MOVHload [4] src mem) 		(MOVHstore [2] dst (MOVHload [2] src mem) 			(MOVHstore dst (MOVHload src mem) mem)))
 	for {
-		t := v.Type
-		x := v.Args[0]
-		y := v.Args[1]
-		v.reset(OpRISCVSRA)
-		v.Type = t
-		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
-		v0.AddArg(x)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 6 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = 4
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v0.AuxInt = 4
+		v0.AddArg(src)
+		v0.AddArg(mem)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
-		v1.AddArg(y)
-		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
-		v2.AuxInt = -1
-		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
-		v3.AuxInt = 64
-		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
-		v4.AddArg(y)
-		v3.AddArg(v4)
-		v2.AddArg(v3)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v1.AuxInt = 2
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v2.AuxInt = 2
+		v2.AddArg(src)
+		v2.AddArg(mem)
 		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVHload, config.fe.TypeInt16())
+		v4.AddArg(src)
+		v4.AddArg(mem)
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
 		v.AddArg(v1)
 		return true
 	}
-}
-func rewriteValueRISCV_OpSignExt16to32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (SignExt16to32 <t> x)
+	// match: (Move [s] dst src mem)
+	// cond: SizeAndAlign(s).Size() == 12 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [8] dst (MOVWload [8] src mem) 		(MOVWstore [4] dst (MOVWload [4] src mem) 			(MOVWstore dst (MOVWload src mem) mem)))
+	for {
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(SizeAndAlign(s).Size() == 12 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 8
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v0.AuxInt = 8
+		v0.AddArg(src)
+		v0.AddArg(mem)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AuxInt = 4
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v2.AuxInt = 4
+		v2.AddArg(src)
+		v2.AddArg(mem)
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVWload, config.fe.TypeInt32())
+		v4.AddArg(src)
+		v4.AddArg(mem)
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore [8] dst (MOVDload [8] src mem) 		(MOVDstore dst (MOVDload src mem) mem))
+	for {
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = 8
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v0.AuxInt = 8
+		v0.AddArg(src)
+		v0.AddArg(mem)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v2.AddArg(src)
+		v2.AddArg(mem)
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 24 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore [16] dst (MOVDload [16] src mem) 		(MOVDstore [8] dst (MOVDload [8] src mem) 			(MOVDstore dst (MOVDload src mem) mem)))
+	for {
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 24 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = 16
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v0.AuxInt = 16
+		v0.AddArg(src)
+		v0.AddArg(mem)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v1.AuxInt = 8
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v2.AuxInt = 8
+		v2.AddArg(src)
+		v2.AddArg(mem)
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v4.AddArg(src)
+		v4.AddArg(mem)
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 32 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore [24] dst (MOVDload [24] src mem) 		(MOVDstore [16] dst (MOVDload [16] src mem) 			(MOVDstore [8] dst (MOVDload [8] src mem) 				(MOVDstore dst (MOVDload src mem) mem))))
+	for {
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 32 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = 24
+		v.AddArg(dst)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v0.AuxInt = 24
+		v0.AddArg(src)
+		v0.AddArg(mem)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v1.AuxInt = 16
+		v1.AddArg(dst)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v2.AuxInt = 16
+		v2.AddArg(src)
+		v2.AddArg(mem)
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v3.AuxInt = 8
+		v3.AddArg(dst)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v4.AuxInt = 8
+		v4.AddArg(src)
+		v4.AddArg(mem)
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v5.AddArg(dst)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVDload, config.fe.TypeInt64())
+		v6.AddArg(src)
+		v6.AddArg(mem)
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Move [s] dst src mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size()%8 == 0 && SizeAndAlign(s).Size() > 32 && SizeAndAlign(s).Size() <= 8*128 	&& SizeAndAlign(s).Align()%8 == 0 && !config.noDuffDevice
+	// result: (DUFFCOPY [8 * (128 - SizeAndAlign(s).Size()/8)] dst src mem)
+	for {
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size()%8 == 0 && SizeAndAlign(s).Size() > 32 && SizeAndAlign(s).Size() <= 8*128 && SizeAndAlign(s).Align()%8 == 0 && !config.noDuffDevice) {
+			break
+		}
+		v.reset(OpRISCVDUFFCOPY)
+		v.AuxInt = 8 * (128 - SizeAndAlign(s).Size()/8)
+		v.AddArg(dst)
+		v.AddArg(src)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Move [s] dst src mem)
 	// cond:
-	// result: (SRAI [48] (SLLI <t> [48] x))
+	// result: (LoweredMove [SizeAndAlign(s).Align()] 		dst 		src 		(ADDI <src.Type> [SizeAndAlign(s).Size()-moveSize(SizeAndAlign(s).Align(), config)] src) 		mem)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 48
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 48
-		v0.AddArg(x)
+		s := v.AuxInt
+		dst := v.Args[0]
+		src := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVLoweredMove)
+		v.AuxInt = SizeAndAlign(s).Align()
+		v.AddArg(dst)
+		v.AddArg(src)
+		v0 := b.NewValue0(v.Pos, OpRISCVADDI, src.Type)
+		v0.AuxInt = SizeAndAlign(s).Size() - moveSize(SizeAndAlign(s).Align(), config)
+		v0.AddArg(src)
 		v.AddArg(v0)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSignExt16to64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMul16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (SignExt16to64 <t> x)
+	// match: (Mul16 x y)
+	// cond: config.RegSize == 4
+	// result: (MUL x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMUL)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (Mul16 x y)
 	// cond:
-	// result: (SRAI [48] (SLLI <t> [48] x))
+	// result: (MULW (SignExt16to32 x) (SignExt16to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 48
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 48
+		y := v.Args[1]
+		v.reset(OpRISCVMULW)
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
 		v0.AddArg(x)
 		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v1.AddArg(y)
+		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSignExt32to64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMul32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (SignExt32to64 <t> x)
+	// match: (Mul32 x y)
+	// cond: config.RegSize == 4
+	// result: (MUL x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMUL)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (Mul32 x y)
 	// cond:
-	// result: (SRAI [32] (SLLI <t> [32] x))
+	// result: (MULW x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 32
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 32
-		v0.AddArg(x)
-		v.AddArg(v0)
+		y := v.Args[1]
+		v.reset(OpRISCVMULW)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSignExt8to16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMul32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (SignExt8to16  <t> x)
+	// match: (Mul32F x y)
 	// cond:
-	// result: (SRAI [56] (SLLI <t> [56] x))
+	// result: (FMULS x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 56
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 56
-		v0.AddArg(x)
-		v.AddArg(v0)
+		y := v.Args[1]
+		v.reset(OpRISCVFMULS)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSignExt8to32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMul64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (SignExt8to32  <t> x)
+	// match: (Mul64 x y)
 	// cond:
-	// result: (SRAI [56] (SLLI <t> [56] x))
+	// result: (MUL  x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 56
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 56
-		v0.AddArg(x)
-		v.AddArg(v0)
+		y := v.Args[1]
+		v.reset(OpRISCVMUL)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSignExt8to64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMul64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (SignExt8to64  <t> x)
+	// match: (Mul64F x y)
 	// cond:
-	// result: (SRAI [56] (SLLI <t> [56] x))
+	// result: (FMULD x y)
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRAI)
-		v.AuxInt = 56
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 56
-		v0.AddArg(x)
-		v.AddArg(v0)
+		y := v.Args[1]
+		v.reset(OpRISCVFMULD)
+		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSlicemask(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpMul8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Slicemask <t> x)
+	// match: (Mul8  x y)
+	// cond: config.RegSize == 4
+	// result: (MUL x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMUL)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (Mul8 x y)
 	// cond:
-	// result: (XOR (MOVDconst [-1]) (SRA <t> (SUB <t> x (MOVDconst [1])) (MOVDconst [63])))
+	// result: (MULW (SignExt8to32 x)  (SignExt8to32 y))
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVXOR)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v0.AuxInt = -1
+		y := v.Args[1]
+		v.reset(OpRISCVMULW)
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v0.AddArg(x)
 		v.AddArg(v0)
-		v1 := b.NewValue0(v.Pos, OpRISCVSRA, t)
-		v2 := b.NewValue0(v.Pos, OpRISCVSUB, t)
-		v2.AddArg(x)
-		v3 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v3.AuxInt = 1
-		v2.AddArg(v3)
-		v1.AddArg(v2)
-		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v4.AuxInt = 63
-		v1.AddArg(v4)
+		v1 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v1.AddArg(y)
 		v.AddArg(v1)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSqrt(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeg16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Sqrt x)
+	// match: (Neg16 x)
 	// cond:
-	// result: (FSQRTD x)
+	// result: (NEG x)
 	for {
 		x := v.Args[0]
-		v.reset(OpRISCVFSQRTD)
+		v.reset(OpRISCVNEG)
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpStaticCall(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeg32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (StaticCall  [argwid] {target}      mem)
+	// match: (Neg32 x)
 	// cond:
-	// result: (CALLstatic  [argwid] {target}      mem)
+	// result: (NEG x)
 	for {
-		argwid := v.AuxInt
-		target := v.Aux
-		mem := v.Args[0]
-		v.reset(OpRISCVCALLstatic)
-		v.AuxInt = argwid
-		v.Aux = target
-		v.AddArg(mem)
+		x := v.Args[0]
+		v.reset(OpRISCVNEG)
+		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpStore(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeg32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Store [1] ptr val mem)
+	// match: (Neg32F x)
 	// cond:
-	// result: (MOVBstore ptr val mem)
+	// result: (FNEGS x)
 	for {
-		if v.AuxInt != 1 {
-			break
-		}
-		ptr := v.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		v.reset(OpRISCVMOVBstore)
-		v.AddArg(ptr)
-		v.AddArg(val)
-		v.AddArg(mem)
+		x := v.Args[0]
+		v.reset(OpRISCVFNEGS)
+		v.AddArg(x)
 		return true
 	}
-	// match: (Store [2] ptr val mem)
+}
+func rewriteValueRISCV_OpNeg64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Neg64 x)
 	// cond:
-	// result: (MOVHstore ptr val mem)
+	// result: (NEG x)
 	for {
-		if v.AuxInt != 2 {
-			break
-		}
-		ptr := v.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		v.reset(OpRISCVMOVHstore)
-		v.AddArg(ptr)
-		v.AddArg(val)
-		v.AddArg(mem)
+		x := v.Args[0]
+		v.reset(OpRISCVNEG)
+		v.AddArg(x)
 		return true
 	}
-	// match: (Store [4] ptr val mem)
-	// cond: !is32BitFloat(val.Type)
-	// result: (MOVWstore ptr val mem)
+}
+func rewriteValueRISCV_OpNeg64F(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Neg64F x)
+	// cond:
+	// result: (FNEGD x)
 	for {
-		if v.AuxInt != 4 {
-			break
-		}
-		ptr := v.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(!is32BitFloat(val.Type)) {
-			break
-		}
-		v.reset(OpRISCVMOVWstore)
-		v.AddArg(ptr)
-		v.AddArg(val)
-		v.AddArg(mem)
+		x := v.Args[0]
+		v.reset(OpRISCVFNEGD)
+		v.AddArg(x)
 		return true
 	}
-	// match: (Store [8] ptr val mem)
-	// cond: !is64BitFloat(val.Type)
-	// result: (MOVDstore ptr val mem)
+}
+func rewriteValueRISCV_OpNeg8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Neg8  x)
+	// cond:
+	// result: (NEG x)
 	for {
-		if v.AuxInt != 8 {
-			break
-		}
-		ptr := v.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(!is64BitFloat(val.Type)) {
-			break
-		}
-		v.reset(OpRISCVMOVDstore)
-		v.AddArg(ptr)
-		v.AddArg(val)
-		v.AddArg(mem)
+		x := v.Args[0]
+		v.reset(OpRISCVNEG)
+		v.AddArg(x)
 		return true
 	}
-	// match: (Store [4] ptr val mem)
-	// cond: is32BitFloat(val.Type)
-	// result: (FMOVWstore ptr val mem)
+}
+func rewriteValueRISCV_OpNeq16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Neq16 x y)
+	// cond: config.RegSize == 4
+	// result: (SNEZ (ZeroExt16to32 (SUB <x.Type> x y)))
 	for {
-		if v.AuxInt != 4 {
-			break
-		}
-		ptr := v.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is32BitFloat(val.Type)) {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVFMOVWstore)
-		v.AddArg(ptr)
-		v.AddArg(val)
-		v.AddArg(mem)
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
 		return true
 	}
-	// match: (Store [8] ptr val mem)
-	// cond: is64BitFloat(val.Type)
-	// result: (FMOVDstore ptr val mem)
+	// match: (Neq16  x y)
+	// cond:
+	// result: (SNEZ (ZeroExt16to64 (SUB <x.Type> x y)))
 	for {
-		if v.AuxInt != 8 {
-			break
-		}
-		ptr := v.Args[0]
-		val := v.Args[1]
-		mem := v.Args[2]
-		if !(is64BitFloat(val.Type)) {
-			break
-		}
-		v.reset(OpRISCVFMOVDstore)
-		v.AddArg(ptr)
-		v.AddArg(val)
-		v.AddArg(mem)
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
 		return true
 	}
-	return false
 }
-func rewriteValueRISCV_OpSub16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeq32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Sub16 x y)
-	// cond:
-	// result: (SUB x y)
+	// match: (Neq32 x y)
+	// cond: config.RegSize == 4
+	// result: (SNEZ (SUB <x.Type> x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSUB)
-		v.AddArg(x)
-		v.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
-}
-func rewriteValueRISCV_OpSub32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (Sub32 x y)
+	// match: (Neq32  x y)
 	// cond:
-	// result: (SUB x y)
+	// result: (SNEZ (ZeroExt32to64 (SUB <x.Type> x y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSUB)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSub32F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeq32F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Sub32F x y)
+	// match: (Neq32F x y)
 	// cond:
-	// result: (FSUBS x y)
+	// result: (FNES x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFSUBS)
+		v.reset(OpRISCVFNES)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSub64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeq64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Sub64 x y)
+	// match: (Neq64  x y)
 	// cond:
-	// result: (SUB x y)
+	// result: (SNEZ (SUB <x.Type> x y))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSUB)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSub64F(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeq64F(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Sub64F x y)
+	// match: (Neq64F x y)
 	// cond:
-	// result: (FSUBD x y)
+	// result: (FNED x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVFSUBD)
+		v.reset(OpRISCVFNED)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpSub8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeq8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Sub8 x y)
-	// cond:
-	// result: (SUB x y)
+	// match: (Neq8  x y)
+	// cond: config.RegSize == 4
+	// result: (SNEZ (ZeroExt8to32  (SUB <x.Type> x y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSUB)
-		v.AddArg(x)
-		v.AddArg(y)
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
 		return true
 	}
-}
-func rewriteValueRISCV_OpSubPtr(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (SubPtr x y)
+	// match: (Neq8   x y)
 	// cond:
-	// result: (SUB x y)
+	// result: (SNEZ (ZeroExt8to64  (SUB <x.Type> x y)))
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVSUB)
-		v.AddArg(x)
-		v.AddArg(y)
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v1 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v1.AddArg(x)
+		v1.AddArg(y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpTrunc16to8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeqB(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Trunc16to8  x)
+	// match: (NeqB x y)
 	// cond:
-	// result: x
+	// result: (Neq8 x y)
 	for {
 		x := v.Args[0]
-		v.reset(OpCopy)
-		v.Type = x.Type
+		y := v.Args[1]
+		v.reset(OpNeq8)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpTrunc32to16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNeqPtr(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Trunc32to16 x)
+	// match: (NeqPtr x y)
 	// cond:
-	// result: x
+	// result: (SNEZ (SUB <x.Type> x y))
 	for {
 		x := v.Args[0]
-		v.reset(OpCopy)
-		v.Type = x.Type
-		v.AddArg(x)
+		y := v.Args[1]
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, x.Type)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpTrunc32to8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNilCheck(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Trunc32to8  x)
+	// match: (NilCheck ptr mem)
 	// cond:
-	// result: x
+	// result: (LoweredNilCheck ptr mem)
 	for {
-		x := v.Args[0]
-		v.reset(OpCopy)
-		v.Type = x.Type
-		v.AddArg(x)
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		v.reset(OpRISCVLoweredNilCheck)
+		v.AddArg(ptr)
+		v.AddArg(mem)
 		return true
 	}
 }
-func rewriteValueRISCV_OpTrunc64to16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpNot(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Trunc64to16 x)
+	// match: (Not  x)
 	// cond:
-	// result: x
+	// result: (XORI [1] x)
 	for {
 		x := v.Args[0]
-		v.reset(OpCopy)
-		v.Type = x.Type
+		v.reset(OpRISCVXORI)
+		v.AuxInt = 1
 		v.AddArg(x)
 		return true
 	}
 }
-func rewriteValueRISCV_OpTrunc64to32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpOffPtr(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Trunc64to32 x)
+	// match: (OffPtr [off] ptr:(SP))
 	// cond:
-	// result: x
+	// result: (MOVaddr [off] ptr)
 	for {
-		x := v.Args[0]
-		v.reset(OpCopy)
-		v.Type = x.Type
-		v.AddArg(x)
+		off := v.AuxInt
+		ptr := v.Args[0]
+		if ptr.Op != OpSP {
+			break
+		}
+		v.reset(OpRISCVMOVaddr)
+		v.AuxInt = off
+		v.AddArg(ptr)
+		return true
+	}
+	// match: (OffPtr [off] ptr)
+	// cond:
+	// result: (ADDI [off] ptr)
+	for {
+		off := v.AuxInt
+		ptr := v.Args[0]
+		v.reset(OpRISCVADDI)
+		v.AuxInt = off
+		v.AddArg(ptr)
 		return true
 	}
 }
-func rewriteValueRISCV_OpTrunc64to8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpOr16(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Trunc64to8  x)
+	// match: (Or16 x y)
 	// cond:
-	// result: x
+	// result: (OR x y)
 	for {
 		x := v.Args[0]
-		v.reset(OpCopy)
-		v.Type = x.Type
+		y := v.Args[1]
+		v.reset(OpRISCVOR)
 		v.AddArg(x)
+		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpXor16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpOr32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Xor16 x y)
+	// match: (Or32 x y)
 	// cond:
-	// result: (XOR x y)
+	// result: (OR x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVXOR)
+		v.reset(OpRISCVOR)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpXor32(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpOr64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Xor32 x y)
+	// match: (Or64 x y)
 	// cond:
-	// result: (XOR x y)
+	// result: (OR x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVXOR)
+		v.reset(OpRISCVOR)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpXor64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpOr8(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Xor64 x y)
+	// match: (Or8  x y)
 	// cond:
-	// result: (XOR x y)
+	// result: (OR x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVXOR)
+		v.reset(OpRISCVOR)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpXor8(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpOrB(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Xor8  x y)
+	// match: (OrB  x y)
 	// cond:
-	// result: (XOR x y)
+	// result: (OR   x y)
 	for {
 		x := v.Args[0]
 		y := v.Args[1]
-		v.reset(OpRISCVXOR)
+		v.reset(OpRISCVOR)
 		v.AddArg(x)
 		v.AddArg(y)
 		return true
 	}
 }
-func rewriteValueRISCV_OpZero(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpPopCount32(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (Zero [s]   _ mem)
-	// cond: SizeAndAlign(s).Size() == 0
-	// result: mem
-	for {
-		s := v.AuxInt
-		mem := v.Args[1]
-		if !(SizeAndAlign(s).Size() == 0) {
-			break
-		}
-		v.reset(OpCopy)
-		v.Type = mem.Type
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Zero [s] ptr mem)
-	// cond: SizeAndAlign(s).Size() == 1
-	// result: (MOVBstore ptr (MOVBconst) mem)
-	for {
-		s := v.AuxInt
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(SizeAndAlign(s).Size() == 1) {
-			break
-		}
-		v.reset(OpRISCVMOVBstore)
-		v.AddArg(ptr)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVBconst, config.fe.TypeUInt8())
-		v.AddArg(v0)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Zero [s] ptr mem)
-	// cond: SizeAndAlign(s).Size() == 2
-	// result: (MOVHstore ptr (MOVHconst) mem)
+	// match: (PopCount32 x)
+	// cond: config.RegSize == 4 && config.riscvB
+	// result: (CPOP x)
 	for {
-		s := v.AuxInt
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(SizeAndAlign(s).Size() == 2) {
+		x := v.Args[0]
+		if !(config.RegSize == 4 && config.riscvB) {
 			break
 		}
-		v.reset(OpRISCVMOVHstore)
-		v.AddArg(ptr)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVHconst, config.fe.TypeUInt16())
-		v.AddArg(v0)
-		v.AddArg(mem)
+		v.reset(OpRISCVCPOP)
+		v.AddArg(x)
 		return true
 	}
-	// match: (Zero [s] ptr mem)
-	// cond: SizeAndAlign(s).Size() == 4
-	// result: (MOVWstore ptr (MOVWconst) mem)
+	// match: (PopCount32 <t> x)
+	// cond: config.RegSize == 4
+	// result: (SRLI [24] (MUL <t> (MOVDconst [0x01010101]) 		(AND <t> (MOVDconst [0x0f0f0f0f]) 			(ADD <t> 				(ADD <t> 					(AND <t> (MOVDconst [0x33333333]) (SUB <t> x (AND <t> (MOVDconst [0x55555555]) (SRLI <t> [1] x)))) 					(AND <t> (MOVDconst [0x33333333]) (SRLI <t> [2] (SUB <t> x (AND <t> (MOVDconst [0x55555555]) (SRLI <t> [1] x)))))) 				(SRLI <t> [4] 					(ADD <t> 						(AND <t> (MOVDconst [0x33333333]) (SUB <t> x (AND <t> (MOVDconst [0x55555555]) (SRLI <t> [1] x)))) 						(AND <t> (MOVDconst [0x33333333]) (SRLI <t> [2] (SUB <t> x (AND <t> (MOVDconst [0x55555555]) (SRLI <t> [1] x)))))))))))
 	for {
-		s := v.AuxInt
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(SizeAndAlign(s).Size() == 4) {
+		t := v.Type
+		x := v.Args[0]
+		if !(config.RegSize == 4) {
 			break
 		}
-		v.reset(OpRISCVMOVWstore)
-		v.AddArg(ptr)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVWconst, config.fe.TypeUInt32())
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = 24
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AuxInt = 0x01010101
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AuxInt = 0x0f0f0f0f
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v5 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v6 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v7 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v7.AuxInt = 0x33333333
+		v6.AddArg(v7)
+		v8 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v8.AddArg(x)
+		v9 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v10 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v10.AuxInt = 0x55555555
+		v9.AddArg(v10)
+		v11 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v11.AuxInt = 1
+		v11.AddArg(x)
+		v9.AddArg(v11)
+		v8.AddArg(v9)
+		v6.AddArg(v8)
+		v5.AddArg(v6)
+		v12 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v13 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v13.AuxInt = 0x33333333
+		v12.AddArg(v13)
+		v14 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v14.AuxInt = 2
+		v15 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v15.AddArg(x)
+		v16 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v17 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v17.AuxInt = 0x55555555
+		v16.AddArg(v17)
+		v18 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v18.AuxInt = 1
+		v18.AddArg(x)
+		v16.AddArg(v18)
+		v15.AddArg(v16)
+		v14.AddArg(v15)
+		v12.AddArg(v14)
+		v5.AddArg(v12)
+		v4.AddArg(v5)
+		v19 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v19.AuxInt = 4
+		v20 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v21 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v22 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v22.AuxInt = 0x33333333
+		v21.AddArg(v22)
+		v23 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v23.AddArg(x)
+		v24 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v25 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v25.AuxInt = 0x55555555
+		v24.AddArg(v25)
+		v26 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v26.AuxInt = 1
+		v26.AddArg(x)
+		v24.AddArg(v26)
+		v23.AddArg(v24)
+		v21.AddArg(v23)
+		v20.AddArg(v21)
+		v27 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v28 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v28.AuxInt = 0x33333333
+		v27.AddArg(v28)
+		v29 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v29.AuxInt = 2
+		v30 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v30.AddArg(x)
+		v31 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v32 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v32.AuxInt = 0x55555555
+		v31.AddArg(v32)
+		v33 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v33.AuxInt = 1
+		v33.AddArg(x)
+		v31.AddArg(v33)
+		v30.AddArg(v31)
+		v29.AddArg(v30)
+		v27.AddArg(v29)
+		v20.AddArg(v27)
+		v19.AddArg(v20)
+		v4.AddArg(v19)
+		v2.AddArg(v4)
+		v0.AddArg(v2)
 		v.AddArg(v0)
-		v.AddArg(mem)
 		return true
 	}
-	// match: (Zero [s] ptr mem)
-	// cond: SizeAndAlign(s).Size() == 8
-	// result: (MOVDstore ptr (MOVDconst) mem)
+	// match: (PopCount32 x)
+	// cond: config.riscvB
+	// result: (CPOPW x)
 	for {
-		s := v.AuxInt
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		if !(SizeAndAlign(s).Size() == 8) {
+		x := v.Args[0]
+		if !(config.riscvB) {
 			break
 		}
-		v.reset(OpRISCVMOVDstore)
-		v.AddArg(ptr)
-		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v.AddArg(v0)
-		v.AddArg(mem)
-		return true
-	}
-	// match: (Zero [s] ptr mem)
-	// cond:
-	// result: (LoweredZero [SizeAndAlign(s).Align()] 		ptr 		(ADD <ptr.Type> ptr (MOVDconst [SizeAndAlign(s).Size()-moveSize(SizeAndAlign(s).Align(), config)])) 		mem)
-	for {
-		s := v.AuxInt
-		ptr := v.Args[0]
-		mem := v.Args[1]
-		v.reset(OpRISCVLoweredZero)
-		v.AuxInt = SizeAndAlign(s).Align()
-		v.AddArg(ptr)
-		v0 := b.NewValue0(v.Pos, OpRISCVADD, ptr.Type)
-		v0.AddArg(ptr)
-		v1 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
-		v1.AuxInt = SizeAndAlign(s).Size() - moveSize(SizeAndAlign(s).Align(), config)
-		v0.AddArg(v1)
-		v.AddArg(v0)
-		v.AddArg(mem)
+		v.reset(OpRISCVCPOPW)
+		v.AddArg(x)
 		return true
 	}
-}
-func rewriteValueRISCV_OpZeroExt16to32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (ZeroExt16to32 <t> x)
+	// match: (PopCount32 <t> x)
 	// cond:
-	// result: (SRLI [48] (SLLI <t> [48] x))
+	// result: (PopCount64 <t> (ZeroExt32to64 <t> x))
 	for {
 		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 48
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 48
+		v.reset(OpPopCount64)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpZeroExt32to64, t)
 		v0.AddArg(x)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpZeroExt16to64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpPopCount64(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (ZeroExt16to64 <t> x)
-	// cond:
-	// result: (SRLI [48] (SLLI <t> [48] x))
+	// match: (PopCount64 x)
+	// cond: config.riscvB
+	// result: (CPOP x)
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 48
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 48
-		v0.AddArg(x)
-		v.AddArg(v0)
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVCPOP)
+		v.AddArg(x)
 		return true
 	}
-}
-func rewriteValueRISCV_OpZeroExt32to64(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (ZeroExt32to64 <t> x)
+	// match: (PopCount64 <t> x)
 	// cond:
-	// result: (SRLI [32] (SLLI <t> [32] x))
+	// result: (SRLI [56] (MUL <t> (MOVDconst [0x0101010101010101]) 		(AND <t> (MOVDconst [0x0f0f0f0f0f0f0f0f]) 			(ADD <t> 				(ADD <t> 					(AND <t> (MOVDconst [0x3333333333333333]) (SUB <t> x (AND <t> (MOVDconst [0x5555555555555555]) (SRLI <t> [1] x)))) 					(AND <t> (MOVDconst [0x3333333333333333]) (SRLI <t> [2] (SUB <t> x (AND <t> (MOVDconst [0x5555555555555555]) (SRLI <t> [1] x)))))) 				(SRLI <t> [4] 					(ADD <t> 						(AND <t> (MOVDconst [0x3333333333333333]) (SUB <t> x (AND <t> (MOVDconst [0x5555555555555555]) (SRLI <t> [1] x)))) 						(AND <t> (MOVDconst [0x3333333333333333]) (SRLI <t> [2] (SUB <t> x (AND <t> (MOVDconst [0x5555555555555555]) (SRLI <t> [1] x)))))))))))
 	for {
 		t := v.Type
 		x := v.Args[0]
 		v.reset(OpRISCVSRLI)
-		v.AuxInt = 32
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 32
-		v0.AddArg(x)
+		v.AuxInt = 56
+		v0 := b.NewValue0(v.Pos, OpRISCVMUL, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AuxInt = 0x0101010101010101
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AuxInt = 0x0f0f0f0f0f0f0f0f
+		v2.AddArg(v3)
+		v4 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v5 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v6 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v7 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v7.AuxInt = 0x3333333333333333
+		v6.AddArg(v7)
+		v8 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v8.AddArg(x)
+		v9 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v10 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v10.AuxInt = 0x5555555555555555
+		v9.AddArg(v10)
+		v11 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v11.AuxInt = 1
+		v11.AddArg(x)
+		v9.AddArg(v11)
+		v8.AddArg(v9)
+		v6.AddArg(v8)
+		v5.AddArg(v6)
+		v12 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v13 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v13.AuxInt = 0x3333333333333333
+		v12.AddArg(v13)
+		v14 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v14.AuxInt = 2
+		v15 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v15.AddArg(x)
+		v16 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v17 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v17.AuxInt = 0x5555555555555555
+		v16.AddArg(v17)
+		v18 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v18.AuxInt = 1
+		v18.AddArg(x)
+		v16.AddArg(v18)
+		v15.AddArg(v16)
+		v14.AddArg(v15)
+		v12.AddArg(v14)
+		v5.AddArg(v12)
+		v4.AddArg(v5)
+		v19 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v19.AuxInt = 4
+		v20 := b.NewValue0(v.Pos, OpRISCVADD, t)
+		v21 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v22 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v22.AuxInt = 0x3333333333333333
+		v21.AddArg(v22)
+		v23 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v23.AddArg(x)
+		v24 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v25 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v25.AuxInt = 0x5555555555555555
+		v24.AddArg(v25)
+		v26 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v26.AuxInt = 1
+		v26.AddArg(x)
+		v24.AddArg(v26)
+		v23.AddArg(v24)
+		v21.AddArg(v23)
+		v20.AddArg(v21)
+		v27 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v28 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v28.AuxInt = 0x3333333333333333
+		v27.AddArg(v28)
+		v29 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v29.AuxInt = 2
+		v30 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v30.AddArg(x)
+		v31 := b.NewValue0(v.Pos, OpRISCVAND, t)
+		v32 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v32.AuxInt = 0x5555555555555555
+		v31.AddArg(v32)
+		v33 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v33.AuxInt = 1
+		v33.AddArg(x)
+		v31.AddArg(v33)
+		v30.AddArg(v31)
+		v29.AddArg(v30)
+		v27.AddArg(v29)
+		v20.AddArg(v27)
+		v19.AddArg(v20)
+		v4.AddArg(v19)
+		v2.AddArg(v4)
+		v0.AddArg(v2)
 		v.AddArg(v0)
 		return true
 	}
 }
-func rewriteValueRISCV_OpZeroExt8to16(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpRISCVADD(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (ZeroExt8to16  <t> x)
-	// cond:
-	// result: (SRLI [56] (SLLI <t> [56] x))
+	// match: (ADD (MOVDconst [c]) x)
+	// cond: is12Bit(c)
+	// result: (ADDI [c] x)
 	for {
-		t := v.Type
-		x := v.Args[0]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 56
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 56
-		v0.AddArg(x)
-		v.AddArg(v0)
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVADDI)
+		v.AuxInt = c
+		v.AddArg(x)
 		return true
 	}
-}
-func rewriteValueRISCV_OpZeroExt8to32(v *Value, config *Config) bool {
-	b := v.Block
-	_ = b
-	// match: (ZeroExt8to32  <t> x)
-	// cond:
-	// result: (SRLI [56] (SLLI <t> [56] x))
+	// match: (ADD x (MOVDconst [c]))
+	// cond: is12Bit(c)
+	// result: (ADDI [c] x)
 	for {
-		t := v.Type
 		x := v.Args[0]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 56
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 56
-		v0.AddArg(x)
-		v.AddArg(v0)
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVADDI)
+		v.AuxInt = c
+		v.AddArg(x)
 		return true
 	}
+	return false
 }
-func rewriteValueRISCV_OpZeroExt8to64(v *Value, config *Config) bool {
+func rewriteValueRISCV_OpRISCVADDI(v *Value, config *Config) bool {
 	b := v.Block
 	_ = b
-	// match: (ZeroExt8to64  <t> x)
+	// match: (ADDI [c] (MOVaddr [d] {s} x))
+	// cond: is32Bit(c+d)
+	// result: (MOVaddr [c+d] {s} x)
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		d := v_0.AuxInt
+		s := v_0.Aux
+		x := v_0.Args[0]
+		if !(is32Bit(c + d)) {
+			break
+		}
+		v.reset(OpRISCVMOVaddr)
+		v.AuxInt = c + d
+		v.Aux = s
+		v.AddArg(x)
+		return true
+	}
+	// match: (ADDI  [0]  x)
 	// cond:
-	// result: (SRLI [56] (SLLI <t> [56] x))
+	// result: x
 	for {
-		t := v.Type
+		if v.AuxInt != 0 {
+			break
+		}
 		x := v.Args[0]
-		v.reset(OpRISCVSRLI)
-		v.AuxInt = 56
-		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
-		v0.AuxInt = 56
-		v0.AddArg(x)
-		v.AddArg(v0)
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (ADDI  [c] (MOVDconst [d]))
+	// cond: (config.RegSize == 8 || is32Bit(c+d))
+	// result: (MOVDconst [c+d])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		if !(config.RegSize == 8 || is32Bit(c+d)) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = c + d
+		return true
+	}
+	// match: (ADDI  [c] (ADDI [d] x))
+	// cond: is12Bit(c+d)
+	// result: (ADDI [c+d] x)
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		d := v_0.AuxInt
+		x := v_0.Args[0]
+		if !(is12Bit(c + d)) {
+			break
+		}
+		v.reset(OpRISCVADDI)
+		v.AuxInt = c + d
+		v.AddArg(x)
 		return true
 	}
+	return false
 }
-func rewriteBlockRISCV(b *Block, config *Config) bool {
-	switch b.Kind {
-	case BlockIf:
-		// match: (If cond yes no)
-		// cond:
-		// result: (BNE cond yes no)
-		for {
-			v := b.Control
-			_ = v
-			cond := b.Control
-			yes := b.Succs[0]
-			no := b.Succs[1]
-			b.Kind = BlockRISCVBNE
+func rewriteValueRISCV_OpRISCVADDIW(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ADDIW [c] (MOVDconst [d]))
+	// cond:
+	// result: (MOVDconst [int64(int32(c+d))])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(int32(c + d))
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVAND(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (AND (MOVDconst [c]) x)
+	// cond: is12Bit(c)
+	// result: (ANDI [c] x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVANDI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (AND x (MOVDconst [c]))
+	// cond: is12Bit(c)
+	// result: (ANDI [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVANDI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (AND x (XORI [-1] y))
+	// cond: config.riscvB
+	// result: (ANDN x y)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVXORI {
+			break
+		}
+		if v_1.AuxInt != -1 {
+			break
+		}
+		y := v_1.Args[0]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVANDN)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (AND (XORI [-1] y) x)
+	// cond: config.riscvB
+	// result: (ANDN x y)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVXORI {
+			break
+		}
+		if v_0.AuxInt != -1 {
+			break
+		}
+		y := v_0.Args[0]
+		x := v.Args[1]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVANDN)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVANDI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ANDI  [-1] x)
+	// cond:
+	// result: x
+	for {
+		if v.AuxInt != -1 {
+			break
+		}
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (ANDI  [0]  _)
+	// cond:
+	// result: (MOVDconst [0])
+	for {
+		if v.AuxInt != 0 {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
+		return true
+	}
+	// match: (ANDI  [c] (MOVDconst [d]))
+	// cond:
+	// result: (MOVDconst [c&d])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = c & d
+		return true
+	}
+	// match: (ANDI  [c] (ANDI [d] x))
+	// cond:
+	// result: (ANDI [c&d] x)
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVANDI {
+			break
+		}
+		d := v_0.AuxInt
+		x := v_0.Args[0]
+		v.reset(OpRISCVANDI)
+		v.AuxInt = c & d
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVFMADDD(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (FMADDD (FNEGD x) y z)
+	// cond:
+	// result: (FNMSUBD x y z)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVFNEGD {
+			break
+		}
+		x := v_0.Args[0]
+		y := v.Args[1]
+		z := v.Args[2]
+		v.reset(OpRISCVFNMSUBD)
+		v.AddArg(x)
+		v.AddArg(y)
+		v.AddArg(z)
+		return true
+	}
+	// match: (FMADDD x (FNEGD y) z)
+	// cond:
+	// result: (FNMSUBD x y z)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVFNEGD {
+			break
+		}
+		y := v_1.Args[0]
+		z := v.Args[2]
+		v.reset(OpRISCVFNMSUBD)
+		v.AddArg(x)
+		v.AddArg(y)
+		v.AddArg(z)
+		return true
+	}
+	// match: (FMADDD x y (FNEGD z))
+	// cond:
+	// result: (FMSUBD x y z)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v_2 := v.Args[2]
+		if v_2.Op != OpRISCVFNEGD {
+			break
+		}
+		z := v_2.Args[0]
+		v.reset(OpRISCVFMSUBD)
+		v.AddArg(x)
+		v.AddArg(y)
+		v.AddArg(z)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVFNMSUBD(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (FNMSUBD x y (FNEGD z))
+	// cond:
+	// result: (FNMADDD x y z)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v_2 := v.Args[2]
+		if v_2.Op != OpRISCVFNEGD {
+			break
+		}
+		z := v_2.Args[0]
+		v.reset(OpRISCVFNMADDD)
+		v.AddArg(x)
+		v.AddArg(y)
+		v.AddArg(z)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVBUload(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVBUload [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVBUload [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBUload)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBUload [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVBUload [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBUload)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVBUreg(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVBUreg (MOVDconst [c]))
+	// cond:
+	// result: (MOVDconst [int64(uint8(c))])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(uint8(c))
+		return true
+	}
+	// match: (MOVBUreg x:(MOVBUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(SLT   _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLT {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(SLTU  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLTU {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(SLTI  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLTI {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(SLTIU _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLTIU {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(SEQZ  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSEQZ {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(SNEZ  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSNEZ {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(ANDI [c] _))
+	// cond: c >= 0 && c <= 0xff
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVANDI {
+			break
+		}
+		c := x.AuxInt
+		if !(c >= 0 && c <= 0xff) {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBUreg x:(MOVBUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVBload(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVBload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVBload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBload)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBload  [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVBload  [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBload)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVBreg(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVBreg  (MOVDconst [c]))
+	// cond:
+	// result: (MOVDconst [int64(int8(c))])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(int8(c))
+		return true
+	}
+	// match: (MOVBreg  x:(MOVBload  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBreg  x:(SLT   _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLT {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBreg  x:(SLTU  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLTU {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBreg  x:(SLTI  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLTI {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBreg  x:(SLTIU _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSLTIU {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBreg  x:(SEQZ  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSEQZ {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBreg  x:(SNEZ  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVSNEZ {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVBreg  x:(MOVBreg  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVBstore(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVBstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVBstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off1] {sym} (ADDI [off2] base) val mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVBstore [off1+off2] {sym} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off] {sym} ptr (MOVDconst [0]) mem)
+	// cond:
+	// result: (MOVBstorezero [off] {sym} ptr mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		if v_1.AuxInt != 0 {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstorezero)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off] {sym} ptr (MOVBreg  x) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVBreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off] {sym} ptr (MOVHreg  x) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVHreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off] {sym} ptr (MOVWreg  x) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVWreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off] {sym} ptr (MOVBUreg x) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVBUreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off] {sym} ptr (MOVHUreg x) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVHUreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstore [off] {sym} ptr (MOVWUreg x) mem)
+	// cond:
+	// result: (MOVBstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVWUreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVBstorezero(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVBstorezero [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVBstorezero [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVBstorezero [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVBstorezero [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVBstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVDload(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVDload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVDload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVDload)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVDload  [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVDload  [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVDload)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVDstore(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVDstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVDstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVDstore [off1] {sym} (ADDI [off2] base) val mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVDstore [off1+off2] {sym} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVDstore [off] {sym} ptr (MOVDconst [0]) mem)
+	// cond:
+	// result: (MOVDstorezero [off] {sym} ptr mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		if v_1.AuxInt != 0 {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVDstorezero)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVDstorezero(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVDstorezero [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVDstorezero [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVDstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVDstorezero [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVDstorezero [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVDstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVHUload(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVHUload [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVHUload [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHUload)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHUload [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVHUload [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHUload)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVHUreg(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVHUreg (MOVDconst [c]))
+	// cond:
+	// result: (MOVDconst [int64(uint16(c))])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(uint16(c))
+		return true
+	}
+	// match: (MOVHUreg x:(MOVBUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHUreg x:(MOVHUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHUreg x:(ANDI [c] _))
+	// cond: c >= 0 && c <= 0xffff
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVANDI {
+			break
+		}
+		c := x.AuxInt
+		if !(c >= 0 && c <= 0xffff) {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHUreg x:(MOVBUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHUreg x:(MOVHUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVHload(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVHload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVHload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHload)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHload  [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVHload  [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHload)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVHreg(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVHreg  (MOVDconst [c]))
+	// cond:
+	// result: (MOVDconst [int64(int16(c))])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(int16(c))
+		return true
+	}
+	// match: (MOVHreg  x:(MOVBload  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHreg  x:(MOVBUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHreg  x:(MOVHload  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHreg  x:(ANDI [c] _))
+	// cond: c >= 0 && c <= 0x7fff
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVANDI {
+			break
+		}
+		c := x.AuxInt
+		if !(c >= 0 && c <= 0x7fff) {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHreg  x:(MOVBreg  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHreg  x:(MOVBUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVHreg  x:(MOVHreg  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVHstore(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVHstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVHstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHstore [off1] {sym} (ADDI [off2] base) val mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVHstore [off1+off2] {sym} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHstore [off] {sym} ptr (MOVDconst [0]) mem)
+	// cond:
+	// result: (MOVHstorezero [off] {sym} ptr mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		if v_1.AuxInt != 0 {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVHstorezero)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHstore [off] {sym} ptr (MOVHreg  x) mem)
+	// cond:
+	// result: (MOVHstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVHreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHstore [off] {sym} ptr (MOVWreg  x) mem)
+	// cond:
+	// result: (MOVHstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVWreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHstore [off] {sym} ptr (MOVHUreg x) mem)
+	// cond:
+	// result: (MOVHstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVHUreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHstore [off] {sym} ptr (MOVWUreg x) mem)
+	// cond:
+	// result: (MOVHstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVWUreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVHstorezero(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVHstorezero [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVHstorezero [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVHstorezero [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVHstorezero [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVHstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVWUload(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVWUload [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVWUload [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWUload)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWUload [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVWUload [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWUload)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVWUreg(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVWUreg (MOVDconst [c]))
+	// cond: config.RegSize == 8
+	// result: (MOVDconst [int64(uint32(c))])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(uint32(c))
+		return true
+	}
+	// match: (MOVWUreg x:(MOVBUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWUreg x:(MOVHUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWUreg x:(MOVWUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVWUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWUreg x:(ANDI [c] _))
+	// cond: c >= 0 && c <= 0xffffffff
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVANDI {
+			break
+		}
+		c := x.AuxInt
+		if !(c >= 0 && c <= 0xffffffff) {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWUreg x:(MOVBUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWUreg x:(MOVHUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWUreg x:(MOVWUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVWUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVWload(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVWload  [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVWload  [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWload)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWload  [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVWload  [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWload)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVWreg(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVWreg  (MOVDconst [c]))
+	// cond:
+	// result: (MOVDconst [int64(int32(c))])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(int32(c))
+		return true
+	}
+	// match: (MOVWreg  x:(MOVBload  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVBUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVHload  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVHUload _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHUload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVWload  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVWload {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg x:(ADDIW _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVADDIW {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg x:(MULW  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMULW {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg x:(DIVW  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVDIVW {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg x:(DIVUW _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVDIVUW {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg x:(REMW  _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVREMW {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg x:(REMUW _ _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVREMUW {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(ANDI [c] _))
+	// cond: c >= 0 && c <= 0x7fffffff
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVANDI {
+			break
+		}
+		c := x.AuxInt
+		if !(c >= 0 && c <= 0x7fffffff) {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVBreg  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVBUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVBUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVHreg  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVHUreg _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVHUreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	// match: (MOVWreg  x:(MOVWreg  _))
+	// cond:
+	// result: (MOVDreg x)
+	for {
+		x := v.Args[0]
+		if x.Op != OpRISCVMOVWreg {
+			break
+		}
+		v.reset(OpRISCVMOVDreg)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVWstore(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVWstore [off1] {sym1} (MOVaddr [off2] {sym2} base) val mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVWstore [off1+off2] {mergeSym(sym1,sym2)} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWstore [off1] {sym} (ADDI [off2] base) val mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVWstore [off1+off2] {sym} base val mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWstore [off] {sym} ptr (MOVDconst [0]) mem)
+	// cond:
+	// result: (MOVWstorezero [off] {sym} ptr mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		if v_1.AuxInt != 0 {
+			break
+		}
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVWstorezero)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWstore [off] {sym} ptr (MOVWreg  x) mem)
+	// cond:
+	// result: (MOVWstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVWreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWstore [off] {sym} ptr (MOVWUreg x) mem)
+	// cond:
+	// result: (MOVWstore [off] {sym} ptr x mem)
+	for {
+		off := v.AuxInt
+		sym := v.Aux
+		ptr := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVWUreg {
+			break
+		}
+		x := v_1.Args[0]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = off
+		v.Aux = sym
+		v.AddArg(ptr)
+		v.AddArg(x)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVMOVWstorezero(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (MOVWstorezero [off1] {sym1} (MOVaddr [off2] {sym2} base) mem)
+	// cond: is32Bit(off1+off2) && canMergeSym(sym1, sym2)
+	// result: (MOVWstorezero [off1+off2] {mergeSym(sym1,sym2)} base mem)
+	for {
+		off1 := v.AuxInt
+		sym1 := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVaddr {
+			break
+		}
+		off2 := v_0.AuxInt
+		sym2 := v_0.Aux
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1+off2) && canMergeSym(sym1, sym2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = mergeSym(sym1, sym2)
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (MOVWstorezero [off1] {sym} (ADDI [off2] base) mem)
+	// cond: is32Bit(off1+off2)
+	// result: (MOVWstorezero [off1+off2] {sym} base mem)
+	for {
+		off1 := v.AuxInt
+		sym := v.Aux
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVADDI {
+			break
+		}
+		off2 := v_0.AuxInt
+		base := v_0.Args[0]
+		mem := v.Args[1]
+		if !(is32Bit(off1 + off2)) {
+			break
+		}
+		v.reset(OpRISCVMOVWstorezero)
+		v.AuxInt = off1 + off2
+		v.Aux = sym
+		v.AddArg(base)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVNEG(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (NEG (MOVDconst [c]))
+	// cond: (config.RegSize == 8 || is32Bit(-c))
+	// result: (MOVDconst [-c])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		if !(config.RegSize == 8 || is32Bit(-c)) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = -c
+		return true
+	}
+	// match: (NEG (NEG x))
+	// cond:
+	// result: x
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVNEG {
+			break
+		}
+		x := v_0.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVOR(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (OR  (MOVDconst [c]) x)
+	// cond: is12Bit(c)
+	// result: (ORI  [c] x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVORI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (OR  x (MOVDconst [c]))
+	// cond: is12Bit(c)
+	// result: (ORI  [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVORI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (OR (SLLI [c] x) (SRLI [d] x))
+	// cond: config.riscvB && c+d == config.RegSize*8
+	// result: (RORI [d] x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSLLI {
+			break
+		}
+		c := v_0.AuxInt
+		x := v_0.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVSRLI {
+			break
+		}
+		d := v_1.AuxInt
+		if x != v_1.Args[0] {
+			break
+		}
+		if !(config.riscvB && c+d == config.RegSize*8) {
+			break
+		}
+		v.reset(OpRISCVRORI)
+		v.AuxInt = d
+		v.AddArg(x)
+		return true
+	}
+	// match: (OR (SRLI [d] x) (SLLI [c] x))
+	// cond: config.riscvB && c+d == config.RegSize*8
+	// result: (RORI [d] x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSRLI {
+			break
+		}
+		d := v_0.AuxInt
+		x := v_0.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVSLLI {
+			break
+		}
+		c := v_1.AuxInt
+		if x != v_1.Args[0] {
+			break
+		}
+		if !(config.riscvB && c+d == config.RegSize*8) {
+			break
+		}
+		v.reset(OpRISCVRORI)
+		v.AuxInt = d
+		v.AddArg(x)
+		return true
+	}
+	// match: (OR <t> (SLLI [c] x) (SRLI [d] (MOVWUreg x)))
+	// cond: config.riscvB && config.RegSize == 8 && t.Size() == 4 && c+d == 32
+	// result: (RORIW [d] x)
+	for {
+		t := v.Type
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSLLI {
+			break
+		}
+		c := v_0.AuxInt
+		x := v_0.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVSRLI {
+			break
+		}
+		d := v_1.AuxInt
+		v_1_0 := v_1.Args[0]
+		if v_1_0.Op != OpRISCVMOVWUreg {
+			break
+		}
+		if x != v_1_0.Args[0] {
+			break
+		}
+		if !(config.riscvB && config.RegSize == 8 && t.Size() == 4 && c+d == 32) {
+			break
+		}
+		v.reset(OpRISCVRORIW)
+		v.AuxInt = d
+		v.AddArg(x)
+		return true
+	}
+	// match: (OR <t> (SRLI [d] (MOVWUreg x)) (SLLI [c] x))
+	// cond: config.riscvB && config.RegSize == 8 && t.Size() == 4 && c+d == 32
+	// result: (RORIW [d] x)
+	for {
+		t := v.Type
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSRLI {
+			break
+		}
+		d := v_0.AuxInt
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpRISCVMOVWUreg {
+			break
+		}
+		x := v_0_0.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVSLLI {
+			break
+		}
+		c := v_1.AuxInt
+		if x != v_1.Args[0] {
+			break
+		}
+		if !(config.riscvB && config.RegSize == 8 && t.Size() == 4 && c+d == 32) {
+			break
+		}
+		v.reset(OpRISCVRORIW)
+		v.AuxInt = d
+		v.AddArg(x)
+		return true
+	}
+	// match: (OR  x (XORI [-1] y))
+	// cond: config.riscvB
+	// result: (ORN  x y)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVXORI {
+			break
+		}
+		if v_1.AuxInt != -1 {
+			break
+		}
+		y := v_1.Args[0]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVORN)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (OR  (XORI [-1] y) x)
+	// cond: config.riscvB
+	// result: (ORN  x y)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVXORI {
+			break
+		}
+		if v_0.AuxInt != -1 {
+			break
+		}
+		y := v_0.Args[0]
+		x := v.Args[1]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVORN)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVORI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ORI   [0]  x)
+	// cond:
+	// result: x
+	for {
+		if v.AuxInt != 0 {
+			break
+		}
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (ORI   [-1] _)
+	// cond:
+	// result: (MOVDconst [-1])
+	for {
+		if v.AuxInt != -1 {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = -1
+		return true
+	}
+	// match: (ORI   [c] (MOVDconst [d]))
+	// cond:
+	// result: (MOVDconst [c|d])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = c | d
+		return true
+	}
+	// match: (ORI   [c] (ORI [d] x))
+	// cond:
+	// result: (ORI [c|d] x)
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVORI {
+			break
+		}
+		d := v_0.AuxInt
+		x := v_0.Args[0]
+		v.reset(OpRISCVORI)
+		v.AuxInt = c | d
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVROL(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ROL x (MOVDconst [c]))
+	// cond:
+	// result: (RORI [(-c)&(config.RegSize*8-1)] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpRISCVRORI)
+		v.AuxInt = (-c) & (config.RegSize*8 - 1)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVROLW(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ROLW x (MOVDconst [c]))
+	// cond:
+	// result: (RORIW [(-c)&31] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpRISCVRORIW)
+		v.AuxInt = (-c) & 31
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVRORI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (RORI [0] x)
+	// cond:
+	// result: x
+	for {
+		if v.AuxInt != 0 {
+			break
+		}
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSEQZ(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SEQZ (SUB (MOVDconst [c]) x))
+	// cond: is12Bit(-c)
+	// result: (SEQZ (ADDI <x.Type> [-c] x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSUB {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0_0.AuxInt
+		x := v_0.Args[1]
+		if !(is12Bit(-c)) {
+			break
+		}
+		v.reset(OpRISCVSEQZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVADDI, x.Type)
+		v0.AuxInt = -c
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SEQZ (MOVDconst [c]))
+	// cond:
+	// result: (MOVDconst [b2i(c == 0)])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = b2i(c == 0)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSLL(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SLL x (MOVDconst [c]))
+	// cond:
+	// result: (SLLI [c&(config.RegSize*8-1)] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpRISCVSLLI)
+		v.AuxInt = c & (config.RegSize*8 - 1)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSLLI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SLLI  [0]  x)
+	// cond:
+	// result: x
+	for {
+		if v.AuxInt != 0 {
+			break
+		}
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (SLLI  [c] (MOVDconst [d]))
+	// cond: config.RegSize == 8
+	// result: (MOVDconst [d<<uint64(c)])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = d << uint64(c)
+		return true
+	}
+	// match: (SLLI  [c] (MOVDconst [d]))
+	// cond: config.RegSize == 4
+	// result: (MOVDconst [int64(int32(d)<<uint64(c))])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(int32(d) << uint64(c))
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSLT(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SLT  x (MOVDconst [c]))
+	// cond: is12Bit(c)
+	// result: (SLTI  [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVSLTI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSLTI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SLTI  [c] (MOVDconst [d]))
+	// cond:
+	// result: (MOVDconst [b2i(d < c)])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = b2i(d < c)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSLTIU(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SLTIU [c] (MOVDconst [d]))
+	// cond:
+	// result: (MOVDconst [b2i(uint64(d) < uint64(c))])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = b2i(uint64(d) < uint64(c))
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSLTU(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SLTU x (MOVDconst [c]))
+	// cond: is12Bit(c)
+	// result: (SLTIU [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVSLTIU)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (SLTU (MOVDconst [0]) x)
+	// cond:
+	// result: (SNEZ x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		if v_0.AuxInt != 0 {
+			break
+		}
+		x := v.Args[1]
+		v.reset(OpRISCVSNEZ)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSNEZ(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SNEZ (SUB (MOVDconst [c]) x))
+	// cond: is12Bit(-c)
+	// result: (SNEZ (ADDI <x.Type> [-c] x))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSUB {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0_0.AuxInt
+		x := v_0.Args[1]
+		if !(is12Bit(-c)) {
+			break
+		}
+		v.reset(OpRISCVSNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCVADDI, x.Type)
+		v0.AuxInt = -c
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (SNEZ (MOVDconst [c]))
+	// cond:
+	// result: (MOVDconst [b2i(c != 0)])
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = b2i(c != 0)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSRA(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SRA x (MOVDconst [c]))
+	// cond:
+	// result: (SRAI [c&(config.RegSize*8-1)] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = c & (config.RegSize*8 - 1)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSRAI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SRAI  [0]  x)
+	// cond:
+	// result: x
+	for {
+		if v.AuxInt != 0 {
+			break
+		}
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (SRAI  [c] (MOVDconst [d]))
+	// cond:
+	// result: (MOVDconst [d>>uint64(c)])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = d >> uint64(c)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSRL(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SRL x (MOVDconst [c]))
+	// cond:
+	// result: (SRLI [c&(config.RegSize*8-1)] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = c & (config.RegSize*8 - 1)
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSRLI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SRLI  [0]  x)
+	// cond:
+	// result: x
+	for {
+		if v.AuxInt != 0 {
+			break
+		}
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (SRLI  [c] (MOVDconst [d]))
+	// cond: config.RegSize == 8
+	// result: (MOVDconst [int64(uint64(d)>>uint64(c))])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(uint64(d) >> uint64(c))
+		return true
+	}
+	// match: (SRLI  [c] (MOVDconst [d]))
+	// cond: config.RegSize == 4
+	// result: (MOVDconst [int64(int32(uint32(d)>>uint64(c)))])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = int64(int32(uint32(d) >> uint64(c)))
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVSUB(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SUB x (MOVDconst [c]))
+	// cond: is12Bit(-c)
+	// result: (ADDI [-c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(is12Bit(-c)) {
+			break
+		}
+		v.reset(OpRISCVADDI)
+		v.AuxInt = -c
+		v.AddArg(x)
+		return true
+	}
+	// match: (SUB (MOVDconst [0]) x)
+	// cond:
+	// result: (NEG x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		if v_0.AuxInt != 0 {
+			break
+		}
+		x := v.Args[1]
+		v.reset(OpRISCVNEG)
+		v.AddArg(x)
+		return true
+	}
+	// match: (SUB (MOVDconst [c]) (SUB (MOVDconst [d]) x))
+	// cond: is32Bit(c-d) && is12Bit(c-d)
+	// result: (ADDI [c-d] x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVSUB {
+			break
+		}
+		v_1_0 := v_1.Args[0]
+		if v_1_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_1_0.AuxInt
+		x := v_1.Args[1]
+		if !(is32Bit(c-d) && is12Bit(c-d)) {
+			break
+		}
+		v.reset(OpRISCVADDI)
+		v.AuxInt = c - d
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVXOR(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (XOR (MOVDconst [c]) x)
+	// cond: is12Bit(c)
+	// result: (XORI [c] x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0.AuxInt
+		x := v.Args[1]
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVXORI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (XOR x (MOVDconst [c]))
+	// cond: is12Bit(c)
+	// result: (XORI [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(is12Bit(c)) {
+			break
+		}
+		v.reset(OpRISCVXORI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRISCVXORI(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (XORI [1] (SLT  (MOVDconst [c]) x))
+	// cond: is12Bit(c+1)
+	// result: (SLTI  [c+1] x)
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSLT {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0_0.AuxInt
+		x := v_0.Args[1]
+		if !(is12Bit(c + 1)) {
+			break
+		}
+		v.reset(OpRISCVSLTI)
+		v.AuxInt = c + 1
+		v.AddArg(x)
+		return true
+	}
+	// match: (XORI [1] (SLTU (MOVDconst [c]) x))
+	// cond: is12Bit(c+1) && c != -1
+	// result: (SLTIU [c+1] x)
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVSLTU {
+			break
+		}
+		v_0_0 := v_0.Args[0]
+		if v_0_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_0_0.AuxInt
+		x := v_0.Args[1]
+		if !(is12Bit(c+1) && c != -1) {
+			break
+		}
+		v.reset(OpRISCVSLTIU)
+		v.AuxInt = c + 1
+		v.AddArg(x)
+		return true
+	}
+	// match: (XORI  [0]  x)
+	// cond:
+	// result: x
+	for {
+		if v.AuxInt != 0 {
+			break
+		}
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+	// match: (XORI  [c] (MOVDconst [d]))
+	// cond:
+	// result: (MOVDconst [c^d])
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVMOVDconst {
+			break
+		}
+		d := v_0.AuxInt
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = c ^ d
+		return true
+	}
+	// match: (XORI  [c] (XORI [d] x))
+	// cond:
+	// result: (XORI [c^d] x)
+	for {
+		c := v.AuxInt
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVXORI {
+			break
+		}
+		d := v_0.AuxInt
+		x := v_0.Args[0]
+		v.reset(OpRISCVXORI)
+		v.AuxInt = c ^ d
+		v.AddArg(x)
+		return true
+	}
+	// match: (XORI [-1] (XOR x y))
+	// cond: config.riscvB
+	// result: (XNOR x y)
+	for {
+		if v.AuxInt != -1 {
+			break
+		}
+		v_0 := v.Args[0]
+		if v_0.Op != OpRISCVXOR {
+			break
+		}
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVXNOR)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRotateLeft32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (RotateLeft32 x y)
+	// cond: config.RegSize == 4 && config.riscvB
+	// result: (ROL x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4 && config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVROL)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (RotateLeft32 <t> x (MOVDconst [c]))
+	// cond: config.RegSize == 4
+	// result: (OR (SLLI <t> [c&31] x) (SRLI <t> [-c&31] x))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVOR)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v0.AuxInt = c & 31
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v1.AuxInt = -c & 31
+		v1.AddArg(x)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (RotateLeft32 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (OR (SLL <t> x y) (SRL <t> x (NEG <y.Type> y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVOR)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1.AddArg(x)
+		v2 := b.NewValue0(v.Pos, OpRISCVNEG, y.Type)
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (RotateLeft32 x y)
+	// cond: config.riscvB
+	// result: (ROLW x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVROLW)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (RotateLeft32 <t> x y)
+	// cond:
+	// result: (SRL (OR <t> (SLLI <t> [32] x) (ZeroExt32to64 <t> x)) (ANDI <y.Type> [31] (NEG <y.Type> y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRL)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, t)
+		v1 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v1.AuxInt = 32
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v2 := b.NewValue0(v.Pos, OpZeroExt32to64, t)
+		v2.AddArg(x)
+		v0.AddArg(v2)
+		v.AddArg(v0)
+		v3 := b.NewValue0(v.Pos, OpRISCVANDI, y.Type)
+		v3.AuxInt = 31
+		v4 := b.NewValue0(v.Pos, OpRISCVNEG, y.Type)
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v.AddArg(v3)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRotateLeft64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (RotateLeft64 x y)
+	// cond: config.riscvB
+	// result: (ROL x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.riscvB) {
+			break
+		}
+		v.reset(OpRISCVROL)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (RotateLeft64 <t> x (MOVDconst [c]))
+	// cond:
+	// result: (OR (SLLI <t> [c&63] x) (SRLI <t> [-c&63] x))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		v.reset(OpRISCVOR)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, t)
+		v0.AuxInt = c & 63
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVSRLI, t)
+		v1.AuxInt = -c & 63
+		v1.AddArg(x)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (RotateLeft64 <t> x y)
+	// cond:
+	// result: (OR (SLL <t> x y) (SRL <t> x (NEG <y.Type> y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVOR)
+		v0 := b.NewValue0(v.Pos, OpRISCVSLL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1.AddArg(x)
+		v2 := b.NewValue0(v.Pos, OpRISCVNEG, y.Type)
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh16Ux16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16Ux16 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> (ZeroExt16to32 x) y) (Neg16 <t> (SLTIU <t> [32] (ZeroExt16to32 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg16, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	// match: (Rsh16Ux16 <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg16, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh16Ux32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16Ux32 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> (ZeroExt16to32 x) y) (Neg16 <t> (SLTIU <t> [32] y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg16, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 32
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	// match: (Rsh16Ux32 <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg16, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh16Ux64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16Ux64 x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 16
+	// result: (SRLI [c+16] (SLLI <config.fe.TypeUInt32()> [16] x))
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 16) {
+			break
+		}
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = c + 16
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt32())
+		v0.AuxInt = 16
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh16Ux64 _ (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 16
+	// result: (MOVDconst [0])
+	for {
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 16) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
+		return true
+	}
+	// match: (Rsh16Ux64 <t> x y)
+	// cond: config.RegSize == 8
+	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg16, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRsh16Ux8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16Ux8  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> (ZeroExt16to32 x) y) (Neg16 <t> (SLTIU <t> [32] (ZeroExt8to32  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg16, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	// match: (Rsh16Ux8  <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt16to64 x) y) (Neg16 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg16, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh16x16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16x16 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> (SignExt16to32 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] (ZeroExt16to32 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh16x16 <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh16x32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16x32 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> (SignExt16to32 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 32
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh16x32 <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh16x64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16x64  x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 16
+	// result: (SRAI [c+16] (SLLI <config.fe.TypeUInt32()> [16] x))
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 16) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = c + 16
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt32())
+		v0.AuxInt = 16
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh16x64 x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 16
+	// result: (SRAI [31] (SLLI <config.fe.TypeUInt32()> [16] x))
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 16) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 31
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt32())
+		v0.AuxInt = 16
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh16x64 <t> x y)
+	// cond: config.RegSize == 8
+	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRsh16x8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh16x8  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> (SignExt16to32 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] (ZeroExt8to32  y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt16to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh16x8  <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt16to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt16to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh32Ux16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32Ux16 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> x                 y) (Neg32 <t> (SLTIU <t> [32] (ZeroExt16to32 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh32Ux16 <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg32, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh32Ux32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32Ux32 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> x                 y) (Neg32 <t> (SLTIU <t> [32] y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh32Ux32 <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg32, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh32Ux64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32Ux64 x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 32
+	// result: (SRLI [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 32) {
+			break
+		}
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (Rsh32Ux64 _ (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 32
+	// result: (MOVDconst [0])
+	for {
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 32) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
+		return true
+	}
+	// match: (Rsh32Ux64 <t> x y)
+	// cond: config.RegSize == 8
+	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg32, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRsh32Ux8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32Ux8  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> x                 y) (Neg32 <t> (SLTIU <t> [32] (ZeroExt8to32  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg32, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh32Ux8  <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt32to64 x) y) (Neg32 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg32, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh32x16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32x16 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] (ZeroExt16to32 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh32x16 <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh32x32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32x32 <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v2.AuxInt = 32
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh32x32 <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh32x64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32x64  x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 32
+	// result: (SRAI [c] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 32) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = c
+		v.AddArg(x)
+		return true
+	}
+	// match: (Rsh32x64 x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 32
+	// result: (SRAI [31] x)
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 32) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 31
+		v.AddArg(x)
+		return true
+	}
+	// match: (Rsh32x64 <t> x y)
+	// cond: config.RegSize == 8
+	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRsh32x8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh32x8  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] (ZeroExt8to32  y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v2.AuxInt = 32
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh32x8  <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt32to64 x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt32to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64Ux16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64Ux16 <t> x y)
+	// cond:
+	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64Ux32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64Ux32 <t> x y)
+	// cond:
+	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64Ux64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64Ux64 <t> x y)
+	// cond:
+	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64Ux8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64Ux8  <t> x y)
+	// cond:
+	// result: (AND (SRL <t> x                 y) (Neg64 <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpNeg64, t)
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64x16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64x16 <t> x y)
+	// cond:
+	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64x32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64x32 <t> x y)
+	// cond:
+	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64x64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64x64 <t> x y)
+	// cond:
+	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v2.AuxInt = 64
+		v2.AddArg(y)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh64x8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh64x8  <t> x y)
+	// cond:
+	// result: (SRA <t> x                 (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v0.AddArg(y)
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v1.AuxInt = -1
+		v2 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v2.AuxInt = 64
+		v3 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh8Ux16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8Ux16  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> (ZeroExt8to32  x) y) (Neg8  <t> (SLTIU <t> [32] (ZeroExt16to32 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg8, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	// match: (Rsh8Ux16  <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt16to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg8, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh8Ux32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8Ux32  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> (ZeroExt8to32  x) y) (Neg8  <t> (SLTIU <t> [32] y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg8, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 32
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	// match: (Rsh8Ux32  <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt32to64 y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg8, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh8Ux64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8Ux64  x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 8
+	// result: (SRLI [c+24] (SLLI <config.fe.TypeUInt32()> [24] x))
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 8) {
+			break
+		}
+		v.reset(OpRISCVSRLI)
+		v.AuxInt = c + 24
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt32())
+		v0.AuxInt = 24
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh8Ux64  _ (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 8
+	// result: (MOVDconst [0])
+	for {
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 8) {
+			break
+		}
+		v.reset(OpRISCVMOVDconst)
+		v.AuxInt = 0
+		return true
+	}
+	// match: (Rsh8Ux64  <t> x y)
+	// cond: config.RegSize == 8
+	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] y)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg8, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRsh8Ux8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8Ux8   <t> x y)
+	// cond: config.RegSize == 4
+	// result: (AND (SRL <t> (ZeroExt8to32  x) y) (Neg8  <t> (SLTIU <t> [32] (ZeroExt8to32  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg8, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+	// match: (Rsh8Ux8   <t> x y)
+	// cond:
+	// result: (AND (SRL <t> (ZeroExt8to64  x) y) (Neg8  <t> (SLTIU <t> [64] (ZeroExt8to64  y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVAND)
+		v0 := b.NewValue0(v.Pos, OpRISCVSRL, t)
+		v1 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v2 := b.NewValue0(v.Pos, OpNeg8, t)
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, t)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v.AddArg(v2)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh8x16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8x16  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> (SignExt8to32  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] (ZeroExt16to32 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh8x16  <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt16to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt16to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh8x32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8x32  <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> (SignExt8to32  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 32
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh8x32  <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt32to64 y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt32to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpRsh8x64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8x64   x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) < 8
+	// result: (SRAI [c+24] (SLLI <config.fe.TypeUInt32()> [24] x))
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) < 8) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = c + 24
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt32())
+		v0.AuxInt = 24
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh8x64  x (MOVDconst [c]))
+	// cond: config.RegSize == 4 && uint32(c) >= 8
+	// result: (SRAI [31] (SLLI <config.fe.TypeUInt32()> [24] x))
+	for {
+		x := v.Args[0]
+		v_1 := v.Args[1]
+		if v_1.Op != OpRISCVMOVDconst {
+			break
+		}
+		c := v_1.AuxInt
+		if !(config.RegSize == 4 && uint32(c) >= 8) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 31
+		v0 := b.NewValue0(v.Pos, OpRISCVSLLI, config.fe.TypeUInt32())
+		v0.AuxInt = 24
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Rsh8x64  <t> x y)
+	// cond: config.RegSize == 8
+	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] y))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 8) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v3.AddArg(y)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpRsh8x8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Rsh8x8   <t> x y)
+	// cond: config.RegSize == 4
+	// result: (SRA <t> (SignExt8to32  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [32] (ZeroExt8to32  y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt8to32, config.fe.TypeInt32())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 32
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to32, config.fe.TypeUInt32())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Rsh8x8   <t> x y)
+	// cond:
+	// result: (SRA <t> (SignExt8to64  x) (OR <y.Type> y (ADDI <y.Type> [-1] (SLTIU <y.Type> [64] (ZeroExt8to64  y)))))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSRA)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpSignExt8to64, config.fe.TypeInt64())
+		v0.AddArg(x)
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVOR, y.Type)
+		v1.AddArg(y)
+		v2 := b.NewValue0(v.Pos, OpRISCVADDI, y.Type)
+		v2.AuxInt = -1
+		v3 := b.NewValue0(v.Pos, OpRISCVSLTIU, y.Type)
+		v3.AuxInt = 64
+		v4 := b.NewValue0(v.Pos, OpZeroExt8to64, config.fe.TypeUInt64())
+		v4.AddArg(y)
+		v3.AddArg(v4)
+		v2.AddArg(v3)
+		v1.AddArg(v2)
+		v.AddArg(v1)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSelect0(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Select0 (Add32carry <t> x y))
+	// cond:
+	// result: (ADD <t.FieldType(0)> x y)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAdd32carry {
+			break
+		}
+		t := v_0.Type
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		v.reset(OpRISCVADD)
+		v.Type = t.FieldType(0)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (Select0 (Sub32carry <t> x y))
+	// cond:
+	// result: (SUB <t.FieldType(0)> x y)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpSub32carry {
+			break
+		}
+		t := v_0.Type
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		v.reset(OpRISCVSUB)
+		v.Type = t.FieldType(0)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	// match: (Select0 (Mul32uhilo x y))
+	// cond:
+	// result: (MULHU x y)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpMul32uhilo {
+			break
+		}
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		v.reset(OpRISCVMULHU)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpSelect1(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Select1 (Add32carry <t> x y))
+	// cond:
+	// result: (SLTU <config.fe.TypeBool()> (ADD <t.FieldType(0)> x y) x)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpAdd32carry {
+			break
+		}
+		t := v_0.Type
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		v.reset(OpRISCVSLTU)
+		v.Type = config.fe.TypeBool()
+		v0 := b.NewValue0(v.Pos, OpRISCVADD, t.FieldType(0))
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v.AddArg(x)
+		return true
+	}
+	// match: (Select1 (Sub32carry <t> x y))
+	// cond:
+	// result: (SLTU <config.fe.TypeBool()> x (SUB <t.FieldType(0)> x y))
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpSub32carry {
+			break
+		}
+		t := v_0.Type
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		v.reset(OpRISCVSLTU)
+		v.Type = config.fe.TypeBool()
+		v.AddArg(x)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, t.FieldType(0))
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Mul32uhilo x y))
+	// cond:
+	// result: (MUL   x y)
+	for {
+		v_0 := v.Args[0]
+		if v_0.Op != OpMul32uhilo {
+			break
+		}
+		x := v_0.Args[0]
+		y := v_0.Args[1]
+		v.reset(OpRISCVMUL)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpSignExt16to32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SignExt16to32 x)
+	// cond:
+	// result: (MOVHreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVHreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSignExt16to64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SignExt16to64 x)
+	// cond:
+	// result: (MOVHreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVHreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSignExt32to64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SignExt32to64 x)
+	// cond:
+	// result: (MOVWreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVWreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSignExt8to16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SignExt8to16  x)
+	// cond:
+	// result: (MOVBreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVBreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSignExt8to32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SignExt8to32  x)
+	// cond:
+	// result: (MOVBreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVBreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSignExt8to64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SignExt8to64  x)
+	// cond:
+	// result: (MOVBreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVBreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSignmask(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Signmask x)
+	// cond:
+	// result: (SRAI [31] x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 31
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSlicemask(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Slicemask <t> x)
+	// cond: config.RegSize == 4
+	// result: (SRAI [31] (Neg32 <t> x))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		if !(config.RegSize == 4) {
+			break
+		}
+		v.reset(OpRISCVSRAI)
+		v.AuxInt = 31
+		v0 := b.NewValue0(v.Pos, OpNeg32, t)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Slicemask <t> x)
+	// cond:
+	// result: (XORI [-1] (SRAI <t> [63] (ADDI <t> [-1] x)))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		v.reset(OpRISCVXORI)
+		v.AuxInt = -1
+		v0 := b.NewValue0(v.Pos, OpRISCVSRAI, t)
+		v0.AuxInt = 63
+		v1 := b.NewValue0(v.Pos, OpRISCVADDI, t)
+		v1.AuxInt = -1
+		v1.AddArg(x)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSqrt(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sqrt x)
+	// cond:
+	// result: (FSQRTD x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVFSQRTD)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpStaticCall(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (StaticCall  [argwid] {target}      mem)
+	// cond:
+	// result: (CALLstatic  [argwid] {target}      mem)
+	for {
+		argwid := v.AuxInt
+		target := v.Aux
+		mem := v.Args[0]
+		v.reset(OpRISCVCALLstatic)
+		v.AuxInt = argwid
+		v.Aux = target
+		v.AddArg(mem)
+		return true
+	}
+}
+func rewriteValueRISCV_OpStore(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Store [1] ptr val mem)
+	// cond:
+	// result: (MOVBstore ptr val mem)
+	for {
+		if v.AuxInt != 1 {
+			break
+		}
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVBstore)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Store [2] ptr val mem)
+	// cond:
+	// result: (MOVHstore ptr val mem)
+	for {
+		if v.AuxInt != 2 {
+			break
+		}
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		v.reset(OpRISCVMOVHstore)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Store [4] ptr val mem)
+	// cond: !is32BitFloat(val.Type)
+	// result: (MOVWstore ptr val mem)
+	for {
+		if v.AuxInt != 4 {
+			break
+		}
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(!is32BitFloat(val.Type)) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Store [8] ptr val mem)
+	// cond: !is64BitFloat(val.Type)
+	// result: (MOVDstore ptr val mem)
+	for {
+		if v.AuxInt != 8 {
+			break
+		}
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(!is64BitFloat(val.Type)) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Store [4] ptr val mem)
+	// cond: is32BitFloat(val.Type)
+	// result: (FMOVWstore ptr val mem)
+	for {
+		if v.AuxInt != 4 {
+			break
+		}
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is32BitFloat(val.Type)) {
+			break
+		}
+		v.reset(OpRISCVFMOVWstore)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Store [8] ptr val mem)
+	// cond: is64BitFloat(val.Type)
+	// result: (FMOVDstore ptr val mem)
+	for {
+		if v.AuxInt != 8 {
+			break
+		}
+		ptr := v.Args[0]
+		val := v.Args[1]
+		mem := v.Args[2]
+		if !(is64BitFloat(val.Type)) {
+			break
+		}
+		v.reset(OpRISCVFMOVDstore)
+		v.AddArg(ptr)
+		v.AddArg(val)
+		v.AddArg(mem)
+		return true
+	}
+	return false
+}
+func rewriteValueRISCV_OpSub16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sub16 x y)
+	// cond:
+	// result: (SUB x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSUB)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSub32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sub32 x y)
+	// cond:
+	// result: (SUB x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSUB)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSub32F(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sub32F x y)
+	// cond:
+	// result: (FSUBS x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVFSUBS)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSub32withcarry(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sub32withcarry <t> x y c)
+	// cond:
+	// result: (SUB (SUB <t> x y) c)
+	for {
+		t := v.Type
+		x := v.Args[0]
+		y := v.Args[1]
+		c := v.Args[2]
+		v.reset(OpRISCVSUB)
+		v0 := b.NewValue0(v.Pos, OpRISCVSUB, t)
+		v0.AddArg(x)
+		v0.AddArg(y)
+		v.AddArg(v0)
+		v.AddArg(c)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSub64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sub64 x y)
+	// cond:
+	// result: (SUB x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSUB)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSub64F(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sub64F x y)
+	// cond:
+	// result: (FSUBD x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVFSUBD)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSub8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Sub8 x y)
+	// cond:
+	// result: (SUB x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSUB)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpSubPtr(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (SubPtr x y)
+	// cond:
+	// result: (SUB x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVSUB)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpTrunc(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Trunc x)
+	// cond:
+	// result: (LoweredTruncD x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVLoweredTruncD)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpTrunc16to8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Trunc16to8  x)
+	// cond:
+	// result: x
+	for {
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpTrunc32to16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Trunc32to16 x)
+	// cond:
+	// result: x
+	for {
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpTrunc32to8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Trunc32to8  x)
+	// cond:
+	// result: x
+	for {
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpTrunc64to16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Trunc64to16 x)
+	// cond:
+	// result: x
+	for {
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpTrunc64to32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Trunc64to32 x)
+	// cond:
+	// result: x
+	for {
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpTrunc64to8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Trunc64to8  x)
+	// cond:
+	// result: x
+	for {
+		x := v.Args[0]
+		v.reset(OpCopy)
+		v.Type = x.Type
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpXor16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Xor16 x y)
+	// cond:
+	// result: (XOR x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVXOR)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpXor32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Xor32 x y)
+	// cond:
+	// result: (XOR x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVXOR)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpXor64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Xor64 x y)
+	// cond:
+	// result: (XOR x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVXOR)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpXor8(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Xor8  x y)
+	// cond:
+	// result: (XOR x y)
+	for {
+		x := v.Args[0]
+		y := v.Args[1]
+		v.reset(OpRISCVXOR)
+		v.AddArg(x)
+		v.AddArg(y)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZero(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 4 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [4] ptr (MOVDconst) 		(MOVWstore ptr (MOVDconst) mem))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 4 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 4
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 4 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [12] ptr (MOVDconst) 		(MOVWstore [8] ptr (MOVDconst) 			(MOVWstore [4] ptr (MOVDconst) 				(MOVWstore ptr (MOVDconst) mem))))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 4 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 12
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AuxInt = 8
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v3.AuxInt = 4
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v5.AddArg(ptr)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 4 && SizeAndAlign(s).Size()%4 == 0 && SizeAndAlign(s).Size() > 16 && SizeAndAlign(s).Size() <= 4*128 	&& SizeAndAlign(s).Align()%4 == 0 && !config.noDuffDevice
+	// result: (DUFFZERO [4 * (128 - SizeAndAlign(s).Size()/4)] ptr mem)
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 4 && SizeAndAlign(s).Size()%4 == 0 && SizeAndAlign(s).Size() > 16 && SizeAndAlign(s).Size() <= 4*128 && SizeAndAlign(s).Align()%4 == 0 && !config.noDuffDevice) {
+			break
+		}
+		v.reset(OpRISCVDUFFZERO)
+		v.AuxInt = 4 * (128 - SizeAndAlign(s).Size()/4)
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Zero [s]   _ mem)
+	// cond: SizeAndAlign(s).Size() == 0
+	// result: mem
+	for {
+		s := v.AuxInt
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 0) {
+			break
+		}
+		v.reset(OpCopy)
+		v.Type = mem.Type
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 1
+	// result: (MOVBstore ptr (MOVDconst) mem)
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 1) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 2 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore ptr (MOVDconst) mem)
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 2 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 2
+	// result: (MOVBstore [1] ptr (MOVDconst) 		(MOVBstore ptr (MOVDconst) mem))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 2) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = 1
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore ptr (MOVDconst) mem)
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore [2] ptr (MOVDconst) 		(MOVHstore ptr (MOVDconst) mem))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 4 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = 2
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 4
+	// result: (MOVBstore [3] ptr (MOVDconst) 		(MOVBstore [2] ptr (MOVDconst) 			(MOVBstore [1] ptr (MOVDconst) 				(MOVBstore ptr (MOVDconst) mem))))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 4) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = 3
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v1.AuxInt = 2
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v3.AuxInt = 1
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v5.AddArg(ptr)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore ptr (MOVDconst) mem)
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [4] ptr (MOVDconst) 		(MOVWstore ptr (MOVDconst) mem))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 4
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore [6] ptr (MOVDconst) 		(MOVHstore [4] ptr (MOVDconst) 			(MOVHstore [2] ptr (MOVDconst) 				(MOVHstore ptr (MOVDconst) mem))))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 8 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = 6
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v1.AuxInt = 4
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v3.AuxInt = 2
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v5.AddArg(ptr)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 3
+	// result: (MOVBstore [2] ptr (MOVDconst) 		(MOVBstore [1] ptr (MOVDconst) 			(MOVBstore ptr (MOVDconst) mem)))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 3) {
+			break
+		}
+		v.reset(OpRISCVMOVBstore)
+		v.AuxInt = 2
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v1.AuxInt = 1
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVBstore, TypeMem)
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 6 && SizeAndAlign(s).Align()%2 == 0
+	// result: (MOVHstore [4] ptr (MOVDconst) 		(MOVHstore [2] ptr (MOVDconst) 			(MOVHstore ptr (MOVDconst) mem)))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 6 && SizeAndAlign(s).Align()%2 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVHstore)
+		v.AuxInt = 4
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v1.AuxInt = 2
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVHstore, TypeMem)
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: SizeAndAlign(s).Size() == 12 && SizeAndAlign(s).Align()%4 == 0
+	// result: (MOVWstore [8] ptr (MOVDconst) 		(MOVWstore [4] ptr (MOVDconst) 			(MOVWstore ptr (MOVDconst) mem)))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(SizeAndAlign(s).Size() == 12 && SizeAndAlign(s).Align()%4 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVWstore)
+		v.AuxInt = 8
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v1.AuxInt = 4
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVWstore, TypeMem)
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore [8] ptr (MOVDconst) 		(MOVDstore ptr (MOVDconst) mem))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 16 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = 8
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v1.AddArg(mem)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 24 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore [16] ptr (MOVDconst) 		(MOVDstore [8] ptr (MOVDconst) 			(MOVDstore ptr (MOVDconst) mem)))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 24 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = 16
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v1.AuxInt = 8
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v3.AddArg(mem)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size() == 32 && SizeAndAlign(s).Align()%8 == 0
+	// result: (MOVDstore [24] ptr (MOVDconst) 		(MOVDstore [16] ptr (MOVDconst) 			(MOVDstore [8] ptr (MOVDconst) 				(MOVDstore ptr (MOVDconst) mem))))
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size() == 32 && SizeAndAlign(s).Align()%8 == 0) {
+			break
+		}
+		v.reset(OpRISCVMOVDstore)
+		v.AuxInt = 24
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v.AddArg(v0)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v1.AuxInt = 16
+		v1.AddArg(ptr)
+		v2 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AddArg(v2)
+		v3 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v3.AuxInt = 8
+		v3.AddArg(ptr)
+		v4 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v3.AddArg(v4)
+		v5 := b.NewValue0(v.Pos, OpRISCVMOVDstore, TypeMem)
+		v5.AddArg(ptr)
+		v6 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v5.AddArg(v6)
+		v5.AddArg(mem)
+		v3.AddArg(v5)
+		v1.AddArg(v3)
+		v.AddArg(v1)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond: config.RegSize == 8 && SizeAndAlign(s).Size()%8 == 0 && SizeAndAlign(s).Size() > 32 && SizeAndAlign(s).Size() <= 8*128 	&& SizeAndAlign(s).Align()%8 == 0 && !config.noDuffDevice
+	// result: (DUFFZERO [4 * (128 - SizeAndAlign(s).Size()/8)] ptr mem)
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		if !(config.RegSize == 8 && SizeAndAlign(s).Size()%8 == 0 && SizeAndAlign(s).Size() > 32 && SizeAndAlign(s).Size() <= 8*128 && SizeAndAlign(s).Align()%8 == 0 && !config.noDuffDevice) {
+			break
+		}
+		v.reset(OpRISCVDUFFZERO)
+		v.AuxInt = 4 * (128 - SizeAndAlign(s).Size()/8)
+		v.AddArg(ptr)
+		v.AddArg(mem)
+		return true
+	}
+	// match: (Zero [s] ptr mem)
+	// cond:
+	// result: (LoweredZero [SizeAndAlign(s).Align()] 		ptr 		(ADD <ptr.Type> ptr (MOVDconst [SizeAndAlign(s).Size()-moveSize(SizeAndAlign(s).Align(), config)])) 		mem)
+	for {
+		s := v.AuxInt
+		ptr := v.Args[0]
+		mem := v.Args[1]
+		v.reset(OpRISCVLoweredZero)
+		v.AuxInt = SizeAndAlign(s).Align()
+		v.AddArg(ptr)
+		v0 := b.NewValue0(v.Pos, OpRISCVADD, ptr.Type)
+		v0.AddArg(ptr)
+		v1 := b.NewValue0(v.Pos, OpRISCVMOVDconst, config.fe.TypeUInt64())
+		v1.AuxInt = SizeAndAlign(s).Size() - moveSize(SizeAndAlign(s).Align(), config)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		v.AddArg(mem)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZeroExt16to32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ZeroExt16to32 x)
+	// cond:
+	// result: (MOVHUreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVHUreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZeroExt16to64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ZeroExt16to64 x)
+	// cond:
+	// result: (MOVHUreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVHUreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZeroExt32to64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ZeroExt32to64 x)
+	// cond:
+	// result: (MOVWUreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVWUreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZeroExt8to16(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ZeroExt8to16  x)
+	// cond:
+	// result: (MOVBUreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVBUreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZeroExt8to32(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ZeroExt8to32  x)
+	// cond:
+	// result: (MOVBUreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVBUreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZeroExt8to64(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (ZeroExt8to64  x)
+	// cond:
+	// result: (MOVBUreg x)
+	for {
+		x := v.Args[0]
+		v.reset(OpRISCVMOVBUreg)
+		v.AddArg(x)
+		return true
+	}
+}
+func rewriteValueRISCV_OpZeromask(v *Value, config *Config) bool {
+	b := v.Block
+	_ = b
+	// match: (Zeromask <t> x)
+	// cond:
+	// result: (Neg32 <t> (SNEZ <t> x))
+	for {
+		t := v.Type
+		x := v.Args[0]
+		v.reset(OpNeg32)
+		v.Type = t
+		v0 := b.NewValue0(v.Pos, OpRISCVSNEZ, t)
+		v0.AddArg(x)
+		v.AddArg(v0)
+		return true
+	}
+}
+func rewriteBlockRISCV(b *Block, config *Config) bool {
+	switch b.Kind {
+	case BlockRISCVBEQ:
+		// match: (BEQ  (MOVDconst [0]) y yes no)
+		// cond:
+		// result: (BEQZ y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v.AuxInt != 0 {
+				break
+			}
+			v2 := b.Control2
+			_ = v2
+			y := b.Control2
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			b.SetControl(y)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQ  x (MOVDconst [0]) yes no)
+		// cond:
+		// result: (BEQZ x yes no)
+		for {
+			v := b.Control
+			_ = v
+			x := b.Control
+			v2 := b.Control2
+			if v2.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v2.AuxInt != 0 {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			b.SetControl(x)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockRISCVBEQZ:
+		// match: (BEQZ (SEQZ x) yes no)
+		// cond:
+		// result: (BNEZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSEQZ {
+				break
+			}
+			x := v.Args[0]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (SNEZ x) yes no)
+		// cond:
+		// result: (BEQZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSNEZ {
+				break
+			}
+			x := v.Args[0]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (XORI [1] x:(SLT  _ _)) yes no)
+		// cond:
+		// result: (BNEZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVXORI {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			x := v.Args[0]
+			if x.Op != OpRISCVSLT {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (XORI [1] x:(SLTU _ _)) yes no)
+		// cond:
+		// result: (BNEZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVXORI {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			x := v.Args[0]
+			if x.Op != OpRISCVSLTU {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (FNED x y) yes no)
+		// cond:
+		// result: (BNEZ (FEQD <config.fe.TypeBool()> x y) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVFNED {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			v0 := b.NewValue0(v.Pos, OpRISCVFEQD, config.fe.TypeBool())
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (FNES x y) yes no)
+		// cond:
+		// result: (BNEZ (FEQS <config.fe.TypeBool()> x y) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVFNES {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			v0 := b.NewValue0(v.Pos, OpRISCVFEQS, config.fe.TypeBool())
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (SUB x y) yes no)
+		// cond:
+		// result: (BEQ x y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSUB {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQ
+			b.SetControl(x)
+			b.SetControl2(y)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (SLT  x y) yes no)
+		// cond:
+		// result: (BGE  x y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSLT {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBGE
+			b.SetControl(x)
+			b.SetControl2(y)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BEQZ (SLTU x y) yes no)
+		// cond:
+		// result: (BGEU x y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSLTU {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBGEU
+			b.SetControl(x)
+			b.SetControl2(y)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockRISCVBGE:
+		// match: (BGE  (MOVDconst [0]) y yes no)
+		// cond:
+		// result: (BLEZ y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v.AuxInt != 0 {
+				break
+			}
+			v2 := b.Control2
+			_ = v2
+			y := b.Control2
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBLEZ
+			b.SetControl(y)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BGE  x (MOVDconst [0]) yes no)
+		// cond:
+		// result: (BGEZ x yes no)
+		for {
+			v := b.Control
+			_ = v
+			x := b.Control
+			v2 := b.Control2
+			if v2.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v2.AuxInt != 0 {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBGEZ
+			b.SetControl(x)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockRISCVBGEU:
+		// match: (BGEU (MOVDconst [0]) y yes no)
+		// cond:
+		// result: (BEQZ y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v.AuxInt != 0 {
+				break
+			}
+			v2 := b.Control2
+			_ = v2
+			y := b.Control2
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			b.SetControl(y)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockRISCVBLT:
+		// match: (BLT  (MOVDconst [0]) y yes no)
+		// cond:
+		// result: (BGTZ y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v.AuxInt != 0 {
+				break
+			}
+			v2 := b.Control2
+			_ = v2
+			y := b.Control2
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBGTZ
+			b.SetControl(y)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BLT  x (MOVDconst [0]) yes no)
+		// cond:
+		// result: (BLTZ x yes no)
+		for {
+			v := b.Control
+			_ = v
+			x := b.Control
+			v2 := b.Control2
+			if v2.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v2.AuxInt != 0 {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBLTZ
+			b.SetControl(x)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockRISCVBLTU:
+		// match: (BLTU (MOVDconst [0]) y yes no)
+		// cond:
+		// result: (BNEZ y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v.AuxInt != 0 {
+				break
+			}
+			v2 := b.Control2
+			_ = v2
+			y := b.Control2
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			b.SetControl(y)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockRISCVBNE:
+		// match: (BNE  (MOVDconst [0]) y yes no)
+		// cond:
+		// result: (BNEZ y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v.AuxInt != 0 {
+				break
+			}
+			v2 := b.Control2
+			_ = v2
+			y := b.Control2
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			b.SetControl(y)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNE  x (MOVDconst [0]) yes no)
+		// cond:
+		// result: (BNEZ x yes no)
+		for {
+			v := b.Control
+			_ = v
+			x := b.Control
+			v2 := b.Control2
+			if v2.Op != OpRISCVMOVDconst {
+				break
+			}
+			if v2.AuxInt != 0 {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			b.SetControl(x)
+			b.SetControl2(nil)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockRISCVBNEZ:
+		// match: (BNEZ (SEQZ x) yes no)
+		// cond:
+		// result: (BEQZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSEQZ {
+				break
+			}
+			x := v.Args[0]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (SNEZ x) yes no)
+		// cond:
+		// result: (BNEZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSNEZ {
+				break
+			}
+			x := v.Args[0]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (XORI [1] x:(SLT  _ _)) yes no)
+		// cond:
+		// result: (BEQZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVXORI {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			x := v.Args[0]
+			if x.Op != OpRISCVSLT {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (XORI [1] x:(SLTU _ _)) yes no)
+		// cond:
+		// result: (BEQZ x yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVXORI {
+				break
+			}
+			if v.AuxInt != 1 {
+				break
+			}
+			x := v.Args[0]
+			if x.Op != OpRISCVSLTU {
+				break
+			}
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			b.SetControl(x)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (FNED x y) yes no)
+		// cond:
+		// result: (BEQZ (FEQD <config.fe.TypeBool()> x y) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVFNED {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			v0 := b.NewValue0(v.Pos, OpRISCVFEQD, config.fe.TypeBool())
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (FNES x y) yes no)
+		// cond:
+		// result: (BEQZ (FEQS <config.fe.TypeBool()> x y) yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVFNES {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBEQZ
+			v0 := b.NewValue0(v.Pos, OpRISCVFEQS, config.fe.TypeBool())
+			v0.AddArg(x)
+			v0.AddArg(y)
+			b.SetControl(v0)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (SUB x y) yes no)
+		// cond:
+		// result: (BNE x y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSUB {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNE
+			b.SetControl(x)
+			b.SetControl2(y)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (SLT  x y) yes no)
+		// cond:
+		// result: (BLT  x y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSLT {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBLT
+			b.SetControl(x)
+			b.SetControl2(y)
+			_ = yes
+			_ = no
+			return true
+		}
+		// match: (BNEZ (SLTU x y) yes no)
+		// cond:
+		// result: (BLTU x y yes no)
+		for {
+			v := b.Control
+			if v.Op != OpRISCVSLTU {
+				break
+			}
+			x := v.Args[0]
+			y := v.Args[1]
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBLTU
+			b.SetControl(x)
+			b.SetControl2(y)
+			_ = yes
+			_ = no
+			return true
+		}
+	case BlockIf:
+		// match: (If cond yes no)
+		// cond:
+		// result: (BNEZ cond yes no)
+		for {
+			v := b.Control
+			_ = v
+			cond := b.Control
+			yes := b.Succs[0]
+			no := b.Succs[1]
+			b.Kind = BlockRISCVBNEZ
 			b.SetControl(cond)
 			_ = yes
 			_ = no
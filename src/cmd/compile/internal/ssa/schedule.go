@@ -177,20 +177,23 @@ func schedule(f *Func) {
 			}
 		}
 
-		if b.Control != nil && b.Control.Op != OpPhi {
-			// Force the control value to be scheduled at the end,
-			// unless it is a phi value (which must be first).
-			score[b.Control.ID] = ScoreControl
+		for _, c := range [...]*Value{b.Control, b.Control2} {
+			if c == nil || c.Op == OpPhi {
+				continue
+			}
+			// Force the control values to be scheduled at the end,
+			// unless they are phi values (which must be first).
+			score[c.ID] = ScoreControl
 
-			// Schedule values dependent on the control value at the end.
+			// Schedule values dependent on the control values at the end.
 			// This reduces the number of register spills. We don't find
-			// all values that depend on the control, just values with a
+			// all values that depend on the controls, just values with a
 			// direct dependency. This is cheaper and in testing there
 			// was no difference in the number of spills.
 			for _, v := range b.Values {
 				if v.Op != OpPhi {
 					for _, a := range v.Args {
-						if a == b.Control {
+						if a == c {
 							score[v.ID] = ScoreControl
 						}
 					}
@@ -23,7 +23,7 @@ func TestSizeof(t *testing.T) {
 		_64bit uintptr     // size on 64bit platforms
 	}{
 		{Value{}, 72, 120},
-		{Block{}, 152, 288},
+		{Block{}, 156, 296},
 	}
 
 	for _, tt := range tests {
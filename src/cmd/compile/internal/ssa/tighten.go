@@ -88,8 +88,8 @@ func tighten(f *Func) {
 					}
 				}
 			}
-			if c := b.Control; c != nil {
-				if !canMove[c.ID] {
+			for _, c := range [...]*Value{b.Control, b.Control2} {
+				if c == nil || !canMove[c.ID] {
 					continue
 				}
 				if target[c.ID] == nil {
@@ -40,7 +40,7 @@ type Value struct {
 	// Source position
 	Pos src.XPos
 
-	// Use count. Each appearance in Value.Args and Block.Control counts once.
+	// Use count. Each appearance in Value.Args, Block.Control and Block.Control2 counts once.
 	Uses int32
 
 	// Storage for the first three args
@@ -46,7 +46,7 @@ func main() {
 		ppc64.Init()
 	case "s390x":
 		s390x.Init()
-	case "riscv":
+	case "riscv", "riscv32":
 		riscv.Main()
 	}
 
@@ -28,6 +28,7 @@ var (
 	goos                   string
 	goarm                  string
 	go386                  string
+	goriscv                string
 	goroot                 string
 	goroot_final           string
 	goextlinkenabled       string
@@ -64,6 +65,7 @@ var okgoarch = []string{
 	"ppc64",
 	"ppc64le",
 	"riscv",
+	"riscv32",
 	"s390x",
 }
 
@@ -139,6 +141,8 @@ func xinit() {
 	}
 	go386 = b
 
+	goriscv = os.Getenv("GORISCV")
+
 	p := pathf("%s/src/all.bash", goroot)
 	if !isfile(p) {
 		fatal("$GOROOT is not set correctly or not exported\n"+
@@ -225,6 +229,7 @@ func xinit() {
 	os.Setenv("GOHOSTARCH", gohostarch)
 	os.Setenv("GOHOSTOS", gohostos)
 	os.Setenv("GOOS", goos)
+	os.Setenv("GORISCV", goriscv)
 	os.Setenv("GOROOT", goroot)
 	os.Setenv("GOROOT_FINAL", goroot_final)
 
@@ -996,6 +1001,9 @@ func cmdenv() {
 	if goarch == "386" {
 		xprintf(format, "GO386", go386)
 	}
+	if goarch == "riscv" || goarch == "riscv32" {
+		xprintf(format, "GORISCV", goriscv)
+	}
 
 	if *path {
 		sep := ":"
@@ -1112,7 +1120,8 @@ var cgoEnabled = map[string]bool{
 	"linux/mipsle":    true,
 	"linux/mips64":    true,
 	"linux/mips64le":  true,
-	"linux/riscv":     false,
+	"linux/riscv":     true,
+	"linux/riscv32":   false,
 	"linux/s390x":     true,
 	"android/386":     true,
 	"android/amd64":   true,
@@ -25,7 +25,7 @@ import (
 func mkzversion(dir, file string) {
 	// FIXME: We need large stacks until we can link in the runtime,
 	// to avoid needing to ever call runtime.morestack.
-	// The + 20 * GoarchRiscv should be removed.
+	// The + 20 * (GoarchRiscv + GoarchRiscv32) should be removed.
 	out := fmt.Sprintf(
 		"// auto generated by go tool dist\n"+
 			"\n"+
@@ -34,7 +34,7 @@ func mkzversion(dir, file string) {
 			"const DefaultGoroot = `%s`\n"+
 			"const TheVersion = `%s`\n"+
 			"const Goexperiment = `%s`\n"+
-			"const StackGuardMultiplier = %d + 20 * GoarchRiscv\n\n", goroot_final, findgoversion(), os.Getenv("GOEXPERIMENT"), stackGuardMultiplier())
+			"const StackGuardMultiplier = %d + 20 * (GoarchRiscv + GoarchRiscv32)\n\n", goroot_final, findgoversion(), os.Getenv("GOEXPERIMENT"), stackGuardMultiplier())
 
 	writefile(out, file, writeSkipSame)
 }
@@ -46,6 +46,7 @@ func mkzversion(dir, file string) {
 //	const defaultGOROOT = <goroot>
 //	const defaultGO386 = <go386>
 //	const defaultGOARM = <goarm>
+//	const defaultGORISCV = <goriscv>
 //	const defaultGOOS = runtime.GOOS
 //	const defaultGOARCH = runtime.GOARCH
 //	const defaultGO_EXTLINK_ENABLED = <goextlinkenabled>
@@ -73,13 +74,14 @@ func mkzbootstrap(file string) {
 			"const defaultGOROOT = `%s`\n"+
 			"const defaultGO386 = `%s`\n"+
 			"const defaultGOARM = `%s`\n"+
+			"const defaultGORISCV = `%s`\n"+
 			"const defaultGOOS = runtime.GOOS\n"+
 			"const defaultGOARCH = runtime.GOARCH\n"+
 			"const defaultGO_EXTLINK_ENABLED = `%s`\n"+
 			"const version = `%s`\n"+
 			"const stackGuardMultiplier = %d\n"+
 			"const goexperiment = `%s`\n",
-		goroot_final, go386, goarm, goextlinkenabled, findgoversion(), stackGuardMultiplier(), os.Getenv("GOEXPERIMENT"))
+		goroot_final, go386, goarm, goriscv, goextlinkenabled, findgoversion(), stackGuardMultiplier(), os.Getenv("GOEXPERIMENT"))
 
 	writefile(out, file, writeSkipSame)
 }
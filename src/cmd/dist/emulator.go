@@ -0,0 +1,211 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// emulators lists, for each GOARCH that can be tested under a
+// user-mode emulator on a linux host, the commands to try in order.
+// spike with the pk proxy kernel is much slower than qemu-user and
+// supports fewer system calls, so it is only a fallback.
+var emulators = map[string][][]string{
+	"riscv":   {{"qemu-riscv64"}, {"spike", "pk"}},
+	"riscv32": {{"qemu-riscv32"}},
+}
+
+// emulatorSkipFile lists the tests that cannot pass under emulation,
+// relative to $GOROOT.
+const emulatorSkipFile = "misc/riscv/emulator_skip.txt"
+
+// findEmulator returns the command to run target binaries with, or
+// nil if the host can run them itself or no emulator was found.
+// A go_$GOOS_$GOARCH_exec wrapper in $PATH is preferred over the
+// emulators themselves, as go test would use it too.
+func (t *tester) findEmulator() []string {
+	if t.emulatorStr != "" {
+		return strings.Fields(t.emulatorStr)
+	}
+	if t.gohostos != "linux" || t.goos != "linux" || t.goarch == t.gohostarch {
+		return nil
+	}
+	cmds, ok := emulators[t.goarch]
+	if !ok {
+		return nil
+	}
+	if path, err := exec.LookPath(fmt.Sprintf("go_%s_%s_exec", t.goos, t.goarch)); err == nil {
+		return []string{path}
+	}
+	for _, cmd := range cmds {
+		if _, err := exec.LookPath(cmd[0]); err == nil {
+			return cmd
+		}
+	}
+	return nil
+}
+
+// readEmulatorSkips reads emulatorSkipFile. Each line names a package
+// and optionally tests in it; a package without tests is skipped
+// entirely, and is mapped to a nil slice. Text after a # is ignored.
+func (t *tester) readEmulatorSkips() (map[string][]string, error) {
+	f, err := os.Open(filepath.Join(t.goroot, emulatorSkipFile))
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	skips := make(map[string][]string)
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := s.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		f := strings.Fields(line)
+		if len(f) == 0 {
+			continue
+		}
+		skips[f[0]] = append(skips[f[0]], f[1:]...)
+	}
+	return skips, s.Err()
+}
+
+var testFuncRx = regexp.MustCompile(`(?m)^func ((Test|Example)\w*)\(`)
+
+// emulatorRunFlag returns a -run pattern matching the tests and
+// examples in pkg other than skip, or "" if none are left.
+func (t *tester) emulatorRunFlag(pkg string, skip []string) (string, error) {
+	skipped := make(map[string]bool)
+	for _, name := range skip {
+		skipped[name] = true
+	}
+	files, err := filepath.Glob(filepath.Join(t.goroot, "src", pkg, "*_test.go"))
+	if err != nil {
+		return "", err
+	}
+	seen := make(map[string]bool)
+	var names []string
+	for _, file := range files {
+		slurp, err := ioutil.ReadFile(file)
+		if err != nil {
+			return "", err
+		}
+		for _, m := range testFuncRx.FindAllSubmatch(slurp, -1) {
+			name := string(m[1])
+			if name == "TestMain" || skipped[name] || seen[name] {
+				continue
+			}
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", nil
+	}
+	sort.Strings(names)
+	return "^(" + strings.Join(names, "|") + ")$", nil
+}
+
+// runEmulatedStdTests runs go test with args on pkgs, running the
+// test binaries with t.emulator and leaving out the tests in
+// emulatorSkipFile, then prints a summary of the results.
+func (t *tester) runEmulatedStdTests(args, pkgs []string) error {
+	skips, err := t.readEmulatorSkips()
+	if err != nil {
+		return err
+	}
+	args = append(args, "-exec="+strings.Join(t.emulator, " "))
+
+	var whole []string                 // packages run with all their tests
+	partial := make(map[string]string) // packages run with a -run pattern
+	var skippedPkgs []string
+	skippedTests := 0
+	for _, pkg := range pkgs {
+		skip, ok := skips[pkg]
+		switch {
+		case !ok:
+			whole = append(whole, pkg)
+		case skip == nil:
+			skippedPkgs = append(skippedPkgs, pkg)
+		default:
+			run, err := t.emulatorRunFlag(pkg, skip)
+			if err != nil {
+				return err
+			}
+			skippedTests += len(skip)
+			if run == "" {
+				skippedPkgs = append(skippedPkgs, pkg)
+				continue
+			}
+			partial[pkg] = run
+		}
+	}
+
+	var out bytes.Buffer
+	goTest := func(extra ...string) error {
+		cmd := exec.Command("go", append(append([]string(nil), args...), extra...)...)
+		cmd.Stdout = io.MultiWriter(os.Stdout, &out)
+		cmd.Stderr = cmd.Stdout
+		if vflag > 1 {
+			errprintf("%s\n", strings.Join(cmd.Args, " "))
+		}
+		return cmd.Run()
+	}
+	var firstErr error
+	if len(whole) > 0 {
+		firstErr = goTest(whole...)
+	}
+	var names []string
+	for pkg := range partial {
+		names = append(names, pkg)
+	}
+	sort.Strings(names)
+	for _, pkg := range names {
+		if firstErr != nil && !t.keepGoing {
+			break
+		}
+		if err := goTest("-run="+partial[pkg], pkg); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	t.emulatorSummary(out.Bytes(), skippedPkgs, skippedTests)
+	return firstErr
+}
+
+var goTestResultRx = regexp.MustCompile(`(?m)^(ok  |FAIL)\t(\S+)`)
+
+// emulatorSummary prints the number of packages that passed, failed
+// and were skipped, given the output of the go test runs.
+func (t *tester) emulatorSummary(out []byte, skippedPkgs []string, skippedTests int) {
+	var passed int
+	var failed []string
+	for _, m := range goTestResultRx.FindAllSubmatch(out, -1) {
+		if string(m[1]) == "FAIL" {
+			failed = append(failed, string(m[2]))
+		} else {
+			passed++
+		}
+	}
+	t.out("Summary of tests under " + strings.Join(t.emulator, " ") + ".")
+	fmt.Printf("%d packages passed, %d failed, %d skipped; %d further tests skipped by %s\n",
+		passed, len(failed), len(skippedPkgs), skippedTests, emulatorSkipFile)
+	for _, pkg := range failed {
+		fmt.Printf("FAIL\t%s\n", pkg)
+	}
+	for _, pkg := range skippedPkgs {
+		fmt.Printf("skip\t%s\n", pkg)
+	}
+}
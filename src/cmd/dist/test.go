@@ -31,6 +31,8 @@ func cmdtest() {
 	flag.BoolVar(&t.race, "race", false, "run in race builder mode (different set of tests)")
 	flag.BoolVar(&t.compileOnly, "compile-only", false, "compile tests, but don't run them. This is for some builders. Not all dist tests respect this flag, but most do.")
 	flag.StringVar(&t.banner, "banner", "##### ", "banner prefix; blank means no section banners")
+	flag.StringVar(&t.emulatorStr, "emulator", os.Getenv("GO_TEST_EMULATOR"),
+		"command to run target binaries with; empty means look for one when testing a target the host cannot run")
 	flag.StringVar(&t.runRxStr, "run", os.Getenv("GOTESTONLY"),
 		"run only those tests matching the regular expression; empty means to run all. "+
 			"Special exception: if the string begins with '!', the match is inverted.")
@@ -65,6 +67,9 @@ type tester struct {
 	partial    bool
 	haveTime   bool // the 'time' binary is available
 
+	emulatorStr string   // -emulator flag
+	emulator    []string // command to run target binaries with, if any
+
 	tests        []distTest
 	timeoutScale int
 
@@ -111,6 +116,7 @@ func (t *tester) run() {
 		log.Fatalf("the -run regular expression flag is mutually exclusive with test name arguments")
 	}
 	t.runNames = flag.Args()
+	t.emulator = t.findEmulator()
 
 	if t.hasBash() {
 		if _, err := exec.LookPath("time"); err == nil {
@@ -158,6 +164,9 @@ func (t *tester) run() {
 	case "mips", "mipsle", "mips64", "mips64le":
 		t.timeoutScale = 4
 	}
+	if t.emulator != nil {
+		t.timeoutScale *= 4
+	}
 	if s := os.Getenv("GO_TEST_TIMEOUT_SCALE"); s != "" {
 		t.timeoutScale, err = strconv.Atoi(s)
 		if err != nil {
@@ -175,6 +184,11 @@ func (t *tester) run() {
 		t.runRx = regexp.MustCompile(t.runRxStr)
 	}
 
+	if t.emulator != nil && !t.listMode {
+		t.out(fmt.Sprintf("Running %s/%s binaries with %s.", t.goos, t.goarch, strings.Join(t.emulator, " ")))
+		fmt.Println("Only the standard library package tests are run. The runtime -cpu, cgo, misc, doc, api and test directory tests are skipped.")
+	}
+
 	t.registerTests()
 	if t.listMode {
 		for _, tt := range t.tests {
@@ -288,6 +302,9 @@ func (t *tester) registerStdTest(pkg string) {
 			if t.compileOnly {
 				args = append(args, "-run=^$")
 			}
+			if t.emulator != nil {
+				return t.runEmulatedStdTests(args, stdMatches)
+			}
 			args = append(args, stdMatches...)
 			cmd := exec.Command("go", args...)
 			cmd.Stdout = os.Stdout
@@ -353,7 +370,7 @@ func (t *tester) registerTests() {
 
 	// This test needs its stdout/stderr to be terminals, so we don't run it from cmd/go's tests.
 	// See issue 18153.
-	if t.goos == "linux" {
+	if t.goos == "linux" && t.emulator == nil {
 		t.tests = append(t.tests, distTest{
 			name:    "cmd_go_test_terminal",
 			heading: "cmd/go terminal test",
@@ -392,7 +409,7 @@ func (t *tester) registerTests() {
 			cmd.Args = append(cmd.Args, "-tags", "race")
 		}
 		cmd.Args = append(cmd.Args, "std")
-		if !t.race {
+		if !t.race && t.emulator == nil {
 			cmd.Args = append(cmd.Args, "cmd")
 		}
 		all, err := cmd.Output()
@@ -412,7 +429,9 @@ func (t *tester) registerTests() {
 		}
 	}
 
-	if t.race {
+	// Under an emulator only the standard library tests are run; the
+	// rest build and run target programs directly.
+	if t.race || t.emulator != nil {
 		return
 	}
 
@@ -462,7 +481,7 @@ func (t *tester) registerTests() {
 	}
 
 	// Test internal linking of PIE binaries where it is supported.
-	if t.goos == "linux" && t.goarch == "amd64" {
+	if t.goos == "linux" && (t.goarch == "amd64" || t.goarch == "riscv") {
 		t.tests = append(t.tests, distTest{
 			name:    "pie_internal",
 			heading: "internal linking of -buildmode=pie",
@@ -703,7 +722,7 @@ func (t *tester) extLink() bool {
 		"darwin-arm", "darwin-arm64",
 		"dragonfly-386", "dragonfly-amd64",
 		"freebsd-386", "freebsd-amd64", "freebsd-arm",
-		"linux-386", "linux-amd64", "linux-arm", "linux-arm64", "linux-ppc64le", "linux-mips64", "linux-mips64le", "linux-mips", "linux-mipsle", "linux-s390x",
+		"linux-386", "linux-amd64", "linux-arm", "linux-arm64", "linux-ppc64le", "linux-mips64", "linux-mips64le", "linux-mips", "linux-mipsle", "linux-riscv", "linux-s390x",
 		"netbsd-386", "netbsd-amd64",
 		"openbsd-386", "openbsd-amd64",
 		"windows-386", "windows-amd64":
@@ -740,7 +759,7 @@ func (t *tester) internalLink() bool {
 	// Internally linking cgo is incomplete on some architectures.
 	// https://golang.org/issue/10373
 	// https://golang.org/issue/14449
-	if t.goarch == "arm64" || t.goarch == "mips64" || t.goarch == "mips64le" || t.goarch == "mips" || t.goarch == "mipsle" {
+	if t.goarch == "arm64" || t.goarch == "mips64" || t.goarch == "mips64le" || t.goarch == "mips" || t.goarch == "mipsle" || t.goarch == "riscv" {
 		return false
 	}
 	return true
@@ -844,7 +863,7 @@ func (t *tester) cgoTest(dt *distTest) error {
 	case "android-arm",
 		"dragonfly-386", "dragonfly-amd64",
 		"freebsd-386", "freebsd-amd64", "freebsd-arm",
-		"linux-386", "linux-amd64", "linux-arm", "linux-ppc64le", "linux-s390x",
+		"linux-386", "linux-amd64", "linux-arm", "linux-ppc64le", "linux-riscv", "linux-s390x",
 		"netbsd-386", "netbsd-amd64":
 
 		cmd := t.addCmd(dt, "misc/cgo/test", "go", "test", "-ldflags", "-linkmode=external")
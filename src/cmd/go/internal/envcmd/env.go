@@ -62,6 +62,8 @@ func MkEnv() []cfg.EnvVar {
 		env = append(env, cfg.EnvVar{"GOARM", os.Getenv("GOARM")})
 	case "386":
 		env = append(env, cfg.EnvVar{"GO386", os.Getenv("GO386")})
+	case "riscv", "riscv32":
+		env = append(env, cfg.EnvVar{"GORISCV", os.Getenv("GORISCV")})
 	}
 
 	cmd := b.GccCmd(".")
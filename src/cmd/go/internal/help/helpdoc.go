@@ -494,6 +494,12 @@ Architecture-specific environment variables:
 	GO386
 		For GOARCH=386, the floating point instruction set.
 		Valid values are 387, sse2.
+	GORISCV
+		For GOARCH=riscv and riscv32, the optional instruction set
+		extensions that generated code may use, as a comma-separated list.
+		Valid extensions are b (Zba, Zbb and Zbs) and c. The value g
+		selects the base RV64G or RV32G ISA alone. The default, unless
+		set when building the toolchain, is g.
 
 Special-purpose environment variables:
 
@@ -1654,6 +1654,14 @@ func computeBuildID(p *Package) {
 		fmt.Fprintf(h, "zversion %q\n", string(data))
 	}
 
+	// Include $GORISCV in the hash for package runtime/internal/sys,
+	// which every package depends on, so that changing it rebuilds
+	// everything rather than mixing code for different extensions.
+	// An empty $GORISCV stands for the toolchain default.
+	if p.Standard && p.ImportPath == "runtime/internal/sys" && (cfg.BuildContext.GOARCH == "riscv" || cfg.BuildContext.GOARCH == "riscv32") {
+		fmt.Fprintf(h, "goriscv %q\n", os.Getenv("GORISCV"))
+	}
+
 	// Include the build IDs of any dependencies in the hash.
 	// This, combined with the runtime/zversion content,
 	// will cause packages to have different build IDs when
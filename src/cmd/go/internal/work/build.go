@@ -316,7 +316,7 @@ func BuildModeInit() {
 			base.Fatalf("-buildmode=pie not supported by gccgo")
 		} else {
 			switch platform {
-			case "linux/386", "linux/amd64", "linux/arm", "linux/arm64", "linux/ppc64le", "linux/s390x",
+			case "linux/386", "linux/amd64", "linux/arm", "linux/arm64", "linux/ppc64le", "linux/riscv", "linux/s390x",
 				"android/amd64", "android/arm", "android/arm64", "android/386":
 				codegenArg = "-shared"
 			default:
@@ -3035,6 +3035,10 @@ func (b *Builder) gccArchArgs() []string {
 		return []string{"-mabi=64"}
 	case "mips", "mipsle":
 		return []string{"-mabi=32", "-march=mips32"}
+	case "riscv":
+		return []string{"-march=rv64gc", "-mabi=lp64d"}
+	case "riscv32":
+		return []string{"-march=rv32gc", "-mabi=ilp32d"}
 	}
 	return nil
 }
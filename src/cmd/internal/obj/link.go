@@ -685,6 +685,11 @@ const (
 	// R_RISCV_PCREL_STYPE resolves a 32-bit PC-relative address using an AUIPC +
 	// S-type instruction pair.
 	R_RISCV_PCREL_STYPE
+
+	// R_RISCV_GOT_PCREL_ITYPE resolves a 32-bit PC-relative address of the
+	// GOT slot holding the address of a symbol using an AUIPC + load
+	// instruction pair.
+	R_RISCV_GOT_PCREL_ITYPE
 )
 
 // IsDirectJump returns whether r is a relocation for a direct jump.
@@ -737,6 +742,7 @@ type Link struct {
 	Flag_shared   bool
 	Flag_dynlink  bool
 	Flag_optimize bool
+	Flag_rvc      bool // riscv: emit compressed instructions
 	Bso           *bufio.Writer
 	Pathname      string
 	Hash          map[SymVer]*LSym
@@ -171,6 +171,56 @@ var Anames = []string{
 	"FLTD",
 	"FLED",
 	"FCLASSD",
+	"ADDUW",
+	"SH1ADD",
+	"SH1ADDUW",
+	"SH2ADD",
+	"SH2ADDUW",
+	"SH3ADD",
+	"SH3ADDUW",
+	"SLLIUW",
+	"ANDN",
+	"ORN",
+	"XNOR",
+	"CLZ",
+	"CLZW",
+	"CTZ",
+	"CTZW",
+	"CPOP",
+	"CPOPW",
+	"MAX",
+	"MAXU",
+	"MIN",
+	"MINU",
+	"SEXTB",
+	"SEXTH",
+	"ZEXTH",
+	"ROL",
+	"ROLW",
+	"ROR",
+	"RORI",
+	"RORIW",
+	"RORW",
+	"ORCB",
+	"REV8",
+	"ZEXTHRV32",
+	"REV8RV32",
+	"BCLR",
+	"BCLRI",
+	"BEXT",
+	"BEXTI",
+	"BINV",
+	"BINVI",
+	"BSET",
+	"BSETI",
+	"SHA256SIG0",
+	"SHA256SIG1",
+	"SHA256SUM0",
+	"SHA256SUM1",
+	"SHA512SIG0",
+	"SHA512SIG1",
+	"SHA512SUM0",
+	"SHA512SUM1",
 	"CSRRW",
 	"CSRRS",
 	"CSRRC",
@@ -181,14 +231,11 @@ var Anames = []string{
 	"SCALL",
 	"EBREAK",
 	"SBREAK",
-	"ERET",
 	"SRET",
-	"MRTS",
-	"MRTH",
-	"HRTS",
 	"WFI",
-	"SFENCEVM",
 	"WORD",
+	"FABSD",
+	"FABSS",
 	"FNEGD",
 	"FNEGS",
 	"FNED",
@@ -38,9 +38,17 @@ package riscv
 
 import (
 	"cmd/internal/obj"
+	"cmd/internal/sys"
 	"fmt"
+	"math"
 )
 
+// isRV32 reports whether ctxt targets RV32, where the integer registers
+// and pointers are 32 bits wide.
+func isRV32(ctxt *obj.Link) bool {
+	return ctxt.Arch.Family == sys.RISCV32
+}
+
 // stackOffset updates Addr offsets based on the current stack size.
 //
 // The stack looks like:
@@ -66,14 +74,14 @@ import (
 // Slide 21 on the presention attached to
 // https://golang.org/issue/16922#issuecomment-243748180 has a nicer version
 // of this diagram.
-func stackOffset(a *obj.Addr, stacksize int64) {
+func stackOffset(ctxt *obj.Link, a *obj.Addr, stacksize int64) {
 	switch a.Name {
 	case obj.NAME_AUTO:
 		// Adjust to the top of AUTOs.
 		a.Offset += stacksize
 	case obj.NAME_PARAM:
 		// Adjust to the bottom of PARAMs.
-		a.Offset += stacksize + 8
+		a.Offset += stacksize + ctxt.FixedFrameSize()
 	}
 }
 
@@ -131,7 +139,10 @@ func jalrToSym(ctxt *obj.Link, p *obj.Prog, lr int16) *obj.Prog {
 	p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
 	p = obj.Appendp(ctxt, p)
 
+	// The runtime relies on the whole sequence being 12 bytes long
+	// (see runtime.jmpdefer), so never compress the JALR.
 	p.As = AJALR
+	p.Mark |= NO_COMPRESS
 	p.From.Type = obj.TYPE_REG
 	p.From.Reg = lr
 	// Leave Sym only for the CALL reloc in assemble.
@@ -145,9 +156,15 @@ func jalrToSym(ctxt *obj.Link, p *obj.Prog, lr int16) *obj.Prog {
 }
 
 // movtol converts a MOV mnemonic into the corresponding load instruction.
-func movtol(mnemonic obj.As) obj.As {
+//
+// MOV moves a whole register, which is a doubleword on RV64 and a word on
+// RV32.
+func movtol(ctxt *obj.Link, mnemonic obj.As) obj.As {
 	switch mnemonic {
 	case AMOV:
+		if isRV32(ctxt) {
+			return ALW
+		}
 		return ALD
 	case AMOVB:
 		return ALB
@@ -160,6 +177,9 @@ func movtol(mnemonic obj.As) obj.As {
 	case AMOVHU:
 		return ALHU
 	case AMOVWU:
+		if isRV32(ctxt) {
+			return ALW
+		}
 		return ALWU
 	case AMOVF:
 		return AFLW
@@ -171,9 +191,12 @@ func movtol(mnemonic obj.As) obj.As {
 }
 
 // movtos converts a MOV mnemonic into the corresponding store instruction.
-func movtos(mnemonic obj.As) obj.As {
+func movtos(ctxt *obj.Link, mnemonic obj.As) obj.As {
 	switch mnemonic {
 	case AMOV:
+		if isRV32(ctxt) {
+			return ASW
+		}
 		return ASD
 	case AMOVB:
 		return ASB
@@ -208,12 +231,19 @@ func progedit(ctxt *obj.Link, p *obj.Prog) {
 		p.From3 = &obj.Addr{Type: obj.TYPE_NONE}
 	}
 
+	if ctxt.Flag_dynlink {
+		rewriteToUseGot(ctxt, p)
+	}
+
 	// Expand binary instructions to ternary ones.
 	if p.From3.Type == obj.TYPE_NONE {
 		switch p.As {
 		case AADD, ASUB, ASLL, AXOR, ASRL, ASRA, AOR, AAND, AMUL, AMULH,
 			AMULHU, AMULHSU, AMULW, ADIV, ADIVU, AREM, AREMU, ADIVW,
-			ADIVUW, AREMW, AREMUW:
+			ADIVUW, AREMW, AREMUW, AADDW, ASUBW, ASLLW, ASRLW, ASRAW,
+			AADDUW, ASH1ADD, ASH1ADDUW, ASH2ADD, ASH2ADDUW, ASH3ADD,
+			ASH3ADDUW, AANDN, AORN, AXNOR, AMAX, AMAXU, AMIN, AMINU, AROL,
+			AROLW, AROR, ARORW, ABCLR, ABEXT, ABINV, ABSET:
 			p.From3.Type = obj.TYPE_REG
 			p.From3.Reg = p.To.Reg
 		}
@@ -241,6 +271,26 @@ func progedit(ctxt *obj.Link, p *obj.Prog) {
 			p.As = ASRLI
 		case AXOR:
 			p.As = AXORI
+		case AADDW:
+			p.As = AADDIW
+		case ASLLW:
+			p.As = ASLLIW
+		case ASRLW:
+			p.As = ASRLIW
+		case ASRAW:
+			p.As = ASRAIW
+		case AROR:
+			p.As = ARORI
+		case ARORW:
+			p.As = ARORIW
+		case ABCLR:
+			p.As = ABCLRI
+		case ABEXT:
+			p.As = ABEXTI
+		case ABINV:
+			p.As = ABINVI
+		case ABSET:
+			p.As = ABSETI
 		}
 	}
 
@@ -260,15 +310,21 @@ func progedit(ctxt *obj.Link, p *obj.Prog) {
 			case obj.NAME_AUTO, obj.NAME_PARAM, obj.NAME_NONE:
 				p.As = AJALR
 				lowerjalr(p)
-			case obj.NAME_EXTERN:
+			case obj.NAME_EXTERN, obj.NAME_STATIC:
 				// Handled in preprocess.
 			default:
-				ctxt.Diag("progedit: unsupported name %d for %v", p.To.Name, p)
+				badInst(ctxt, p, "progedit: unsupported name %d for %v", p.To.Name, p)
 			}
 		default:
-			panic(fmt.Sprintf("unhandled type %+v", p.To.Type))
+			badInst(ctxt, p, "progedit: unsupported destination type %v in JMP: %v", p.To.Type, p)
 		}
 
+	// DUFFZERO and DUFFCOPY are calls into the middle of
+	// runtime·duffzero and runtime·duffcopy; preprocess expands
+	// them like any other CALL to a symbol.
+	case obj.ADUFFZERO, obj.ADUFFCOPY:
+		p.As = obj.ACALL
+
 	case obj.ACALL:
 		switch p.To.Type {
 		case obj.TYPE_MEM:
@@ -285,19 +341,17 @@ func progedit(ctxt *obj.Link, p *obj.Prog) {
 	case AJALR:
 		lowerjalr(p)
 
-	case obj.AUNDEF, AECALL, AEBREAK, ASCALL, ARDCYCLE, ARDTIME, ARDINSTRET:
-		if p.As == obj.AUNDEF {
+	case obj.AUNDEF, AECALL, AEBREAK, ASCALL, ASBREAK, ASRET, AWFI,
+		ARDCYCLE, ARDTIME, ARDINSTRET, ARDCYCLEH, ARDTIMEH, ARDINSTRETH:
+		// SCALL and SBREAK are the old names for ECALL and EBREAK.
+		switch p.As {
+		case obj.AUNDEF, ASBREAK:
 			p.As = AEBREAK
-		}
-		// SCALL is the old name for ECALL.
-		if p.As == ASCALL {
+		case ASCALL:
 			p.As = AECALL
 		}
 
-		i, ok := encode(p.As)
-		if !ok {
-			panic("progedit: tried to rewrite nonexistent instruction")
-		}
+		i := instFor(p)
 		p.From.Type = obj.TYPE_CONST
 		// The CSR isn't exactly an offset, but it winds up in the
 		// immediate area of the encoded instruction, so record it in
@@ -310,6 +364,87 @@ func progedit(ctxt *obj.Link, p *obj.Prog) {
 			p.To.Reg = REG_ZERO
 		}
 
+	case ACSRRW, ACSRRS, ACSRRC, ACSRRWI, ACSRRSI, ACSRRCI:
+		// CSRRS $csr, rs1, rd. The immediate forms take a 5-bit
+		// unsigned immediate in place of rs1.
+		if p.From.Type != obj.TYPE_CONST || p.From.Offset < 0 || p.From.Offset > 4095 {
+			badInst(ctxt, p, "%v\tCSR number out of range 0 to 4095", p)
+			break
+		}
+		// CSR numbers are unsigned, but share the sign-extended
+		// immediate field of the encoding.
+		p.From.Offset = p.From.Offset << 52 >> 52
+		switch p.As {
+		case ACSRRWI, ACSRRSI, ACSRRCI:
+			csrImm(ctxt, p, p.From3)
+		}
+
+	case AFRCSR, AFRRM, AFRFLAGS:
+		// FRRM rd -> CSRRS $frm, ZERO, rd
+		p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: instFor(p).csr}
+		*p.From3 = obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
+
+	case AFSCSR, AFSRM, AFSFLAGS, AFSRMI, AFSFLAGSI:
+		// FSRM rs, rd -> CSRRW $frm, rs, rd
+		// The old value is written to rd, which may be omitted.
+		*p.From3 = p.From
+		p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: instFor(p).csr}
+		if p.To.Type == obj.TYPE_NONE {
+			p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
+		}
+		if p.As == AFSRMI || p.As == AFSFLAGSI {
+			csrImm(ctxt, p, p.From3)
+		}
+
+	case AMOVF:
+		// Rewrite float constants to values stored in memory.
+		if p.From.Type == obj.TYPE_FCONST {
+			f32 := float32(p.From.Val.(float64))
+			literal := fmt.Sprintf("$f32.%08x", math.Float32bits(f32))
+			s := obj.Linklookup(ctxt, literal, 0)
+			s.Size = 4
+			p.From = obj.Addr{Type: obj.TYPE_MEM, Name: obj.NAME_EXTERN, Sym: s}
+		}
+
+	case AMOVD:
+		if p.From.Type == obj.TYPE_FCONST {
+			i64 := math.Float64bits(p.From.Val.(float64))
+			literal := fmt.Sprintf("$f64.%016x", i64)
+			s := obj.Linklookup(ctxt, literal, 0)
+			s.Size = 8
+			p.From = obj.Addr{Type: obj.TYPE_MEM, Name: obj.NAME_EXTERN, Sym: s}
+		}
+
+	case AFENCE:
+		// FENCE orders all memory and I/O accesses: FENCE iorw, iorw.
+		p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: 0xff}
+		*p.From3 = obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
+		p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
+
+	case AFENCEI:
+		// FENCE.I has no operands; all of its fields are zero.
+		p.From = obj.Addr{Type: obj.TYPE_CONST}
+		*p.From3 = obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
+		p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
+
+	case ALRW, ALRD:
+		// LR (rs1), rd -> LR ZERO, rs1, rd
+		if p.From.Type != obj.TYPE_MEM || p.From.Offset != 0 || p.From.Name != obj.NAME_NONE {
+			badInst(ctxt, p, "progedit: %v: expected address with no offset", p)
+		}
+		*p.From3 = obj.Addr{Type: obj.TYPE_REG, Reg: p.From.Reg}
+		p.From = obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
+
+	case ASCW, ASCD, AAMOSWAPW, AAMOSWAPD, AAMOADDW, AAMOADDD, AAMOANDW, AAMOANDD,
+		AAMOORW, AAMOORD, AAMOXORW, AAMOXORD, AAMOMAXW, AAMOMAXD, AAMOMAXUW,
+		AAMOMAXUD, AAMOMINW, AAMOMIND, AAMOMINUW, AAMOMINUD:
+		// SC and the AMOs take the address as their middle operand:
+		// AMOADDW rs2, (rs1), rd.
+		if p.From3.Type != obj.TYPE_MEM || p.From3.Offset != 0 || p.From3.Name != obj.NAME_NONE {
+			badInst(ctxt, p, "progedit: %v: expected address with no offset", p)
+		}
+		p.From3.Type = obj.TYPE_REG
+
 	case ASEQZ:
 		// SEQZ rs, rd -> SLTIU $1, rs, rd
 		p.As = ASLTIU
@@ -323,6 +458,14 @@ func progedit(ctxt *obj.Link, p *obj.Prog) {
 
 	// For binary float instructions, use From3 and To, not From and
 	// To. This helps simplify encoding.
+	case AFABSS:
+		// FABSS rs, rd -> FSGNJXS rs, rs, rd
+		p.As = AFSGNJXS
+		*p.From3 = p.From
+	case AFABSD:
+		// FABSD rs, rd -> FSGNJXD rs, rs, rd
+		p.As = AFSGNJXD
+		*p.From3 = p.From
 	case AFNEGS:
 		// FNEGS rs, rd -> FSGNJNS rs, rs, rd
 		p.As = AFSGNJNS
@@ -338,9 +481,129 @@ func progedit(ctxt *obj.Link, p *obj.Prog) {
 		// be the second input operand.
 		p.From = obj.Addr{Type: obj.TYPE_REG, Reg: REG_F0}
 	case AFCVTWS, AFCVTLS, AFCVTWUS, AFCVTLUS, AFCVTWD, AFCVTLD, AFCVTWUD, AFCVTLUD:
-		// Set the rounding mode in funct3 to round to zero
-		p.Scond = 1
+		// Unless told otherwise, round to zero as Go requires.
+		if p.Scond == 0 {
+			p.Scond = RM_RTZ
+		}
+
+	// ZEXTH and REV8 are encoded differently on RV32.
+	case AZEXTH:
+		if isRV32(ctxt) {
+			p.As = AZEXTHRV32
+		}
+	case AREV8:
+		if isRV32(ctxt) {
+			p.As = AREV8RV32
+		}
+	}
+}
+
+// csrImm rewrites the 5-bit unsigned immediate operand a of a CSR
+// instruction as the register with that number, as the immediate is
+// encoded in the rs1 field.
+func csrImm(ctxt *obj.Link, p *obj.Prog, a *obj.Addr) {
+	if a.Type != obj.TYPE_CONST || a.Offset < 0 || a.Offset > 31 {
+		badInst(ctxt, p, "%v\tCSR immediate out of range 0 to 31", p)
+		return
+	}
+	*a = obj.Addr{Type: obj.TYPE_REG, Reg: REG_X0 + int16(a.Offset)}
+}
+
+// rewriteToUseGot rewrites p to reach global data through the GOT, so
+// that it can refer to symbols defined in other shared libraries.
+func rewriteToUseGot(ctxt *obj.Link, p *obj.Prog) {
+	if p.As == obj.ADUFFCOPY || p.As == obj.ADUFFZERO {
+		//     ADUFFxxx $offset
+		// becomes
+		//     MOV runtime.duffxxx@GOT, TMP
+		//     ADD $offset, TMP
+		//     CALL TMP
+		var sym *obj.LSym
+		if p.As == obj.ADUFFZERO {
+			sym = obj.Linklookup(ctxt, "runtime.duffzero", 0)
+		} else {
+			sym = obj.Linklookup(ctxt, "runtime.duffcopy", 0)
+		}
+		offset := p.To.Offset
+		p.As = AMOV
+		p.From = obj.Addr{Type: obj.TYPE_MEM, Name: obj.NAME_GOTREF, Sym: sym}
+		p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
+		p1 := obj.Appendp(ctxt, p)
+		p1.As = AADD
+		p1.From = obj.Addr{Type: obj.TYPE_CONST, Offset: offset}
+		p1.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
+		p2 := obj.Appendp(ctxt, p1)
+		p2.As = obj.ACALL
+		p2.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
+		return
+	}
+
+	// We only care about global data: NAME_EXTERN means a global
+	// symbol in the Go sense, and p.Sym.Local is true for a few
+	// internally defined symbols.
+	if p.From.Type == obj.TYPE_ADDR && p.From.Name == obj.NAME_EXTERN && !p.From.Sym.Local() {
+		// MOV $sym, Rx becomes MOV sym@GOT, Rx
+		// MOV $sym+<off>, Rx becomes MOV sym@GOT, Rx; ADD $<off>, Rx
+		if p.As != AMOV || p.To.Type != obj.TYPE_REG {
+			ctxt.Diag("do not know how to handle address of %v with -dynlink", p)
+		}
+		p.From.Type = obj.TYPE_MEM
+		p.From.Name = obj.NAME_GOTREF
+		if p.From.Offset != 0 {
+			q := obj.Appendp(ctxt, p)
+			q.As = AADD
+			q.From = obj.Addr{Type: obj.TYPE_CONST, Offset: p.From.Offset}
+			q.To = p.To
+			p.From.Offset = 0
+		}
+		return
+	}
+	if p.From3.Name == obj.NAME_EXTERN {
+		ctxt.Diag("don't know how to handle %v with -dynlink", p)
 	}
+
+	// MOVx sym, Ry becomes MOV sym@GOT, TMP; MOVx (TMP), Ry
+	// MOVx Ry, sym becomes MOV sym@GOT, TMP; MOVx Ry, (TMP)
+	// The offset, if any, moves to the second instruction.
+	var source *obj.Addr
+	if p.From.Name == obj.NAME_EXTERN && !p.From.Sym.Local() {
+		if p.To.Name == obj.NAME_EXTERN && !p.To.Sym.Local() {
+			ctxt.Diag("cannot handle NAME_EXTERN on both sides in %v with -dynlink", p)
+		}
+		source = &p.From
+	} else if p.To.Name == obj.NAME_EXTERN && !p.To.Sym.Local() {
+		source = &p.To
+	} else {
+		return
+	}
+	if p.As == obj.ATEXT || p.As == obj.AFUNCDATA || p.As == obj.ACALL || p.As == obj.ARET || p.As == obj.AJMP {
+		return
+	}
+	if source.Type != obj.TYPE_MEM {
+		ctxt.Diag("don't know how to handle %v with -dynlink", p)
+		return
+	}
+	if source == &p.To && p.From.Type == obj.TYPE_REG && p.From.Reg == REG_TMP {
+		// The GOT load below would overwrite the value to store.
+		ctxt.Diag("%v: cannot store TMP to %v with -dynlink", p, source.Sym)
+		return
+	}
+	p2 := obj.Appendp(ctxt, p)
+	p2.As = p.As
+	p2.From = p.From
+	p2.From3 = &obj.Addr{Type: obj.TYPE_NONE}
+	p2.To = p.To
+	ref := &p2.From
+	if source == &p.To {
+		ref = &p2.To
+	}
+	ref.Reg = REG_TMP
+	ref.Name = obj.NAME_NONE
+	ref.Sym = nil
+
+	p.As = AMOV
+	p.From = obj.Addr{Type: obj.TYPE_MEM, Name: obj.NAME_GOTREF, Sym: source.Sym}
+	p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
 }
 
 // follow can do some optimization on the structure of the program.  Currently,
@@ -352,7 +615,7 @@ func follow(ctxt *obj.Link, s *obj.LSym) {}
 func setpcs(p *obj.Prog, pc int64) {
 	for ; p != nil; p = p.Link {
 		p.Pc = pc
-		pc += encodingForP(p).length
+		pc += instructionLength(p)
 	}
 }
 
@@ -376,6 +639,20 @@ func InvertBranch(i obj.As) obj.As {
 	}
 }
 
+// saveRAAt appends a store of RA to off(SP) after p.
+func saveRAAt(ctxt *obj.Link, p *obj.Prog, off int64) *obj.Prog {
+	// Source register in From3, destination base register in To,
+	// destination offset in From. See MOV TYPE_REG, TYPE_MEM below
+	// for details.
+	p = obj.Appendp(ctxt, p)
+	p.As = movtos(ctxt, AMOV)
+	p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: REG_RA}
+	p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_SP}
+	p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: off}
+	p.Mark |= NO_COMPRESS
+	return p
+}
+
 // containsCall reports whether the symbol contains a CALL (or equivalent)
 // instruction. Must be called after progedit.
 func containsCall(sym *obj.LSym) bool {
@@ -394,6 +671,16 @@ func containsCall(sym *obj.LSym) bool {
 	return false
 }
 
+// addiw returns the instruction that adds a 12-bit immediate and sign
+// extends the 32-bit result, as needed after a LUI. That is ADDIW on RV64;
+// on RV32 the registers are only 32 bits wide and ADDI does the job.
+func addiw(ctxt *obj.Link) obj.As {
+	if isRV32(ctxt) {
+		return AADDI
+	}
+	return AADDIW
+}
+
 // loadImmIntoRegTmp loads the immediate (low, high), generated by Split32BitImmediate into REG_TMP.
 //
 // The following instruction sequence is generated:
@@ -409,7 +696,7 @@ func loadImmIntoRegTmp(ctxt *obj.Link, p *obj.Prog, low, high int64) *obj.Prog {
 	p.Spadj = 0 // needed if TO is SP
 	p = obj.Appendp(ctxt, p)
 
-	p.As = AADDIW
+	p.As = addiw(ctxt)
 	p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: low}
 	p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
 	p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
@@ -440,25 +727,42 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 		text.From3.Offset |= obj.NOFRAME
 		stacksize = 0
 	}
-	// We must save RA if there is a CALL.
-	saveRA := containsCall(cursym)
+	// We must save RA if there is a CALL. Leaf functions with a frame
+	// save it too, so that traceback and the DWARF unwind information
+	// can always find it at 0(SP) once the frame has been allocated.
+	saveRA := containsCall(cursym) || stacksize != 0
 	// Unless we're told not to!
 	if text.From3.Offset&obj.NOFRAME != 0 {
 		saveRA = false
 	}
 	if saveRA {
-		stacksize += 8
+		stacksize += int64(ctxt.Arch.PtrSize)
 	}
 
 	cursym.Args = text.To.Val.(int32)
 	cursym.Locals = int32(stacksize)
 
+	// Goroutines return to goexit+PCQuantum, which the runtime turns
+	// into the address of goexit's second instruction by assuming that
+	// the first one is 4 bytes long (see runtime.gostartcall).
+	if cursym.Name == "runtime.goexit" && text.Link != nil {
+		text.Link.Mark |= NO_COMPRESS
+	}
+
 	prologue := text
 
 	if text.From3.Offset&obj.NOSPLIT == 0 {
 		prologue = stacksplit(ctxt, prologue, stacksize) // emit split check
 	}
 
+	// Actually save RA. If the offset allows, store it below SP before
+	// allocating the frame, so that no instruction is reached with the
+	// frame allocated but RA not yet in it.
+	saveRABelow := saveRA && immFits(-stacksize, 12)
+	if saveRABelow {
+		prologue = saveRAAt(ctxt, prologue, -stacksize)
+	}
+
 	// Insert stack adjustment if necessary.
 	if stacksize != 0 {
 		prologue = obj.Appendp(ctxt, prologue)
@@ -469,18 +773,11 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 		prologue.To.Type = obj.TYPE_REG
 		prologue.To.Reg = REG_SP
 		prologue.Spadj = int32(stacksize)
+		prologue.Mark |= NO_COMPRESS // see runtime.systemstack_switch
 	}
 
-	// Actually save RA.
-	if saveRA {
-		// Source register in From3, destination base register in To,
-		// destination offset in From. See MOV TYPE_REG, TYPE_MEM below
-		// for details.
-		prologue = obj.Appendp(ctxt, prologue)
-		prologue.As = ASD
-		prologue.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: REG_RA}
-		prologue.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_SP}
-		prologue.From = obj.Addr{Type: obj.TYPE_CONST, Offset: 0}
+	if saveRA && !saveRABelow {
+		prologue = saveRAAt(ctxt, prologue, 0)
 	}
 
 	if cursym.Text.From3.Offset&obj.WRAPPER != 0 {
@@ -565,11 +862,11 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 
 	// Update stack-based offsets.
 	for p := cursym.Text; p != nil; p = p.Link {
-		stackOffset(&p.From, stacksize)
+		stackOffset(ctxt, &p.From, stacksize)
 		if p.From3 != nil {
-			stackOffset(p.From3, stacksize)
+			stackOffset(ctxt, p.From3, stacksize)
 		}
-		stackOffset(&p.To, stacksize)
+		stackOffset(ctxt, &p.To, stacksize)
 
 		// TODO: update stacksize when instructions that modify SP are
 		// found, or disallow it entirely.
@@ -590,9 +887,9 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 				switch p.From.Name {
 				case obj.NAME_AUTO, obj.NAME_PARAM, obj.NAME_NONE:
 					if p.To.Type != obj.TYPE_REG {
-						ctxt.Diag("progedit: unsupported load at %v", p)
+						badInst(ctxt, p, "progedit: unsupported load at %v", p)
 					}
-					p.As = movtol(p.As)
+					p.As = movtol(ctxt, p.As)
 					p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: addrtoreg(p.From)}
 					p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: p.From.Offset}
 				case obj.NAME_EXTERN, obj.NAME_STATIC:
@@ -601,22 +898,49 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 					as := p.As
 					to := p.To
 
+					// Floating point loads need an integer
+					// register to hold the address.
+					addr := to.Reg
+					if as == AMOVF || as == AMOVD {
+						addr = REG_TMP
+					}
+
 					p.As = AAUIPC
 					// This offset isn't really encoded
 					// with either instruction. It will be
 					// extracted for a relocation later.
 					p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: p.From.Offset, Sym: p.From.Sym}
 					p.From3 = &obj.Addr{}
-					p.To = obj.Addr{Type: obj.TYPE_REG, Reg: to.Reg}
+					p.To = obj.Addr{Type: obj.TYPE_REG, Reg: addr}
 					p.Mark |= NEED_PCREL_ITYPE_RELOC
 					p = obj.Appendp(ctxt, p)
 
-					p.As = movtol(as)
+					p.As = movtol(ctxt, as)
+					p.From = obj.Addr{Type: obj.TYPE_CONST}
+					p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: addr}
+					p.To = to
+				case obj.NAME_GOTREF:
+					// AUIPC $off_hi, R
+					// LD $off_lo, R, R
+					if p.As != AMOV || p.To.Type != obj.TYPE_REG {
+						badInst(ctxt, p, "progedit: unsupported GOT load at %v", p)
+						break
+					}
+					to := p.To
+
+					p.As = AAUIPC
+					p.From = obj.Addr{Type: obj.TYPE_CONST, Sym: p.From.Sym}
+					p.From3 = &obj.Addr{}
+					p.To = to
+					p.Mark |= NEED_GOT_PCREL_ITYPE_RELOC
+					p = obj.Appendp(ctxt, p)
+
+					p.As = movtol(ctxt, AMOV)
 					p.From = obj.Addr{Type: obj.TYPE_CONST}
 					p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: to.Reg}
 					p.To = to
 				default:
-					ctxt.Diag("progedit: unsupported name %d for %v", p.From.Name, p)
+					badInst(ctxt, p, "progedit: unsupported name %d for %v", p.From.Name, p)
 				}
 			case obj.TYPE_REG:
 				switch p.To.Type {
@@ -633,16 +957,16 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 						p.As = AFSGNJD
 						*p.From3 = p.From
 					default:
-						ctxt.Diag("progedit: unsupported register-register move at %v", p)
+						badInst(ctxt, p, "progedit: unsupported register-register move at %v", p)
 					}
 				case obj.TYPE_MEM: // MOV Rs, c(Rd) -> S $c, Rs, Rd
-					switch p.As {
-					case AMOVBU, AMOVHU, AMOVWU:
-						ctxt.Diag("progedit: unsupported unsigned store at %v", p)
+					if p.As == AMOVBU || p.As == AMOVHU || p.As == AMOVWU {
+						badInst(ctxt, p, "progedit: unsupported unsigned store at %v", p)
+						break
 					}
 					switch p.To.Name {
 					case obj.NAME_AUTO, obj.NAME_PARAM, obj.NAME_NONE:
-						p.As = movtos(p.As)
+						p.As = movtos(ctxt, p.As)
 						// The destination address goes in p.From and
 						// p.To here, with the offset in p.From and the
 						// register in p.To. The source register goes in
@@ -651,7 +975,7 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 						p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: p.From.Offset}
 						p.From3.Type = obj.TYPE_REG
 						p.To = obj.Addr{Type: obj.TYPE_REG, Reg: addrtoreg(p.To)}
-					case obj.NAME_EXTERN:
+					case obj.NAME_EXTERN, obj.NAME_STATIC:
 						// AUIPC $off_hi, TMP
 						// S $off_lo, TMP, R
 						as := p.As
@@ -667,58 +991,85 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 						p.Mark |= NEED_PCREL_STYPE_RELOC
 						p = obj.Appendp(ctxt, p)
 
-						p.As = movtos(as)
+						p.As = movtos(ctxt, as)
 						p.From = obj.Addr{Type: obj.TYPE_CONST}
 						p.From3 = &from
 						p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
 					default:
-						ctxt.Diag("progedit: unsupported name %d for %v", p.From.Name, p)
+						badInst(ctxt, p, "progedit: unsupported name %d for %v", p.From.Name, p)
 					}
 				default:
-					ctxt.Diag("progedit: unsupported MOV at %v", p)
+					badInst(ctxt, p, "progedit: unsupported MOV at %v", p)
 				}
 			case obj.TYPE_CONST:
 				// MOV $c, R
-				// If c is small enough, convert to:
-				//   ADD $c, ZERO, R
-				// If not, convert to:
+				// If c is short enough to build with immSequence,
+				// convert to that sequence. For a 32-bit c it is:
 				//   LUI top20bits(c), R
 				//   ADD bottom12bits(c), R, R
+				// Otherwise load c from the literal pool:
+				//   AUIPC $off_hi, R
+				//   LD $off_lo, R, R
 				if p.As != AMOV {
-					ctxt.Diag("progedit: unsupported constant load at %v", p)
+					badInst(ctxt, p, "progedit: unsupported constant load at %v", p)
+					break
 				}
 				off := p.From.Offset
 				to := p.To
-
-				low, high, err := Split32BitImmediate(off)
-				if err != nil {
-					// TODO: use a constant pool for 64 bit constants?
-					//
-					// Or remove REG_TMP from the general purposes registers used by the compiler
-					// and emulate riscv.rules, using REG_TMP as the 32 bit value staging ground?
-					ctxt.Diag("%v: constant %d too large; see riscv.rules MOVQconst for how to make a 64 bit constant: %v", p, off, err)
+				if isRV32(ctxt) {
+					if uint64(off)>>32 == 0 {
+						// Registers are 32 bits wide, so an
+						// unsigned 32-bit constant is the same
+						// as its signed counterpart.
+						off = int64(int32(off))
+					}
+					if !immFits(off, 32) {
+						badInst(ctxt, p, "progedit: constant %d does not fit in a 32-bit register: %v", off, p)
+						break
+					}
 				}
 
-				// LUI is only necessary if the offset doesn't fit in 12-bits.
-				needLUI := high != 0
-				if needLUI {
-					p.As = ALUI
+				seq := immSequence(ctxt, off)
+				if len(seq) > maxImmSequence {
+					literal := fmt.Sprintf("$i64.%016x", uint64(off))
+					s := obj.Linklookup(ctxt, literal, 0)
+					s.Size = 8
+
+					p.As = AAUIPC
+					p.From = obj.Addr{Type: obj.TYPE_CONST, Sym: s}
+					p.From3 = &obj.Addr{}
 					p.To = to
-					// Pass top 20 bits to LUI.
-					p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: high}
+					p.Mark |= NEED_PCREL_ITYPE_RELOC
 					p = obj.Appendp(ctxt, p)
+
+					p.As = ALD
+					p.From = obj.Addr{Type: obj.TYPE_CONST}
+					p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: to.Reg}
+					p.To = to
+					break
 				}
-				p.As = AADDIW
-				p.To = to
-				p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: low}
-				p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: REG_ZERO}
-				if needLUI {
-					p.From3.Reg = to.Reg
+
+				for i, op := range seq {
+					if i > 0 {
+						p = obj.Appendp(ctxt, p)
+					}
+					p.As = op.as
+					p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: op.imm}
+					// The first instruction builds on ZERO,
+					// the rest on the partial result.
+					p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: to.Reg}
+					if i == 0 {
+						p.From3.Reg = REG_ZERO
+					}
+					if op.as == ALUI {
+						p.From3 = &obj.Addr{}
+					}
+					p.To = to
 				}
 
 			case obj.TYPE_ADDR: // MOV $sym+off(SP/SB), R
 				if p.To.Type != obj.TYPE_REG || p.As != AMOV {
-					ctxt.Diag("progedit: unsupported addr MOV at %v", p)
+					badInst(ctxt, p, "progedit: unsupported addr MOV at %v", p)
 				}
 				switch p.From.Name {
 				case obj.NAME_EXTERN, obj.NAME_STATIC:
@@ -752,10 +1103,10 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 					p.From.Type = obj.TYPE_CONST
 					p.From.Reg = 0
 				default:
-					ctxt.Diag("progedit: bad addr MOV from name %v at %v", p.From.Name, p)
+					badInst(ctxt, p, "progedit: bad addr MOV from name %v at %v", p.From.Name, p)
 				}
 			default:
-				ctxt.Diag("progedit: unsupported MOV at %v", p)
+				badInst(ctxt, p, "progedit: unsupported MOV at %v", p)
 			}
 
 		case obj.ACALL:
@@ -768,7 +1119,7 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 			switch p.To.Type {
 			case obj.TYPE_MEM:
 				switch p.To.Name {
-				case obj.NAME_EXTERN:
+				case obj.NAME_EXTERN, obj.NAME_STATIC:
 					// JMP to symbol.
 					jalrToSym(ctxt, p, REG_ZERO)
 				}
@@ -778,7 +1129,7 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 		case obj.ARET:
 			if saveRA {
 				// Restore RA.
-				p.As = ALD
+				p.As = movtol(ctxt, AMOV)
 				p.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: REG_SP}
 				p.From = obj.Addr{Type: obj.TYPE_CONST, Offset: 0}
 				p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_RA}
@@ -813,7 +1164,7 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 		// Replace FNE[SD] with FEQ[SD] and NOT.
 		case AFNES:
 			if p.To.Type != obj.TYPE_REG {
-				ctxt.Diag("progedit: FNES needs an integer register output")
+				badInst(ctxt, p, "progedit: FNES needs an integer register output at %v", p)
 			}
 			dst := p.To.Reg
 			p.As = AFEQS
@@ -826,7 +1177,7 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 			p.To.Reg = dst
 		case AFNED:
 			if p.To.Type != obj.TYPE_REG {
-				ctxt.Diag("progedit: FNED needs an integer register output")
+				badInst(ctxt, p, "progedit: FNED needs an integer register output at %v", p)
 			}
 			dst := p.To.Reg
 			p.As = AFEQD
@@ -922,18 +1273,33 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 		}
 	}
 
+	// The instruction following an AUIPC is patched together with it, by
+	// the linker or below, so it must not be compressed.
+	for p := cursym.Text; p != nil; p = p.Link {
+		if p.As == AAUIPC && p.Link != nil {
+			p.Link.Mark |= NO_COMPRESS
+		}
+	}
+
 	// Compute instruction addresses.  Once we do that, we need to check for
 	// overextended jumps and branches.  Within each iteration, Pc differences
 	// are always lower bounds (since the program gets monotonically longer,
 	// a fixed point will be reached).  No attempt to handle functions > 2GiB.
+	//
+	// With compressed instructions enabled, eligible branches and jumps
+	// start out in their short forms and are expanded to 32 bits once
+	// their targets move out of range.  This too only makes the program
+	// longer.
 	for {
 		rescan := false
 		setpcs(cursym.Text, 0)
 		for p := cursym.Text; p != nil; p = p.Link {
 			switch p.As {
 			case ABEQ, ABNE, ABLT, ABGE, ABLTU, ABGEU:
-				if p.To.Type != obj.TYPE_BRANCH {
-					panic("assemble: instruction with branch-like opcode lacks destination")
+				if p.To.Type != obj.TYPE_BRANCH || p.Pcond == nil {
+					badInst(ctxt, p, "%v\tbranch needs a label as its destination", p)
+					p.To = obj.Addr{Type: obj.TYPE_BRANCH}
+					p.Pcond = p
 				}
 				offset := p.Pcond.Pc - p.Pc
 				if offset < -4096 || 4096 <= offset {
@@ -949,16 +1315,23 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 					// We may have made previous branches too long,
 					// so recheck them.
 					rescan = true
+				} else if compressible(p) && !cBranchInRange(p, offset) {
+					p.Mark |= NO_COMPRESS
+					rescan = true
 				}
 			case AJAL:
 				if p.Pcond == nil {
-					panic("intersymbol jumps should be expressed as AUIPC+JALR")
+					// Intersymbol jumps are expressed as AUIPC+JALR.
+					badInst(ctxt, p, "%v\tjump needs a label as its destination", p)
+					p.To = obj.Addr{Type: obj.TYPE_BRANCH}
+					p.Pcond = p
 				}
 				offset := p.Pcond.Pc - p.Pc
 				if offset < -(1<<20) || (1<<20) <= offset {
 					// Replace with 2-instruction sequence
 					jmp := obj.Appendp(ctxt, p)
 					jmp.As = AJALR
+					jmp.Mark |= NO_COMPRESS
 					jmp.From = obj.Addr{Type: obj.TYPE_CONST, Offset: 0}
 					jmp.To = p.From
 					jmp.From3 = &obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
@@ -969,6 +1342,9 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 					p.From3 = &obj.Addr{}
 					p.To = obj.Addr{Type: obj.TYPE_REG, Reg: REG_TMP}
 
+					rescan = true
+				} else if compressible(p) && !cBranchInRange(p, offset) {
+					p.Mark |= NO_COMPRESS
 					rescan = true
 				}
 			}
@@ -989,8 +1365,8 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 			case obj.TYPE_BRANCH:
 				p.To.Type = obj.TYPE_CONST
 				p.To.Offset = p.Pcond.Pc - p.Pc
-			case obj.TYPE_MEM:
-				panic("unhandled type")
+			default:
+				badInst(ctxt, p, "%v\tunsupported destination type %v", p, p.To.Type)
 			}
 		case AAUIPC:
 			if p.From.Type == obj.TYPE_BRANCH {
@@ -1006,7 +1382,64 @@ func preprocess(ctxt *obj.Link, cursym *obj.LSym) {
 
 	// Validate all instructions. This provides nice error messages.
 	for p := cursym.Text; p != nil; p = p.Link {
-		encodingForP(p).validate(p)
+		validateP(ctxt, p)
+	}
+}
+
+// rv64Only contains the instructions that exist only on RV64.
+var rv64Only = map[obj.As]bool{
+	ALD: true, ASD: true, ALWU: true,
+	AADDIW: true, ASLLIW: true, ASRLIW: true, ASRAIW: true,
+	AADDW: true, ASUBW: true, ASLLW: true, ASRLW: true, ASRAW: true,
+	AMULW: true, ADIVW: true, ADIVUW: true, AREMW: true, AREMUW: true,
+	ALRD: true, ASCD: true, AAMOSWAPD: true, AAMOADDD: true, AAMOANDD: true,
+	AAMOORD: true, AAMOXORD: true, AAMOMAXD: true, AAMOMAXUD: true,
+	AAMOMIND: true, AAMOMINUD: true,
+	AFCVTLS: true, AFCVTLUS: true, AFCVTSL: true, AFCVTSLU: true,
+	AFCVTLD: true, AFCVTLUD: true, AFCVTDL: true, AFCVTDLU: true,
+	AFMVXD: true, AFMVDX: true,
+	AADDUW: true, ASH1ADDUW: true, ASH2ADDUW: true, ASH3ADDUW: true,
+	ASLLIUW: true, ACLZW: true, ACTZW: true, ACPOPW: true, AROLW: true,
+	ARORW: true, ARORIW: true,
+	ASHA512SIG0: true, ASHA512SIG1: true, ASHA512SUM0: true, ASHA512SUM1: true,
+}
+
+// rv32Only contains the instructions that exist only on RV32.
+var rv32Only = map[obj.As]bool{
+	ARDCYCLEH: true, ARDTIMEH: true, ARDINSTRETH: true,
+	AZEXTHRV32: true, AREV8RV32: true,
+}
+
+// validateRV32 checks that p can be encoded on RV32.
+func validateRV32(p *obj.Prog) {
+	if rv64Only[p.As] {
+		p.Ctxt.Diag("%v	instruction not available on RV32", p)
+	}
+}
+
+// validateRV64 checks that p can be encoded on RV64.
+func validateRV64(p *obj.Prog) {
+	if rv32Only[p.As] {
+		p.Ctxt.Diag("%v	instruction not available on RV64", p)
+	}
+}
+
+// validateShift checks the shift amount of an immediate shift. The
+// amount shares the immediate field with the bits that distinguish
+// SRLI from SRAI, so an out of range amount would silently assemble
+// to a different instruction.
+func validateShift(p *obj.Prog) {
+	var max int64
+	switch p.As {
+	case ASLLI, ASRLI, ASRAI, ASLLIUW, ARORI, ABCLRI, ABEXTI, ABINVI, ABSETI:
+		max = int64(p.Ctxt.Arch.RegSize*8 - 1)
+	case ASLLIW, ASRLIW, ASRAIW, ARORIW:
+		max = 31
+	default:
+		return
+	}
+	if p.From.Type == obj.TYPE_CONST && (p.From.Offset < 0 || p.From.Offset > max) {
+		p.Ctxt.Diag("%v	shift amount out of range 0 to %d", p, max)
 	}
 }
 
@@ -1206,25 +1639,83 @@ func Split32BitImmediate(imm int64) (low, high int64, err error) {
 	return
 }
 
-func regval(r int16, min int16, max int16) uint32 {
+// maxImmSequence is the longest instruction sequence that preprocess uses
+// to build a constant in a register. Longer constants are loaded from the
+// literal pool instead, which takes two instructions and a memory access.
+const maxImmSequence = 3
+
+// An immOp is one step of building a constant in a register: an
+// instruction and its immediate operand.
+type immOp struct {
+	as  obj.As
+	imm int64
+}
+
+// immSequence returns the instructions that build the constant c in a
+// register. The first instruction starts from ZERO and each of the others
+// updates the register in place.
+//
+// A 32-bit constant takes a LUI and an ADDIW (ADDI on RV32), or just
+// one of them. A wider constant is split into its low 12 bits, added
+// last with ADDI, and the bits above them, which are built recursively
+// and shifted into place with SLLI. Trailing zeros in the upper part are
+// folded into the shift, so constants such as 1<<40 stay short.
+func immSequence(ctxt *obj.Link, c int64) []immOp {
+	if low, high, err := Split32BitImmediate(c); err == nil {
+		if high == 0 {
+			return []immOp{{addiw(ctxt), low}}
+		}
+		if low == 0 {
+			// LUI already sign extends its result.
+			return []immOp{{ALUI, high}}
+		}
+		return []immOp{{ALUI, high}, {addiw(ctxt), low}}
+	}
+
+	// As in Split32BitImmediate, the low 12 bits will be treated as
+	// signed, so borrow from the upper part if they are negative.
+	// The arithmetic wraps, but so do SLLI and ADDI.
+	low := signExtend(c, 12)
+	high := (c - low) >> 12
+	shift := int64(12)
+	for high&1 == 0 {
+		high >>= 1
+		shift++
+	}
+
+	seq := append(immSequence(ctxt, high), immOp{ASLLI, shift})
+	if low != 0 {
+		seq = append(seq, immOp{AADDI, low})
+	}
+	return seq
+}
+
+// The operand extraction functions below report malformed operands with
+// ctxt.Diag and return 0 so that assembly can continue and find further
+// errors. validate should already have rejected such operands, so these
+// diagnostics only appear for Progs generated by the assembler itself.
+
+func regval(p *obj.Prog, r int16, min int16, max int16) uint32 {
 	if r < min || max < r {
-		panic(fmt.Sprintf("register out of range, want %d < %d < %d", min, r, max))
+		p.Ctxt.Diag("%v	register %s out of range %s to %s", p, obj.Rconv(int(r)), obj.Rconv(int(min)), obj.Rconv(int(max)))
+		return 0
 	}
 	return uint32(r - min)
 }
 
-func reg(a obj.Addr, min int16, max int16) uint32 {
+func reg(p *obj.Prog, a obj.Addr, min int16, max int16) uint32 {
 	if a.Type != obj.TYPE_REG {
-		panic(fmt.Sprintf("ill typed: %+v", a))
+		p.Ctxt.Diag("%v	expected register but got %s", p, p.Ctxt.Dconv(&a))
+		return 0
 	}
-	return regval(a.Reg, min, max)
+	return regval(p, a.Reg, min, max)
 }
 
 // regi extracts the integer register from an Addr.
-func regi(a obj.Addr) uint32 { return reg(a, REG_X0, REG_X31) }
+func regi(p *obj.Prog, a obj.Addr) uint32 { return reg(p, a, REG_X0, REG_X31) }
 
 // regf extracts the float register from an Addr.
-func regf(a obj.Addr) uint32 { return reg(a, REG_F0, REG_F31) }
+func regf(p *obj.Prog, a obj.Addr) uint32 { return reg(p, a, REG_F0, REG_F31) }
 
 func wantReg(p *obj.Prog, pos string, a *obj.Addr, descr string, min int16, max int16) {
 	if a == nil {
@@ -1262,16 +1753,28 @@ func immFits(x int64, nbits uint) bool {
 }
 
 // immi extracts the integer literal of the specified size from an Addr.
-func immi(a obj.Addr, nbits uint) uint32 {
+func immi(p *obj.Prog, a obj.Addr, nbits uint) uint32 {
 	if a.Type != obj.TYPE_CONST {
-		panic(fmt.Sprintf("ill typed: %+v", a))
+		p.Ctxt.Diag("%v	expected immediate but got %s", p, p.Ctxt.Dconv(&a))
+		return 0
 	}
 	if !immFits(a.Offset, nbits) {
-		panic(fmt.Sprintf("immediate %d in %v cannot fit in %d bits", a.Offset, a, nbits))
+		p.Ctxt.Diag("%v	immediate %d cannot fit in %d bits", p, a.Offset, nbits)
+		return 0
 	}
 	return uint32(a.Offset)
 }
 
+// instFor returns the opcode fields of the instruction p.
+func instFor(p *obj.Prog) *inst {
+	i, ok := encode(p.As)
+	if !ok {
+		p.Ctxt.Diag("%v	could not encode instruction", p)
+		return &inst{}
+	}
+	return i
+}
+
 func wantImm(p *obj.Prog, pos string, a obj.Addr, nbits uint) {
 	if a.Type != obj.TYPE_CONST {
 		p.Ctxt.Diag("%v\texpected immediate in %s position but got %s", p, pos, p.Ctxt.Dconv(&a))
@@ -1283,7 +1786,7 @@ func wantImm(p *obj.Prog, pos string, a obj.Addr, nbits uint) {
 }
 
 func wantEvenJumpOffset(p *obj.Prog) {
-	if p.To.Offset%1 != 0 {
+	if p.To.Offset%2 != 0 {
 		p.Ctxt.Diag("%v\tjump offset %v must be even", p, p.Ctxt.Dconv(&p.To))
 	}
 }
@@ -1294,12 +1797,24 @@ func validateRIII(p *obj.Prog) {
 	wantIntReg(p, "to", &p.To)
 }
 
+func validateRII(p *obj.Prog) {
+	wantIntReg(p, "from", &p.From)
+	wantIntReg(p, "to", &p.To)
+}
+
 func validateRFFF(p *obj.Prog) {
 	wantFloatReg(p, "from", &p.From)
 	wantFloatReg(p, "from3", p.From3)
 	wantFloatReg(p, "to", &p.To)
 }
 
+func validateR4(p *obj.Prog) {
+	wantFloatReg(p, "from", &p.From)
+	wantFloatReg(p, "reg", regAddr(p.Reg))
+	wantFloatReg(p, "from3", p.From3)
+	wantFloatReg(p, "to", &p.To)
+}
+
 func validateRFFI(p *obj.Prog) {
 	wantFloatReg(p, "from", &p.From)
 	wantFloatReg(p, "from3", p.From3)
@@ -1322,41 +1837,61 @@ func validateRFF(p *obj.Prog) {
 }
 
 func encodeR(p *obj.Prog, rs1 uint32, rs2 uint32, rd uint32) uint32 {
-	i, ok := encode(p.As)
-	if !ok {
-		panic("encodeR: could not encode instruction")
-	}
+	i := instFor(p)
 	if i.rs2 != 0 && rs2 != 0 {
-		panic("encodeR: instruction uses rs2, but rs2 was nonzero")
+		p.Ctxt.Diag("%v	instruction uses rs2, but rs2 was nonzero", p)
 	}
 
 	// Using Scond for the floating-point rounding mode override
 	// TODO(sorear) is there a more appropriate way to handle opcode extension bits like this?
-	return i.funct7<<25 | i.rs2<<20 | rs2<<20 | rs1<<15 | i.funct3<<12 | uint32(p.Scond)<<12 | rd<<7 | i.opcode
+	funct3 := i.funct3
+	if p.Scond&RM_SET != 0 {
+		funct3 = uint32(p.Scond &^ RM_SET)
+	}
+	return i.funct7<<25 | i.rs2<<20 | rs2<<20 | rs1<<15 | funct3<<12 | rd<<7 | i.opcode
+}
+
+// encodeR4 encodes a fused multiply-add, which computes
+// From3 * Reg + From.
+func encodeR4(p *obj.Prog) uint32 {
+	rs2 := regval(p, p.Reg, REG_F0, REG_F31)
+	return regf(p, p.From)<<27 | encodeR(p, regf(p, *p.From3), rs2, regf(p, p.To))
 }
 
 func encodeRIII(p *obj.Prog) uint32 {
-	return encodeR(p, regi(*p.From3), regi(p.From), regi(p.To))
+	return encodeR(p, regi(p, *p.From3), regi(p, p.From), regi(p, p.To))
+}
+
+// encodeAMO encodes an LR, SC or AMO instruction. These are always
+// emitted with both the aq and rl bits set, which makes them
+// sequentially consistent as the Go memory model requires.
+func encodeAMO(p *obj.Prog) uint32 {
+	const aqrl = 3 << 25
+	return encodeRIII(p) | aqrl
 }
 
 func encodeRFFF(p *obj.Prog) uint32 {
-	return encodeR(p, regf(*p.From3), regf(p.From), regf(p.To))
+	return encodeR(p, regf(p, *p.From3), regf(p, p.From), regf(p, p.To))
 }
 
 func encodeRFFI(p *obj.Prog) uint32 {
-	return encodeR(p, regf(*p.From3), regf(p.From), regi(p.To))
+	return encodeR(p, regf(p, *p.From3), regf(p, p.From), regi(p, p.To))
 }
 
 func encodeRFI(p *obj.Prog) uint32 {
-	return encodeR(p, regf(p.From), 0, regi(p.To))
+	return encodeR(p, regf(p, p.From), 0, regi(p, p.To))
+}
+
+func encodeRII(p *obj.Prog) uint32 {
+	return encodeR(p, regi(p, p.From), 0, regi(p, p.To))
 }
 
 func encodeRIF(p *obj.Prog) uint32 {
-	return encodeR(p, regi(p.From), 0, regf(p.To))
+	return encodeR(p, regi(p, p.From), 0, regf(p, p.To))
 }
 
 func encodeRFF(p *obj.Prog) uint32 {
-	return encodeR(p, regf(p.From), 0, regf(p.To))
+	return encodeR(p, regf(p, p.From), 0, regf(p, p.To))
 }
 
 func validateII(p *obj.Prog) {
@@ -1372,22 +1907,19 @@ func validateIF(p *obj.Prog) {
 }
 
 func encodeI(p *obj.Prog, rd uint32) uint32 {
-	imm := immi(p.From, 12)
-	rs1 := regi(*p.From3)
-	i, ok := encode(p.As)
-	if !ok {
-		panic("encodeI: could not encode instruction")
-	}
+	imm := immi(p, p.From, 12)
+	rs1 := regi(p, *p.From3)
+	i := instFor(p)
 	imm |= uint32(i.csr)
 	return imm<<20 | rs1<<15 | i.funct3<<12 | rd<<7 | i.opcode
 }
 
 func encodeII(p *obj.Prog) uint32 {
-	return encodeI(p, regi(p.To))
+	return encodeI(p, regi(p, p.To))
 }
 
 func encodeIF(p *obj.Prog) uint32 {
-	return encodeI(p, regf(p.To))
+	return encodeI(p, regf(p, p.To))
 }
 
 func validateSI(p *obj.Prog) {
@@ -1411,12 +1943,9 @@ func EncodeSImmediate(imm int64) (int64, error) {
 }
 
 func encodeS(p *obj.Prog, rs2 uint32) uint32 {
-	imm := immi(p.From, 12)
-	rs1 := regi(p.To)
-	i, ok := encode(p.As)
-	if !ok {
-		panic("encodeS: could not encode instruction")
-	}
+	imm := immi(p, p.From, 12)
+	rs1 := regi(p, p.To)
+	i := instFor(p)
 	return (imm>>5)<<25 |
 		rs2<<20 |
 		rs1<<15 |
@@ -1426,11 +1955,11 @@ func encodeS(p *obj.Prog, rs2 uint32) uint32 {
 }
 
 func encodeSI(p *obj.Prog) uint32 {
-	return encodeS(p, regi(*p.From3))
+	return encodeS(p, regi(p, *p.From3))
 }
 
 func encodeSF(p *obj.Prog) uint32 {
-	return encodeS(p, regf(*p.From3))
+	return encodeS(p, regf(p, *p.From3))
 }
 
 func validateSB(p *obj.Prog) {
@@ -1438,18 +1967,23 @@ func validateSB(p *obj.Prog) {
 	// We implicitly drop the least significant bit in encodeSB.
 	wantEvenJumpOffset(p)
 	wantImm(p, "to", p.To, 13)
-	// TODO: validate that the register from p.Reg is in range
 	wantIntReg(p, "from", &p.From)
+	wantIntReg(p, "reg", regAddr(p.Reg))
 }
 
-func encodeSB(p *obj.Prog) uint32 {
-	imm := immi(p.To, 13)
-	rs2 := regval(p.Reg, REG_X0, REG_X31)
-	rs1 := regi(p.From)
-	i, ok := encode(p.As)
-	if !ok {
-		panic("encodeSB: could not encode instruction")
+// regAddr returns an Addr for the register r, or nil if r is not set.
+func regAddr(r int16) *obj.Addr {
+	if r == obj.REG_NONE {
+		return nil
 	}
+	return &obj.Addr{Type: obj.TYPE_REG, Reg: r}
+}
+
+func encodeSB(p *obj.Prog) uint32 {
+	imm := immi(p, p.To, 13)
+	rs2 := regval(p, p.Reg, REG_X0, REG_X31)
+	rs1 := regi(p, p.From)
+	i := instFor(p)
 	return (imm>>12)<<31 |
 		((imm>>5)&0x3f)<<25 |
 		rs2<<20 |
@@ -1461,7 +1995,7 @@ func encodeSB(p *obj.Prog) uint32 {
 }
 
 func validateU(p *obj.Prog) {
-	if p.As == AAUIPC && p.Mark&(NEED_PCREL_ITYPE_RELOC|NEED_PCREL_STYPE_RELOC) != 0 {
+	if p.As == AAUIPC && p.Mark&(NEED_PCREL_ITYPE_RELOC|NEED_PCREL_STYPE_RELOC|NEED_GOT_PCREL_ITYPE_RELOC) != 0 {
 		// TODO(sorear): Hack.  The Offset is being used here to temporarily
 		// store the relocation addend, not as an actual offset to assemble,
 		// so it's OK for it to be out of range.  Is there a more valid way
@@ -1477,12 +2011,9 @@ func encodeU(p *obj.Prog) uint32 {
 	// Rather than have the user/compiler generate a 32 bit constant,
 	// the bottommost bits of which must all be zero,
 	// instead accept just the top bits.
-	imm := immi(p.From, 20)
-	rd := regi(p.To)
-	i, ok := encode(p.As)
-	if !ok {
-		panic("encodeU: could not encode instruction")
-	}
+	imm := immi(p, p.From, 20)
+	rd := regi(p, p.To)
+	i := instFor(p)
 	return imm<<12 | rd<<7 | i.opcode
 }
 
@@ -1527,39 +2058,28 @@ func EncodeUJImmediate(imm int64) (uint32, error) {
 }
 
 func encodeUJ(p *obj.Prog) uint32 {
-	imm := encodeUJImmediate(immi(p.To, 21))
-	rd := regi(p.From)
-	i, ok := encode(p.As)
-	if !ok {
-		panic("encodeUJ: could not encode instruction")
-	}
+	imm := encodeUJImmediate(immi(p, p.To, 21))
+	rd := regi(p, p.From)
+	i := instFor(p)
 	return imm | rd<<7 | i.opcode
 }
 
 func validateRaw(p *obj.Prog) {
-	// Treat the raw value specially as a 32-bit unsigned integer. Nobody
-	// wants to enter negative machine code.
+	// Accept any value that fits in 32 bits, signed or unsigned, as
+	// the other architectures' WORD does.
 	a := p.From
 	if a.Type != obj.TYPE_CONST {
 		p.Ctxt.Diag("%v\texpected immediate in raw position but got %s", p, p.Ctxt.Dconv(&a))
 		return
 	}
-	if a.Offset < 0 || 1<<32 <= a.Offset {
+	if a.Offset < -1<<31 || 1<<32 <= a.Offset {
 		p.Ctxt.Diag("%v\timmediate in raw position cannot be larger than 32 bits but got %d", p, a.Offset)
 	}
 }
 
 func encodeRaw(p *obj.Prog) uint32 {
-	// Treat the raw value specially as a 32-bit unsigned integer. Nobody
-	// wants to enter negative machine code.
-	a := p.From
-	if a.Type != obj.TYPE_CONST {
-		panic(fmt.Sprintf("ill typed: %+v", a))
-	}
-	if a.Offset < 0 || 1<<32 <= a.Offset {
-		panic(fmt.Sprintf("immediate %d in %v cannot fit in 32 bits", a.Offset, a))
-	}
-	return uint32(a.Offset)
+	// validateRaw has checked that the value fits in 32 bits.
+	return uint32(p.From.Offset)
 }
 
 type encoding struct {
@@ -1579,11 +2099,13 @@ var (
 	// indicates an S-type instruction with rs2 being a float register.
 
 	rIIIEncoding = encoding{encode: encodeRIII, validate: validateRIII, length: 4}
+	rIIEncoding  = encoding{encode: encodeRII, validate: validateRII, length: 4}
 	rFFFEncoding = encoding{encode: encodeRFFF, validate: validateRFFF, length: 4}
 	rFFIEncoding = encoding{encode: encodeRFFI, validate: validateRFFI, length: 4}
 	rFIEncoding  = encoding{encode: encodeRFI, validate: validateRFI, length: 4}
 	rIFEncoding  = encoding{encode: encodeRIF, validate: validateRIF, length: 4}
 	rFFEncoding  = encoding{encode: encodeRFF, validate: validateRFF, length: 4}
+	r4Encoding   = encoding{encode: encodeR4, validate: validateR4, length: 4}
 
 	iIEncoding = encoding{encode: encodeII, validate: validateII, length: 4}
 	iFEncoding = encoding{encode: encodeIF, validate: validateIF, length: 4}
@@ -1599,6 +2121,10 @@ var (
 
 	rawEncoding = encoding{encode: encodeRaw, validate: validateRaw, length: 4}
 
+	// amoEncoding is used for LR, SC and the AMOs, which are R-type
+	// instructions with the aq and rl bits set.
+	amoEncoding = encoding{encode: encodeAMO, validate: validateRIII, length: 4}
+
 	// pseudoOpEncoding panics if encoding is attempted, but does no validation.
 	pseudoOpEncoding = encoding{encode: nil, validate: func(*obj.Prog) {}, length: 0}
 
@@ -1629,6 +2155,9 @@ var encodingForAs = [...]encoding{
 	// 4.2: Integer Computational Instructions
 	AADDI & obj.AMask:  iIEncoding,
 	AADDIW & obj.AMask: iIEncoding,
+	ASLLIW & obj.AMask: iIEncoding,
+	ASRLIW & obj.AMask: iIEncoding,
+	ASRAIW & obj.AMask: iIEncoding,
 	ASLTI & obj.AMask:  iIEncoding,
 	ASLTIU & obj.AMask: iIEncoding,
 	AANDI & obj.AMask:  iIEncoding,
@@ -1649,6 +2178,11 @@ var encodingForAs = [...]encoding{
 	ASRL & obj.AMask:   rIIIEncoding,
 	ASUB & obj.AMask:   rIIIEncoding,
 	ASRA & obj.AMask:   rIIIEncoding,
+	AADDW & obj.AMask:  rIIIEncoding,
+	ASUBW & obj.AMask:  rIIIEncoding,
+	ASLLW & obj.AMask:  rIIIEncoding,
+	ASRLW & obj.AMask:  rIIIEncoding,
+	ASRAW & obj.AMask:  rIIIEncoding,
 
 	// 4.3: Load and Store Instructions
 	ALD & obj.AMask:  iIEncoding,
@@ -1663,10 +2197,31 @@ var encodingForAs = [...]encoding{
 	ASH & obj.AMask:  sIEncoding,
 	ASB & obj.AMask:  sIEncoding,
 
+	// 4.3: Memory Model
+	AFENCE & obj.AMask:  iIEncoding,
+	AFENCEI & obj.AMask: iIEncoding,
+
 	// 4.4: System Instructions
-	ARDCYCLE & obj.AMask:   iIEncoding,
-	ARDTIME & obj.AMask:    iIEncoding,
-	ARDINSTRET & obj.AMask: iIEncoding,
+	ARDCYCLE & obj.AMask:    iIEncoding,
+	ARDCYCLEH & obj.AMask:   iIEncoding,
+	ARDTIME & obj.AMask:     iIEncoding,
+	ARDTIMEH & obj.AMask:    iIEncoding,
+	ARDINSTRET & obj.AMask:  iIEncoding,
+	ARDINSTRETH & obj.AMask: iIEncoding,
+
+	// Privileged 2.1: Instructions to Access CSRs
+	ACSRRW & obj.AMask:  iIEncoding,
+	ACSRRS & obj.AMask:  iIEncoding,
+	ACSRRC & obj.AMask:  iIEncoding,
+	ACSRRWI & obj.AMask: iIEncoding,
+	ACSRRSI & obj.AMask: iIEncoding,
+	ACSRRCI & obj.AMask: iIEncoding,
+
+	// Privileged 3.2.2: Trap-Return Instructions
+	ASRET & obj.AMask: iIEncoding,
+
+	// Privileged 3.2.3: Wait for Interrupt
+	AWFI & obj.AMask: iIEncoding,
 
 	// 5.1: Multiplication Operations
 	AMUL & obj.AMask:    rIIIEncoding,
@@ -1683,25 +2238,72 @@ var encodingForAs = [...]encoding{
 	AREMW & obj.AMask:   rIIIEncoding,
 	AREMUW & obj.AMask:  rIIIEncoding,
 
+	// 6.2: Load-Reserved/Store-Conditional Instructions
+	ALRW & obj.AMask: amoEncoding,
+	ALRD & obj.AMask: amoEncoding,
+	ASCW & obj.AMask: amoEncoding,
+	ASCD & obj.AMask: amoEncoding,
+
+	// 6.3: Atomic Memory Operations
+	AAMOSWAPW & obj.AMask: amoEncoding,
+	AAMOSWAPD & obj.AMask: amoEncoding,
+	AAMOADDW & obj.AMask:  amoEncoding,
+	AAMOADDD & obj.AMask:  amoEncoding,
+	AAMOANDW & obj.AMask:  amoEncoding,
+	AAMOANDD & obj.AMask:  amoEncoding,
+	AAMOORW & obj.AMask:   amoEncoding,
+	AAMOORD & obj.AMask:   amoEncoding,
+	AAMOXORW & obj.AMask:  amoEncoding,
+	AAMOXORD & obj.AMask:  amoEncoding,
+	AAMOMAXW & obj.AMask:  amoEncoding,
+	AAMOMAXD & obj.AMask:  amoEncoding,
+	AAMOMAXUW & obj.AMask: amoEncoding,
+	AAMOMAXUD & obj.AMask: amoEncoding,
+	AAMOMINW & obj.AMask:  amoEncoding,
+	AAMOMIND & obj.AMask:  amoEncoding,
+	AAMOMINUW & obj.AMask: amoEncoding,
+	AAMOMINUD & obj.AMask: amoEncoding,
+
+	// 7.2: Floating-Point Control and Status Register
+	AFRCSR & obj.AMask:    iIEncoding,
+	AFSCSR & obj.AMask:    iIEncoding,
+	AFRRM & obj.AMask:     iIEncoding,
+	AFSRM & obj.AMask:     iIEncoding,
+	AFRFLAGS & obj.AMask:  iIEncoding,
+	AFSFLAGS & obj.AMask:  iIEncoding,
+	AFSRMI & obj.AMask:    iIEncoding,
+	AFSFLAGSI & obj.AMask: iIEncoding,
+
 	// 7.5: Single-Precision Load and Store Instructions
 	AFLW & obj.AMask: iFEncoding,
 	AFSW & obj.AMask: sFEncoding,
 
 	// 7.6: Single-Precision Floating-Point Computational Instructions
-	AFADDS & obj.AMask:  rFFFEncoding,
-	AFSUBS & obj.AMask:  rFFFEncoding,
-	AFMULS & obj.AMask:  rFFFEncoding,
-	AFDIVS & obj.AMask:  rFFFEncoding,
-	AFSQRTS & obj.AMask: rFFFEncoding,
+	AFADDS & obj.AMask:   rFFFEncoding,
+	AFSUBS & obj.AMask:   rFFFEncoding,
+	AFMULS & obj.AMask:   rFFFEncoding,
+	AFDIVS & obj.AMask:   rFFFEncoding,
+	AFSQRTS & obj.AMask:  rFFFEncoding,
+	AFMINS & obj.AMask:   rFFFEncoding,
+	AFMAXS & obj.AMask:   rFFFEncoding,
+	AFMADDS & obj.AMask:  r4Encoding,
+	AFMSUBS & obj.AMask:  r4Encoding,
+	AFNMSUBS & obj.AMask: r4Encoding,
+	AFNMADDS & obj.AMask: r4Encoding,
 
 	// 7.7: Single-Precision Floating-Point Conversion and Move Instructions
 	AFCVTWS & obj.AMask:  rFIEncoding,
 	AFCVTLS & obj.AMask:  rFIEncoding,
 	AFCVTSW & obj.AMask:  rIFEncoding,
 	AFCVTSL & obj.AMask:  rIFEncoding,
+	AFCVTWUS & obj.AMask: rFIEncoding,
+	AFCVTLUS & obj.AMask: rFIEncoding,
+	AFCVTSWU & obj.AMask: rIFEncoding,
+	AFCVTSLU & obj.AMask: rIFEncoding,
 	AFSGNJS & obj.AMask:  rFFFEncoding,
 	AFSGNJNS & obj.AMask: rFFFEncoding,
 	AFSGNJXS & obj.AMask: rFFFEncoding,
+	AFMVXS & obj.AMask:   rFIEncoding,
 	AFMVSX & obj.AMask:   rIFEncoding,
 
 	// 7.8: Single-Precision Floating-Point Compare Instructions
@@ -1709,27 +2311,41 @@ var encodingForAs = [...]encoding{
 	AFLTS & obj.AMask: rFFIEncoding,
 	AFLES & obj.AMask: rFFIEncoding,
 
+	// 7.9: Single-Precision Floating-Point Classify Instruction
+	AFCLASSS & obj.AMask: rFIEncoding,
+
 	// 8.2: Double-Precision Load and Store Instructions
 	AFLD & obj.AMask: iFEncoding,
 	AFSD & obj.AMask: sFEncoding,
 
 	// 8.3: Double-Precision Floating-Point Computational Instructions
-	AFADDD & obj.AMask:  rFFFEncoding,
-	AFSUBD & obj.AMask:  rFFFEncoding,
-	AFMULD & obj.AMask:  rFFFEncoding,
-	AFDIVD & obj.AMask:  rFFFEncoding,
-	AFSQRTD & obj.AMask: rFFFEncoding,
+	AFADDD & obj.AMask:   rFFFEncoding,
+	AFSUBD & obj.AMask:   rFFFEncoding,
+	AFMULD & obj.AMask:   rFFFEncoding,
+	AFDIVD & obj.AMask:   rFFFEncoding,
+	AFSQRTD & obj.AMask:  rFFFEncoding,
+	AFMIND & obj.AMask:   rFFFEncoding,
+	AFMAXD & obj.AMask:   rFFFEncoding,
+	AFMADDD & obj.AMask:  r4Encoding,
+	AFMSUBD & obj.AMask:  r4Encoding,
+	AFNMSUBD & obj.AMask: r4Encoding,
+	AFNMADDD & obj.AMask: r4Encoding,
 
 	// 8.4: Double-Precision Floating-Point Conversion and Move Instructions
 	AFCVTWD & obj.AMask:  rFIEncoding,
 	AFCVTLD & obj.AMask:  rFIEncoding,
 	AFCVTDW & obj.AMask:  rIFEncoding,
 	AFCVTDL & obj.AMask:  rIFEncoding,
+	AFCVTWUD & obj.AMask: rFIEncoding,
+	AFCVTLUD & obj.AMask: rFIEncoding,
+	AFCVTDWU & obj.AMask: rIFEncoding,
+	AFCVTDLU & obj.AMask: rIFEncoding,
 	AFCVTSD & obj.AMask:  rFFEncoding,
 	AFCVTDS & obj.AMask:  rFFEncoding,
 	AFSGNJD & obj.AMask:  rFFFEncoding,
 	AFSGNJND & obj.AMask: rFFFEncoding,
 	AFSGNJXD & obj.AMask: rFFFEncoding,
+	AFMVXD & obj.AMask:   rFIEncoding,
 	AFMVDX & obj.AMask:   rIFEncoding,
 
 	// 8.5: Double-Precision Floating-Point Compare Instructions
@@ -1737,6 +2353,67 @@ var encodingForAs = [...]encoding{
 	AFLTD & obj.AMask: rFFIEncoding,
 	AFLED & obj.AMask: rFFIEncoding,
 
+	// 8.6: Double-Precision Floating-Point Classify Instruction
+	AFCLASSD & obj.AMask: rFIEncoding,
+
+	// Bitmanip 2.1: Zba: Address generation
+	AADDUW & obj.AMask:    rIIIEncoding,
+	ASH1ADD & obj.AMask:   rIIIEncoding,
+	ASH1ADDUW & obj.AMask: rIIIEncoding,
+	ASH2ADD & obj.AMask:   rIIIEncoding,
+	ASH2ADDUW & obj.AMask: rIIIEncoding,
+	ASH3ADD & obj.AMask:   rIIIEncoding,
+	ASH3ADDUW & obj.AMask: rIIIEncoding,
+	ASLLIUW & obj.AMask:   iIEncoding,
+
+	// Bitmanip 2.2: Zbb: Basic bit-manipulation
+	AANDN & obj.AMask:      rIIIEncoding,
+	AORN & obj.AMask:       rIIIEncoding,
+	AXNOR & obj.AMask:      rIIIEncoding,
+	ACLZ & obj.AMask:       rIIEncoding,
+	ACLZW & obj.AMask:      rIIEncoding,
+	ACTZ & obj.AMask:       rIIEncoding,
+	ACTZW & obj.AMask:      rIIEncoding,
+	ACPOP & obj.AMask:      rIIEncoding,
+	ACPOPW & obj.AMask:     rIIEncoding,
+	AMAX & obj.AMask:       rIIIEncoding,
+	AMAXU & obj.AMask:      rIIIEncoding,
+	AMIN & obj.AMask:       rIIIEncoding,
+	AMINU & obj.AMask:      rIIIEncoding,
+	ASEXTB & obj.AMask:     rIIEncoding,
+	ASEXTH & obj.AMask:     rIIEncoding,
+	AZEXTH & obj.AMask:     rIIEncoding,
+	AZEXTHRV32 & obj.AMask: rIIEncoding,
+	AROL & obj.AMask:       rIIIEncoding,
+	AROLW & obj.AMask:      rIIIEncoding,
+	AROR & obj.AMask:       rIIIEncoding,
+	ARORI & obj.AMask:      iIEncoding,
+	ARORIW & obj.AMask:     iIEncoding,
+	ARORW & obj.AMask:      rIIIEncoding,
+	AORCB & obj.AMask:      rIIEncoding,
+	AREV8 & obj.AMask:      rIIEncoding,
+	AREV8RV32 & obj.AMask:  rIIEncoding,
+
+	// Bitmanip 2.4: Zbs: Single-bit instructions
+	ABCLR & obj.AMask:  rIIIEncoding,
+	ABCLRI & obj.AMask: iIEncoding,
+	ABEXT & obj.AMask:  rIIIEncoding,
+	ABEXTI & obj.AMask: iIEncoding,
+	ABINV & obj.AMask:  rIIIEncoding,
+	ABINVI & obj.AMask: iIEncoding,
+	ABSET & obj.AMask:  rIIIEncoding,
+	ABSETI & obj.AMask: iIEncoding,
+
+	// Scalar crypto 2.6: Zknh: NIST suite: hash function instructions
+	ASHA256SIG0 & obj.AMask: rIIEncoding,
+	ASHA256SIG1 & obj.AMask: rIIEncoding,
+	ASHA256SUM0 & obj.AMask: rIIEncoding,
+	ASHA256SUM1 & obj.AMask: rIIEncoding,
+	ASHA512SIG0 & obj.AMask: rIIEncoding,
+	ASHA512SIG1 & obj.AMask: rIIEncoding,
+	ASHA512SUM0 & obj.AMask: rIIEncoding,
+	ASHA512SUM1 & obj.AMask: rIIEncoding,
+
 	// Escape hatch
 	AWORD & obj.AMask: rawEncoding,
 
@@ -1747,29 +2424,65 @@ var encodingForAs = [...]encoding{
 	obj.ANOP:      pseudoOpEncoding,
 }
 
-// encodingForP returns the encoding (encode+validate funcs) for a Prog.
-func encodingForP(p *obj.Prog) encoding {
-	if base := p.As &^ obj.AMask; base != obj.ABaseRISCV && base != 0 {
-		p.Ctxt.Diag("encodingForP: not a RISC-V instruction %s", p.As)
-		return badEncoding
+// lookupEncoding returns the encoding (encode+validate funcs) for as.
+func lookupEncoding(as obj.As) (encoding, error) {
+	if base := as &^ obj.AMask; base != obj.ABaseRISCV && base != 0 {
+		return badEncoding, fmt.Errorf("not a RISC-V instruction %s", as)
 	}
-	as := p.As & obj.AMask
-	if int(as) >= len(encodingForAs) {
-		p.Ctxt.Diag("encodingForP: bad RISC-V instruction %s", p.As)
-		return badEncoding
+	if int(as&obj.AMask) >= len(encodingForAs) {
+		return badEncoding, fmt.Errorf("bad RISC-V instruction %s", as)
 	}
-	enc := encodingForAs[as]
+	enc := encodingForAs[as&obj.AMask]
 	if enc.validate == nil {
-		p.Ctxt.Diag("encodingForP: no encoding for instruction %s", p.As)
-		return badEncoding
+		return badEncoding, fmt.Errorf("no encoding for instruction %s", as)
 	}
+	return enc, nil
+}
+
+// encodingForP returns the encoding (encode+validate funcs) for a Prog.
+// Progs without an encoding get badEncoding; the error is reported once,
+// by validateP.
+func encodingForP(p *obj.Prog) encoding {
+	enc, _ := lookupEncoding(p.As)
 	return enc
 }
 
+// badInst reports an error for p and marks it so that validateP does not
+// report it again.
+func badInst(ctxt *obj.Link, p *obj.Prog, format string, args ...interface{}) {
+	ctxt.Diag(format, args...)
+	p.Mark |= BAD_INST
+}
+
+// validateP reports any problems with p, which must be fully lowered.
+func validateP(ctxt *obj.Link, p *obj.Prog) {
+	if p.Mark&BAD_INST != 0 {
+		return
+	}
+	enc, err := lookupEncoding(p.As)
+	if err != nil {
+		ctxt.Diag("%v	%v", p, err)
+		return
+	}
+	enc.validate(p)
+	validateShift(p)
+	if isRV32(ctxt) {
+		validateRV32(p)
+	} else {
+		validateRV64(p)
+	}
+}
+
 // assemble emits machine code.
 // It is called at the very end of the assembly process.
 func assemble(ctxt *obj.Link, cursym *obj.LSym) {
-	var symcode []uint32 // machine code for this symbol
+	if ctxt.Errors > 0 {
+		// preprocess has already reported why some instructions
+		// cannot be encoded. Encoding them anyway would panic.
+		return
+	}
+
+	var symcode []byte // machine code for this symbol
 	for p := cursym.Text; p != nil; p = p.Link {
 		switch p.As {
 		case AJALR:
@@ -1790,6 +2503,8 @@ func assemble(ctxt *obj.Link, cursym *obj.LSym) {
 				t = obj.R_RISCV_PCREL_ITYPE
 			} else if p.Mark&NEED_PCREL_STYPE_RELOC == NEED_PCREL_STYPE_RELOC {
 				t = obj.R_RISCV_PCREL_STYPE
+			} else if p.Mark&NEED_GOT_PCREL_ITYPE_RELOC == NEED_GOT_PCREL_ITYPE_RELOC {
+				t = obj.R_RISCV_GOT_PCREL_ITYPE
 			} else {
 				break
 			}
@@ -1811,15 +2526,21 @@ func assemble(ctxt *obj.Link, cursym *obj.LSym) {
 			rel.Type = t
 		}
 
+		if c, ok := compress(p); ok {
+			var buf [2]byte
+			ctxt.Arch.ByteOrder.PutUint16(buf[:], c)
+			symcode = append(symcode, buf[:]...)
+			continue
+		}
 		enc := encodingForP(p)
 		if enc.length > 0 {
-			symcode = append(symcode, enc.encode(p))
+			var buf [4]byte
+			ctxt.Arch.ByteOrder.PutUint32(buf[:], enc.encode(p))
+			symcode = append(symcode, buf[:]...)
 		}
 	}
-	cursym.Size = int64(4 * len(symcode))
+	cursym.Size = int64(len(symcode))
 
 	cursym.Grow(cursym.Size)
-	for p, i := cursym.P, 0; i < len(symcode); p, i = p[4:], i+1 {
-		ctxt.Arch.ByteOrder.PutUint32(p, symcode[i])
-	}
+	copy(cursym.P, symcode)
 }
@@ -0,0 +1,436 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv
+
+import "cmd/internal/obj"
+
+// This file implements the optional rewriting of instructions into the
+// 16-bit encodings of the "C" standard extension (RVC), enabled with
+// ctxt.Flag_rvc.
+//
+// Compression is decided per Prog after preprocess has finished rewriting
+// instructions, so the only state it depends on is the Prog's operands and
+// its Mark. Branch and jump offsets are not known until branch relaxation
+// has converged; preprocess optimistically assumes that every eligible
+// branch fits and sets NO_COMPRESS on those that turn out not to. Since
+// instructions only ever grow, this reaches a fixed point.
+//
+// Instructions that are patched by the linker (the AUIPC pairs carrying
+// R_RISCV_PCREL_* relocations, and JALRs carrying R_CALLRISCV) are never
+// compressed, nor is the frame setup in the prologue; the runtime assumes
+// fixed sizes for both.
+
+// Ranges of the offsets of compressed branches and jumps.
+const (
+	cBranchMin = -1 << 8
+	cBranchMax = 1<<8 - 2
+	cJumpMin   = -1 << 11
+	cJumpMax   = 1<<11 - 2
+)
+
+// compressible reports whether p will be emitted as a 16-bit instruction.
+func compressible(p *obj.Prog) bool {
+	_, ok := compress(p)
+	return ok
+}
+
+// instructionLength returns the number of bytes p will be assembled into.
+func instructionLength(p *obj.Prog) int64 {
+	l := encodingForP(p).length
+	if l == 4 && compressible(p) {
+		return 2
+	}
+	return l
+}
+
+// cBranchInRange reports whether offset is reachable by the compressed form
+// of the branch or jump p.
+func cBranchInRange(p *obj.Prog, offset int64) bool {
+	if p.As == AJAL {
+		return cJumpMin <= offset && offset <= cJumpMax
+	}
+	return cBranchMin <= offset && offset <= cBranchMax
+}
+
+// compress returns the 16-bit encoding of p, if compression is enabled and
+// p has one.
+func compress(p *obj.Prog) (uint16, bool) {
+	if p.Ctxt == nil || !p.Ctxt.Flag_rvc || p.Mark&NO_COMPRESS != 0 {
+		return 0, false
+	}
+	var offset int64
+	switch p.As {
+	case ABEQ, ABNE, AJAL:
+		// Until branches are resolved the offset is unknown, and
+		// preprocess guarantees that it will be in range.
+		if p.To.Type == obj.TYPE_CONST {
+			offset = p.To.Offset
+			if !cBranchInRange(p, offset) {
+				return 0, false
+			}
+		}
+	}
+	return compressOperands(p, offset)
+}
+
+// intReg returns the number of the integer register in a, if there is one.
+func intReg(a *obj.Addr) (uint32, bool) {
+	if a == nil || a.Type != obj.TYPE_REG || a.Reg < REG_X0 || a.Reg > REG_X31 {
+		return 0, false
+	}
+	return uint32(a.Reg - REG_X0), true
+}
+
+// floatReg returns the number of the floating point register in a, if there
+// is one.
+func floatReg(a *obj.Addr) (uint32, bool) {
+	if a == nil || a.Type != obj.TYPE_REG || a.Reg < REG_F0 || a.Reg > REG_F31 {
+		return 0, false
+	}
+	return uint32(a.Reg - REG_F0), true
+}
+
+// isPrime reports whether register r is one of the eight registers (x8-x15
+// or f8-f15) addressable by the 3-bit register fields of the compressed
+// encodings.
+func isPrime(r uint32) bool {
+	return 8 <= r && r <= 15
+}
+
+// constOffset returns the constant in a, if there is one.
+func constOffset(a *obj.Addr) (int64, bool) {
+	if a == nil || a.Type != obj.TYPE_CONST || a.Sym != nil {
+		return 0, false
+	}
+	return a.Offset, true
+}
+
+// scaled reports whether imm is a multiple of scale in [0, limit).
+func scaled(imm, scale, limit int64) bool {
+	return imm >= 0 && imm < limit && imm%scale == 0
+}
+
+// Encoders for the compressed instruction formats. All values are assumed
+// to be in range.
+
+func encodeCR(op, funct4, rd, rs2 uint32) uint16 {
+	return uint16(funct4<<12 | rd<<7 | rs2<<2 | op)
+}
+
+func encodeCI(op, funct3, rd uint32, imm int64) uint16 {
+	i := uint32(imm)
+	return uint16(funct3<<13 | (i>>5&1)<<12 | rd<<7 | (i&0x1f)<<2 | op)
+}
+
+func encodeCA(funct6, rd, funct2, rs2 uint32) uint16 {
+	return uint16(funct6<<10 | (rd-8)<<7 | funct2<<5 | (rs2-8)<<2 | 0x1)
+}
+
+func encodeCB(funct3, funct2, rd uint32, imm int64) uint16 {
+	i := uint32(imm)
+	return uint16(funct3<<13 | (i>>5&1)<<12 | funct2<<10 | (rd-8)<<7 | (i&0x1f)<<2 | 0x1)
+}
+
+// encodeCL encodes C.LW, C.LD and C.FLD, and, with the register in rd
+// taken as rs2, C.SW, C.SD and C.FSD.
+func encodeCL(op, funct3, rd, rs1 uint32, imm int64, word bool) uint16 {
+	i := uint32(imm)
+	var lo uint32
+	if word {
+		lo = (i>>2&1)<<1 | i>>6&1
+	} else {
+		lo = i >> 6 & 3
+	}
+	return uint16(funct3<<13 | (i>>3&7)<<10 | (rs1-8)<<7 | lo<<5 | (rd-8)<<2 | op)
+}
+
+// encodeCSPLoad encodes C.LWSP, C.LDSP and C.FLDSP.
+func encodeCSPLoad(funct3, rd uint32, imm int64, word bool) uint16 {
+	i := uint32(imm)
+	var lo uint32
+	if word {
+		lo = (i>>2&7)<<2 | i>>6&3
+	} else {
+		lo = (i>>3&3)<<3 | i>>6&7
+	}
+	return uint16(funct3<<13 | (i>>5&1)<<12 | rd<<7 | lo<<2 | 0x2)
+}
+
+// encodeCSPStore encodes C.SWSP, C.SDSP and C.FSDSP.
+func encodeCSPStore(funct3, rs2 uint32, imm int64, word bool) uint16 {
+	i := uint32(imm)
+	var hi uint32
+	if word {
+		hi = (i>>2&0xf)<<2 | i>>6&3
+	} else {
+		hi = (i>>3&7)<<3 | i>>6&7
+	}
+	return uint16(funct3<<13 | hi<<7 | rs2<<2 | 0x2)
+}
+
+func encodeCJ(imm int64) uint16 {
+	i := uint32(imm)
+	return uint16(0x5<<13 |
+		(i>>11&1)<<12 |
+		(i>>4&1)<<11 |
+		(i>>8&3)<<9 |
+		(i>>10&1)<<8 |
+		(i>>6&1)<<7 |
+		(i>>7&1)<<6 |
+		(i>>1&7)<<3 |
+		(i>>5&1)<<2 |
+		0x1)
+}
+
+func encodeCBranch(funct3, rs1 uint32, imm int64) uint16 {
+	i := uint32(imm)
+	return uint16(funct3<<13 |
+		(i>>8&1)<<12 |
+		(i>>3&3)<<10 |
+		(rs1-8)<<7 |
+		(i>>6&3)<<5 |
+		(i>>1&3)<<3 |
+		(i>>5&1)<<2 |
+		0x1)
+}
+
+// compressOperands returns the 16-bit encoding of p, if it has one. offset
+// is used as the displacement of branches and jumps.
+func compressOperands(p *obj.Prog, offset int64) (uint16, bool) {
+	switch p.As {
+	case AADDI:
+		imm, ok := constOffset(&p.From)
+		rs1, ok1 := intReg(p.From3)
+		rd, ok2 := intReg(&p.To)
+		if !ok || !ok1 || !ok2 {
+			return 0, false
+		}
+		switch {
+		case rd == 0 && rs1 == 0 && imm == 0:
+			// C.NOP
+			return encodeCI(0x1, 0x0, 0, 0), true
+		case rd == 0:
+			return 0, false
+		case rs1 == 0 && immFits(imm, 6):
+			// C.LI
+			return encodeCI(0x1, 0x2, rd, imm), true
+		case imm == 0:
+			// C.MV
+			return encodeCR(0x2, 0x8, rd, rs1), true
+		case rd == rs1 && immFits(imm, 6):
+			// C.ADDI
+			return encodeCI(0x1, 0x0, rd, imm), true
+		case rd == 2 && rs1 == 2 && immFits(imm, 10) && imm%16 == 0:
+			// C.ADDI16SP
+			i := uint32(imm)
+			return uint16(0x3<<13 | (i>>9&1)<<12 | 2<<7 |
+				(i>>4&1)<<6 | (i>>6&1)<<5 | (i>>7&3)<<3 | (i>>5&1)<<2 | 0x1), true
+		case rs1 == 2 && isPrime(rd) && imm > 0 && scaled(imm, 4, 1024):
+			// C.ADDI4SPN
+			i := uint32(imm)
+			return uint16((i>>4&3)<<11 | (i>>6&0xf)<<7 | (i>>2&1)<<6 | (i>>3&1)<<5 | (rd-8)<<2), true
+		}
+
+	case AADDIW:
+		imm, ok := constOffset(&p.From)
+		rs1, ok1 := intReg(p.From3)
+		rd, ok2 := intReg(&p.To)
+		if !ok || !ok1 || !ok2 || rd == 0 || !immFits(imm, 6) {
+			return 0, false
+		}
+		switch rs1 {
+		case 0:
+			// MOV $c, R is ADDIW $c, ZERO, R, which has the same
+			// result as C.LI for small constants.
+			return encodeCI(0x1, 0x2, rd, imm), true
+		case rd:
+			// C.ADDIW
+			return encodeCI(0x1, 0x1, rd, imm), true
+		}
+
+	case ALUI:
+		imm, ok := constOffset(&p.From)
+		rd, ok1 := intReg(&p.To)
+		if ok && ok1 && rd != 0 && rd != 2 && imm != 0 && immFits(imm, 6) {
+			// C.LUI
+			return encodeCI(0x1, 0x3, rd, imm), true
+		}
+
+	case ASLLI, ASRLI, ASRAI, AANDI:
+		imm, ok := constOffset(&p.From)
+		rs1, ok1 := intReg(p.From3)
+		rd, ok2 := intReg(&p.To)
+		if !ok || !ok1 || !ok2 || rd != rs1 {
+			return 0, false
+		}
+		switch p.As {
+		case ASLLI:
+			if rd != 0 && imm > 0 && imm < 64 {
+				// C.SLLI
+				return encodeCI(0x2, 0x0, rd, imm), true
+			}
+		case ASRLI:
+			if isPrime(rd) && imm > 0 && imm < 64 {
+				// C.SRLI
+				return encodeCB(0x4, 0x0, rd, imm), true
+			}
+		case ASRAI:
+			if isPrime(rd) && imm > 0 && imm < 64 {
+				// C.SRAI
+				return encodeCB(0x4, 0x1, rd, imm), true
+			}
+		case AANDI:
+			if isPrime(rd) && immFits(imm, 6) {
+				// C.ANDI
+				return encodeCB(0x4, 0x2, rd, imm), true
+			}
+		}
+
+	case AADD, ASUB, AAND, AOR, AXOR:
+		rs2, ok := intReg(&p.From)
+		rs1, ok1 := intReg(p.From3)
+		rd, ok2 := intReg(&p.To)
+		if !ok || !ok1 || !ok2 || rd == 0 {
+			return 0, false
+		}
+		if p.As != ASUB && rd == rs2 {
+			// The operation is commutative.
+			rs1, rs2 = rs2, rs1
+		}
+		if rd != rs1 {
+			if p.As == AADD && rs1 == 0 && rs2 != 0 {
+				// C.MV
+				return encodeCR(0x2, 0x8, rd, rs2), true
+			}
+			return 0, false
+		}
+		if p.As == AADD {
+			if rs2 != 0 {
+				// C.ADD
+				return encodeCR(0x2, 0x9, rd, rs2), true
+			}
+			return 0, false
+		}
+		if !isPrime(rd) || !isPrime(rs2) {
+			return 0, false
+		}
+		var funct2 uint32
+		switch p.As {
+		case ASUB:
+			funct2 = 0x0 // C.SUB
+		case AXOR:
+			funct2 = 0x1 // C.XOR
+		case AOR:
+			funct2 = 0x2 // C.OR
+		case AAND:
+			funct2 = 0x3 // C.AND
+		}
+		return encodeCA(0x23, rd, funct2, rs2), true
+
+	case ALW, ALD, AFLD:
+		imm, ok := constOffset(&p.From)
+		rs1, ok1 := intReg(p.From3)
+		var rd uint32
+		var ok2 bool
+		if p.As == AFLD {
+			rd, ok2 = floatReg(&p.To)
+		} else {
+			rd, ok2 = intReg(&p.To)
+		}
+		if !ok || !ok1 || !ok2 {
+			return 0, false
+		}
+		word, scale, funct3 := false, int64(8), uint32(0x3)
+		switch p.As {
+		case ALW:
+			word, scale, funct3 = true, 4, 0x2
+		case AFLD:
+			funct3 = 0x1
+		}
+		if rs1 == 2 && (rd != 0 || p.As == AFLD) && scaled(imm, scale, 64*scale) {
+			// C.LWSP, C.LDSP, C.FLDSP
+			return encodeCSPLoad(funct3, rd, imm, word), true
+		}
+		if isPrime(rs1) && isPrime(rd) && scaled(imm, scale, 32*scale) {
+			// C.LW, C.LD, C.FLD
+			return encodeCL(0x0, funct3, rd, rs1, imm, word), true
+		}
+
+	case ASW, ASD, AFSD:
+		imm, ok := constOffset(&p.From)
+		rs1, ok1 := intReg(&p.To)
+		var rs2 uint32
+		var ok2 bool
+		if p.As == AFSD {
+			rs2, ok2 = floatReg(p.From3)
+		} else {
+			rs2, ok2 = intReg(p.From3)
+		}
+		if !ok || !ok1 || !ok2 {
+			return 0, false
+		}
+		word, scale, funct3 := false, int64(8), uint32(0x7)
+		switch p.As {
+		case ASW:
+			word, scale, funct3 = true, 4, 0x6
+		case AFSD:
+			funct3 = 0x5
+		}
+		if rs1 == 2 && scaled(imm, scale, 64*scale) {
+			// C.SWSP, C.SDSP, C.FSDSP
+			return encodeCSPStore(funct3, rs2, imm, word), true
+		}
+		if isPrime(rs1) && isPrime(rs2) && scaled(imm, scale, 32*scale) {
+			// C.SW, C.SD, C.FSD
+			return encodeCL(0x0, funct3, rs2, rs1, imm, word), true
+		}
+
+	case AJAL:
+		rd, ok := intReg(&p.From)
+		if ok && rd == 0 {
+			// C.J
+			return encodeCJ(offset), true
+		}
+
+	case AJALR:
+		imm, ok := constOffset(&p.From)
+		rs1, ok1 := intReg(p.From3)
+		rd, ok2 := intReg(&p.To)
+		if !ok || !ok1 || !ok2 || imm != 0 || rs1 == 0 || p.To.Sym != nil {
+			return 0, false
+		}
+		switch rd {
+		case 0:
+			// C.JR
+			return encodeCR(0x2, 0x8, rs1, 0), true
+		case 1:
+			// C.JALR
+			return encodeCR(0x2, 0x9, rs1, 0), true
+		}
+
+	case ABEQ, ABNE:
+		rs1, ok := intReg(&p.From)
+		if !ok || p.Reg < REG_X0 || p.Reg > REG_X31 {
+			return 0, false
+		}
+		rs2 := uint32(p.Reg - REG_X0)
+		if rs1 == 0 {
+			rs1, rs2 = rs2, rs1
+		}
+		if rs2 != 0 || !isPrime(rs1) {
+			return 0, false
+		}
+		funct3 := uint32(0x6) // C.BEQZ
+		if p.As == ABNE {
+			funct3 = 0x7 // C.BNEZ
+		}
+		return encodeCBranch(funct3, rs1, offset), true
+
+	case AEBREAK:
+		// C.EBREAK
+		return encodeCR(0x2, 0x9, 0, 0), true
+	}
+	return 0, false
+}
@@ -111,7 +111,7 @@ const (
 	REG_RA   = REG_X1
 	REG_SP   = REG_X2
 	REG_GP   = REG_X3 // aka REG_SB
-	REG_TP   = REG_X4 // aka REG_G
+	REG_TP   = REG_X4
 	REG_T0   = REG_X5
 	REG_T1   = REG_X6
 	REG_T2   = REG_X7
@@ -134,16 +134,16 @@ const (
 	REG_S8   = REG_X24
 	REG_S9   = REG_X25
 	REG_S10  = REG_X26
-	REG_S11  = REG_X27
+	REG_S11  = REG_X27 // aka REG_G
 	REG_T3   = REG_X28
 	REG_T4   = REG_X29
 	REG_T5   = REG_X30
 	REG_T6   = REG_X31
 
 	// Go runtime register names.
-	REG_G    = REG_TP // G pointer.
-	REG_CTXT = REG_S4 // Context for closures.
-	REG_TMP  = REG_T6 // Reserved for assembler use.
+	REG_G    = REG_S11 // G pointer.
+	REG_CTXT = REG_S4  // Context for closures.
+	REG_TMP  = REG_T6  // Reserved for assembler use.
 
 	// ABI names for floating point registers.
 	REG_FT0  = REG_F0
@@ -186,6 +186,20 @@ const (
 	REGG  = REG_G
 )
 
+// DWARFRegisters maps registers to the numbers used for them in DWARF
+// debugging information. The RISC-V ELF psABI numbers the integer
+// registers from 0 and the floating point registers from 32.
+var DWARFRegisters = map[int16]int16{}
+
+func init() {
+	for r := REG_X0; r <= REG_X31; r++ {
+		DWARFRegisters[int16(r)] = int16(r - REG_X0)
+	}
+	for r := REG_F0; r <= REG_F31; r++ {
+		DWARFRegisters[int16(r)] = int16(32 + r - REG_F0)
+	}
+}
+
 // Prog.Mark flags.
 const (
 	// NEED_PCREL_ITYPE_RELOC is set on AUIPC instructions to indicate that
@@ -197,6 +211,35 @@ const (
 	// it is the first instruction in an AUIPC + S-type pair that needs a
 	// R_RISCV_PCREL_STYPE relocation.
 	NEED_PCREL_STYPE_RELOC = 1 << 1
+
+	// NO_COMPRESS is set on instructions that must keep their 32-bit
+	// encoding when compressed instructions are enabled.
+	NO_COMPRESS = 1 << 2
+
+	// BAD_INST is set on instructions for which an error has already
+	// been reported, so that later passes do not report them again.
+	BAD_INST = 1 << 3
+
+	// NEED_GOT_PCREL_ITYPE_RELOC is set on AUIPC instructions to indicate
+	// that it is the first instruction in an AUIPC + LD pair that loads
+	// a symbol's address from the GOT, which needs a
+	// R_RISCV_GOT_PCREL_ITYPE relocation.
+	NEED_GOT_PCREL_ITYPE_RELOC = 1 << 4
+)
+
+// Floating-point rounding modes, set in Prog.Scond to override the
+// default. Without one, conversions to an integer round toward zero, as
+// Go requires, and all other instructions round to nearest, ties to
+// even. The rounding mode is in the low three bits; RM_SET marks it as
+// present.
+const (
+	RM_SET = 1 << 3
+
+	RM_RNE = RM_SET | 0 // round to nearest, ties to even
+	RM_RTZ = RM_SET | 1 // round toward zero
+	RM_RDN = RM_SET | 2 // round down, toward -Inf
+	RM_RUP = RM_SET | 3 // round up, toward +Inf
+	RM_RMM = RM_SET | 4 // round to nearest, ties to max magnitude
 )
 
 // RISC-V mnemonics, as defined in the "opcodes" and "opcodes-pseudo" files of
@@ -412,6 +455,70 @@ const (
 	// 8.6: Double-Precision Floating-Point Classify Instruction
 	AFCLASSD
 
+	// Bit-Manipulation ISA
+
+	// 2.1: Zba: Address generation
+	AADDUW
+	ASH1ADD
+	ASH1ADDUW
+	ASH2ADD
+	ASH2ADDUW
+	ASH3ADD
+	ASH3ADDUW
+	ASLLIUW
+
+	// 2.2: Zbb: Basic bit-manipulation
+	AANDN
+	AORN
+	AXNOR
+	ACLZ
+	ACLZW
+	ACTZ
+	ACTZW
+	ACPOP
+	ACPOPW
+	AMAX
+	AMAXU
+	AMIN
+	AMINU
+	ASEXTB
+	ASEXTH
+	AZEXTH
+	AROL
+	AROLW
+	AROR
+	ARORI
+	ARORIW
+	ARORW
+	AORCB
+	AREV8
+
+	// ZEXTH and REV8 have different encodings on RV32.
+	AZEXTHRV32
+	AREV8RV32
+
+	// 2.4: Zbs: Single-bit instructions
+	ABCLR
+	ABCLRI
+	ABEXT
+	ABEXTI
+	ABINV
+	ABINVI
+	ABSET
+	ABSETI
+
+	// Scalar Cryptography ISA
+
+	// 2.6: Zknh: NIST suite: hash function instructions
+	ASHA256SIG0
+	ASHA256SIG1
+	ASHA256SUM0
+	ASHA256SUM1
+	ASHA512SIG0
+	ASHA512SIG1
+	ASHA512SUM0
+	ASHA512SUM1
+
 	// Privileged ISA
 
 	// 2.1: Instructions to Access CSRs
@@ -427,25 +534,20 @@ const (
 	ASCALL
 	AEBREAK
 	ASBREAK
-	AERET
-	ASRET
 
-	// 3.2.2: Trap Redirection Instructions
-	AMRTS
-	AMRTH
-	AHRTS
+	// 3.2.2: Trap-Return Instructions
+	ASRET
 
 	// 3.2.3: Wait for Interrupt
 	AWFI
 
-	// 4.2.1: Supervisor Memory-Management Fence Instruction
-	ASFENCEVM
-
 	// The escape hatch. Inserts a single 32-bit word.
 	AWORD
 
 	// Fake instructions.  These get translated by the assembler into other
 	// instructions, based on their operands.
+	AFABSD
+	AFABSS
 	AFNEGD
 	AFNEGS
 	AFNED
@@ -476,6 +578,9 @@ var unaryDst = map[obj.As]bool{
 	ARDTIMEH:    true,
 	ARDINSTRET:  true,
 	ARDINSTRETH: true,
+	AFRCSR:      true,
+	AFRRM:       true,
+	AFRFLAGS:    true,
 }
 
 // Operands
@@ -191,17 +191,9 @@ func encode(a obj.As) (i *inst, ok bool) {
 	case ASBREAK:
 		return &inst{0x73, 0x0, 0x1, 1, 0x0}, true
 	case ASRET:
-		return &inst{0x73, 0x0, 0x0, 256, 0x8}, true
-	case ASFENCEVM:
-		return &inst{0x73, 0x0, 0x1, 257, 0x8}, true
-	case AWFI:
 		return &inst{0x73, 0x0, 0x2, 258, 0x8}, true
-	case AMRTH:
-		return &inst{0x73, 0x0, 0x6, 774, 0x18}, true
-	case AMRTS:
-		return &inst{0x73, 0x0, 0x5, 773, 0x18}, true
-	case AHRTS:
-		return &inst{0x73, 0x0, 0x5, 517, 0x10}, true
+	case AWFI:
+		return &inst{0x73, 0x0, 0x5, 261, 0x8}, true
 	case ACSRRW:
 		return &inst{0x73, 0x1, 0x0, 0, 0x0}, true
 	case ACSRRS:
@@ -376,8 +368,106 @@ func encode(a obj.As) (i *inst, ok bool) {
 		return &inst{0x73, 0x0, 0x0, 0, 0x0}, true
 	case AEBREAK:
 		return &inst{0x73, 0x0, 0x1, 1, 0x0}, true
-	case AERET:
-		return &inst{0x73, 0x0, 0x0, 256, 0x8}, true
+	case AADDUW:
+		return &inst{0x3b, 0x0, 0x0, 128, 0x4}, true
+	case ASH1ADD:
+		return &inst{0x33, 0x2, 0x0, 512, 0x10}, true
+	case ASH1ADDUW:
+		return &inst{0x3b, 0x2, 0x0, 512, 0x10}, true
+	case ASH2ADD:
+		return &inst{0x33, 0x4, 0x0, 512, 0x10}, true
+	case ASH2ADDUW:
+		return &inst{0x3b, 0x4, 0x0, 512, 0x10}, true
+	case ASH3ADD:
+		return &inst{0x33, 0x6, 0x0, 512, 0x10}, true
+	case ASH3ADDUW:
+		return &inst{0x3b, 0x6, 0x0, 512, 0x10}, true
+	case ASLLIUW:
+		return &inst{0x1b, 0x1, 0x0, 128, 0x4}, true
+	case AANDN:
+		return &inst{0x33, 0x7, 0x0, 1024, 0x20}, true
+	case AORN:
+		return &inst{0x33, 0x6, 0x0, 1024, 0x20}, true
+	case AXNOR:
+		return &inst{0x33, 0x4, 0x0, 1024, 0x20}, true
+	case ACLZ:
+		return &inst{0x13, 0x1, 0x0, 1536, 0x30}, true
+	case ACLZW:
+		return &inst{0x1b, 0x1, 0x0, 1536, 0x30}, true
+	case ACTZ:
+		return &inst{0x13, 0x1, 0x1, 1537, 0x30}, true
+	case ACTZW:
+		return &inst{0x1b, 0x1, 0x1, 1537, 0x30}, true
+	case ACPOP:
+		return &inst{0x13, 0x1, 0x2, 1538, 0x30}, true
+	case ACPOPW:
+		return &inst{0x1b, 0x1, 0x2, 1538, 0x30}, true
+	case AMAX:
+		return &inst{0x33, 0x6, 0x0, 160, 0x5}, true
+	case AMAXU:
+		return &inst{0x33, 0x7, 0x0, 160, 0x5}, true
+	case AMIN:
+		return &inst{0x33, 0x4, 0x0, 160, 0x5}, true
+	case AMINU:
+		return &inst{0x33, 0x5, 0x0, 160, 0x5}, true
+	case ASEXTB:
+		return &inst{0x13, 0x1, 0x4, 1540, 0x30}, true
+	case ASEXTH:
+		return &inst{0x13, 0x1, 0x5, 1541, 0x30}, true
+	case AZEXTH:
+		return &inst{0x3b, 0x4, 0x0, 128, 0x4}, true
+	case AZEXTHRV32:
+		return &inst{0x33, 0x4, 0x0, 128, 0x4}, true
+	case AROL:
+		return &inst{0x33, 0x1, 0x0, 1536, 0x30}, true
+	case AROLW:
+		return &inst{0x3b, 0x1, 0x0, 1536, 0x30}, true
+	case AROR:
+		return &inst{0x33, 0x5, 0x0, 1536, 0x30}, true
+	case ARORI:
+		return &inst{0x13, 0x5, 0x0, 1536, 0x30}, true
+	case ARORIW:
+		return &inst{0x1b, 0x5, 0x0, 1536, 0x30}, true
+	case ARORW:
+		return &inst{0x3b, 0x5, 0x0, 1536, 0x30}, true
+	case AORCB:
+		return &inst{0x13, 0x5, 0x7, 647, 0x14}, true
+	case AREV8:
+		return &inst{0x13, 0x5, 0x18, 1720, 0x35}, true
+	case AREV8RV32:
+		return &inst{0x13, 0x5, 0x18, 1688, 0x34}, true
+	case ABCLR:
+		return &inst{0x33, 0x1, 0x0, 1152, 0x24}, true
+	case ABCLRI:
+		return &inst{0x13, 0x1, 0x0, 1152, 0x24}, true
+	case ABEXT:
+		return &inst{0x33, 0x5, 0x0, 1152, 0x24}, true
+	case ABEXTI:
+		return &inst{0x13, 0x5, 0x0, 1152, 0x24}, true
+	case ABINV:
+		return &inst{0x33, 0x1, 0x0, 1664, 0x34}, true
+	case ABINVI:
+		return &inst{0x13, 0x1, 0x0, 1664, 0x34}, true
+	case ABSET:
+		return &inst{0x33, 0x1, 0x0, 640, 0x14}, true
+	case ABSETI:
+		return &inst{0x13, 0x1, 0x0, 640, 0x14}, true
+	case ASHA256SIG0:
+		return &inst{0x13, 0x1, 0x2, 258, 0x8}, true
+	case ASHA256SIG1:
+		return &inst{0x13, 0x1, 0x3, 259, 0x8}, true
+	case ASHA256SUM0:
+		return &inst{0x13, 0x1, 0x0, 256, 0x8}, true
+	case ASHA256SUM1:
+		return &inst{0x13, 0x1, 0x1, 257, 0x8}, true
+	case ASHA512SIG0:
+		return &inst{0x13, 0x1, 0x6, 262, 0x8}, true
+	case ASHA512SIG1:
+		return &inst{0x13, 0x1, 0x7, 263, 0x8}, true
+	case ASHA512SUM0:
+		return &inst{0x13, 0x1, 0x4, 260, 0x8}, true
+	case ASHA512SUM1:
+		return &inst{0x13, 0x1, 0x5, 261, 0x8}, true
 	}
 	return nil, false
 }
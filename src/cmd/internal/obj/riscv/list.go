@@ -49,22 +49,22 @@ var (
 		REG_RA:   "RA",
 		REG_SP:   "SP",
 		REG_GP:   "GP",
-		// REG_TP is REG_G
-		REG_T0: "T0",
-		REG_T1: "T1",
-		REG_T2: "T2",
-		REG_S0: "S0",
-		REG_S1: "S1",
-		REG_A0: "A0",
-		REG_A1: "A1",
-		REG_A2: "A2",
-		REG_A3: "A3",
-		REG_A4: "A4",
-		REG_A5: "A5",
-		REG_A6: "A6",
-		REG_A7: "A7",
-		REG_S2: "S2",
-		REG_S3: "S3",
+		REG_TP:   "TP",
+		REG_T0:   "T0",
+		REG_T1:   "T1",
+		REG_T2:   "T2",
+		REG_S0:   "S0",
+		REG_S1:   "S1",
+		REG_A0:   "A0",
+		REG_A1:   "A1",
+		REG_A2:   "A2",
+		REG_A3:   "A3",
+		REG_A4:   "A4",
+		REG_A5:   "A5",
+		REG_A6:   "A6",
+		REG_A7:   "A7",
+		REG_S2:   "S2",
+		REG_S3:   "S3",
 		// REG_S4 is REG_CTXT.
 		REG_S5:  "S5",
 		REG_S6:  "S6",
@@ -72,10 +72,10 @@ var (
 		REG_S8:  "S8",
 		REG_S9:  "S9",
 		REG_S10: "S10",
-		REG_S11: "S11",
-		REG_T3:  "T3",
-		REG_T4:  "T4",
-		REG_T5:  "T5",
+		// REG_S11 is REG_G.
+		REG_T3: "T3",
+		REG_T4: "T4",
+		REG_T5: "T5",
 		// REG_T6 is REG_TMP.
 
 		// Go runtime register names.
@@ -239,6 +239,21 @@ func init() {
 	initInstructions()
 	obj.RegisterRegister(obj.RBaseRISCV, REG_END, PrettyPrintReg)
 	obj.RegisterOpcode(obj.ABaseRISCV, Anames)
+	obj.RegisterOpSuffix("riscv", roundingModeConv)
+	obj.RegisterOpSuffix("riscv32", roundingModeConv)
+}
+
+var roundingModeNames = [...]string{".RNE", ".RTZ", ".RDN", ".RUP", ".RMM"}
+
+// roundingModeConv formats a rounding mode set in Prog.Scond.
+func roundingModeConv(s uint8) string {
+	if s&RM_SET == 0 {
+		return ""
+	}
+	if rm := s &^ RM_SET; int(rm) < len(roundingModeNames) {
+		return roundingModeNames[rm]
+	}
+	return fmt.Sprintf(".RM???%d", s&^RM_SET)
 }
 
 func PrettyPrintReg(r int) string {
@@ -33,3 +33,12 @@ var LinkRISCV = obj.LinkArch{
 	Progedit:   progedit,
 	UnaryDst:   unaryDst,
 }
+
+var LinkRISCV32 = obj.LinkArch{
+	Arch:       sys.ArchRISCV32,
+	Preprocess: preprocess,
+	Assemble:   assemble,
+	Follow:     follow,
+	Progedit:   progedit,
+	UnaryDst:   unaryDst,
+}
@@ -37,6 +37,7 @@ var (
 	GOOS    = envOr("GOOS", defaultGOOS)
 	GO386   = envOr("GO386", defaultGO386)
 	GOARM   = goarm()
+	GORISCV = goriscv()
 	Version = version
 )
 
@@ -54,6 +55,48 @@ func goarm() int {
 	panic("unreachable")
 }
 
+// RISCVFeatures records the optional RISC-V extensions that
+// generated code may assume are present.
+type RISCVFeatures struct {
+	B bool // bit manipulation: Zba, Zbb and Zbs
+	C bool // compressed instructions
+}
+
+// String returns f in the form accepted by $GORISCV.
+func (f RISCVFeatures) String() string {
+	var exts []string
+	if f.B {
+		exts = append(exts, "b")
+	}
+	if f.C {
+		exts = append(exts, "c")
+	}
+	return strings.Join(exts, ",")
+}
+
+// goriscv parses $GORISCV, a comma-separated list of extension names.
+// An empty $GORISCV means the toolchain default; g names the base ISA
+// alone, so that it can be selected when the default is not empty.
+func goriscv() RISCVFeatures {
+	var f RISCVFeatures
+	v := envOr("GORISCV", defaultGORISCV)
+	if v == "" || v == "g" {
+		return f
+	}
+	for _, ext := range strings.Split(v, ",") {
+		switch ext {
+		case "b":
+			f.B = true
+		case "c":
+			f.C = true
+		default:
+			// Fail here, rather than validate at multiple call sites.
+			log.Fatalf("Invalid GORISCV value %q. Must be g or a comma-separated list of: b, c.", v)
+		}
+	}
+	return f
+}
+
 func Getgoextlinkenabled() string {
 	return envOr("GO_EXTLINK_ENABLED", defaultGO_EXTLINK_ENABLED)
 }
@@ -123,6 +166,11 @@ func (p *Prog) String() string {
 	}
 
 	sc := CConv(p.Scond)
+	if p.Ctxt.Arch != nil {
+		if cconv := opSuffixSpace[p.Ctxt.Arch.Name]; cconv != nil {
+			sc = cconv(p.Scond)
+		}
+	}
 
 	var buf bytes.Buffer
 
@@ -433,6 +481,16 @@ func regListConv(list int) string {
 	return str
 }
 
+// opSuffixSpace maps an architecture name to the pretty-printer
+// for its Prog.Scond. Architectures without one use CConv.
+var opSuffixSpace = map[string]func(uint8) string{}
+
+// RegisterOpSuffix binds a pretty-printer (CConv) for Prog.Scond
+// to the architecture arch.
+func RegisterOpSuffix(arch string, cconv func(uint8) string) {
+	opSuffixSpace[arch] = cconv
+}
+
 type opSet struct {
 	lo    As
 	names []string
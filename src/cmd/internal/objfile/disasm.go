@@ -15,6 +15,8 @@ import (
 	"strings"
 	"text/tabwriter"
 
+	"cmd/internal/riscv64asm"
+
 	"golang.org/x/arch/arm/armasm"
 	"golang.org/x/arch/ppc64/ppc64asm"
 	"golang.org/x/arch/x86/x86asm"
@@ -259,12 +261,37 @@ func disasm_ppc64(code []byte, pc uint64, lookup lookupFunc, byteOrder binary.By
 	return text, size
 }
 
+func disasm_riscv(code []byte, pc uint64, lookup lookupFunc, _ binary.ByteOrder) (string, int) {
+	return disasm_riscvx(code, pc, lookup, 64)
+}
+
+func disasm_riscv32(code []byte, pc uint64, lookup lookupFunc, _ binary.ByteOrder) (string, int) {
+	return disasm_riscvx(code, pc, lookup, 32)
+}
+
+func disasm_riscvx(code []byte, pc uint64, lookup lookupFunc, xlen int) (string, int) {
+	inst, err := riscv64asm.Decode(code, xlen)
+	var text string
+	size := inst.Len
+	if err != nil || size == 0 || inst.Op == 0 {
+		// Instructions are at least 2 bytes long, and may be
+		// compressed instructions at any 2-byte boundary.
+		size = 2
+		text = "?"
+	} else {
+		text = riscv64asm.GoSyntax(inst, pc, lookup)
+	}
+	return text, size
+}
+
 var disasms = map[string]disasmFunc{
 	"386":     disasm_386,
 	"amd64":   disasm_amd64,
 	"arm":     disasm_arm,
 	"ppc64":   disasm_ppc64,
 	"ppc64le": disasm_ppc64,
+	"riscv":   disasm_riscv,
+	"riscv32": disasm_riscv32,
 }
 
 var byteOrders = map[string]binary.ByteOrder{
@@ -273,6 +300,8 @@ var byteOrders = map[string]binary.ByteOrder{
 	"arm":     binary.LittleEndian,
 	"ppc64":   binary.BigEndian,
 	"ppc64le": binary.LittleEndian,
+	"riscv":   binary.LittleEndian,
+	"riscv32": binary.LittleEndian,
 	"s390x":   binary.BigEndian,
 }
 
@@ -104,6 +104,11 @@ func (f *elfFile) goarch() string {
 			return "ppc64le"
 		}
 		return "ppc64"
+	case elf.EM_RISCV:
+		if f.elf.Class == elf.ELFCLASS32 {
+			return "riscv32"
+		}
+		return "riscv"
 	case elf.EM_S390:
 		return "s390x"
 	}
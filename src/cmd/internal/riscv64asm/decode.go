@@ -0,0 +1,417 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// instFormat is a decoding rule for one specific instruction form.
+// A uint32 instruction x matches the rule if x&mask == value.
+// The args are stored in the same order as the instruction manual.
+type instFormat struct {
+	op    Op
+	mask  uint32
+	value uint32
+	args  [5]argType
+}
+
+// argType says how to extract an argument from an instruction.
+type argType uint8
+
+const (
+	_ argType = iota
+	arg_rd
+	arg_rs1
+	arg_rs2
+	arg_fd
+	arg_fs1
+	arg_fs2
+	arg_fs3
+	arg_imm12    // I-type signed immediate
+	arg_mem_i    // I-type immediate offset from rs1
+	arg_mem_s    // S-type immediate offset from rs1
+	arg_bimm     // B-type PC-relative branch target
+	arg_jimm     // J-type PC-relative jump target
+	arg_uimm20   // U-type upper immediate
+	arg_shamt6   // 6-bit shift amount
+	arg_shamt5   // 5-bit shift amount
+	arg_csr      // control and status register
+	arg_zimm     // 5-bit unsigned immediate in the rs1 field
+	arg_rm       // floating point rounding mode
+	arg_aqrl     // acquire and release bits
+	arg_amo_addr // address in rs1, with no offset
+	arg_pred     // fence predecessor set
+	arg_succ     // fence successor set
+)
+
+var (
+	errShort   = errors.New("truncated instruction")
+	errUnknown = errors.New("unknown instruction")
+	errXLEN    = errors.New("xlen must be 32 or 64")
+)
+
+// Decode decodes the leading bytes in src as a single instruction,
+// for a processor with xlen-bit registers: 32 for RV32 or 64 for RV64.
+// Compressed instructions are decoded as the base instruction they
+// expand to, with Len set to 2.
+func Decode(src []byte, xlen int) (Inst, error) {
+	if xlen != 32 && xlen != 64 {
+		return Inst{}, errXLEN
+	}
+	if len(src) < 2 {
+		return Inst{}, errShort
+	}
+	if c := binary.LittleEndian.Uint16(src); c&3 != 3 {
+		x, ok := expand(c, xlen)
+		if !ok {
+			return Inst{}, errUnknown
+		}
+		inst, err := decode32(x, xlen)
+		if err != nil {
+			return Inst{}, err
+		}
+		inst.Enc = uint32(c)
+		inst.Len = 2
+		return inst, nil
+	}
+	if len(src) < 4 {
+		return Inst{}, errShort
+	}
+	return decode32(binary.LittleEndian.Uint32(src), xlen)
+}
+
+func decode32(x uint32, xlen int) (Inst, error) {
+	for _, f := range instFormats {
+		if x&f.mask != f.value {
+			continue
+		}
+		if xlen == 32 && rv64Only(f.op, x) || xlen == 64 && rv32Only(f.op, x) {
+			break
+		}
+		inst := Inst{Op: f.op, Enc: x, Len: 4, XLEN: xlen}
+		n := 0
+		for _, a := range f.args {
+			if a == 0 {
+				break
+			}
+			arg := decodeArg(a, x)
+			if arg == nil {
+				continue
+			}
+			inst.Args[n] = arg
+			n++
+		}
+		return inst, nil
+	}
+	return Inst{}, errUnknown
+}
+
+// rv64Only reports whether the instruction x, matching op, exists
+// only on RV64.
+func rv64Only(op Op, x uint32) bool {
+	switch op {
+	case SLLI, SRLI, SRAI, RORI, BCLRI, BEXTI, BINVI, BSETI:
+		// On RV32, a shift amount of 32 or more is reserved.
+		return x>>25&1 != 0
+	case ZEXT_H:
+		return x&0x7f == 0x3b
+	case REV8:
+		return x>>20 == 0x6b8
+	case LD, LWU, SD,
+		ADDIW, SLLIW, SRLIW, SRAIW, ADDW, SUBW, SLLW, SRLW, SRAW,
+		MULW, DIVW, DIVUW, REMW, REMUW,
+		LR_D, SC_D, AMOSWAP_D, AMOADD_D, AMOXOR_D, AMOAND_D, AMOOR_D,
+		AMOMIN_D, AMOMAX_D, AMOMINU_D, AMOMAXU_D,
+		FCVT_L_S, FCVT_S_L, FCVT_LU_S, FCVT_S_LU,
+		FCVT_L_D, FCVT_D_L, FCVT_LU_D, FCVT_D_LU, FMV_X_D, FMV_D_X,
+		ADD_UW, SH1ADD_UW, SH2ADD_UW, SH3ADD_UW, SLLI_UW,
+		CLZW, CTZW, CPOPW, ROLW, RORW, RORIW,
+		SHA512SIG0, SHA512SIG1, SHA512SUM0, SHA512SUM1:
+		return true
+	}
+	return false
+}
+
+// rv32Only reports whether the instruction x, matching op, exists
+// only on RV32. ZEXT.H and REV8 have a different encoding on each.
+func rv32Only(op Op, x uint32) bool {
+	switch op {
+	case ZEXT_H:
+		return x&0x7f == 0x33
+	case REV8:
+		return x>>20 == 0x698
+	}
+	return false
+}
+
+// decodeArg extracts the argument described by a from x.
+// It returns nil for arguments that are absent, such as
+// AMO ordering bits that are both clear.
+func decodeArg(a argType, x uint32) Arg {
+	switch a {
+	case arg_rd:
+		return X0 + Reg(x>>7&31)
+	case arg_rs1:
+		return X0 + Reg(x>>15&31)
+	case arg_rs2:
+		return X0 + Reg(x>>20&31)
+	case arg_fd:
+		return F0 + Reg(x>>7&31)
+	case arg_fs1:
+		return F0 + Reg(x>>15&31)
+	case arg_fs2:
+		return F0 + Reg(x>>20&31)
+	case arg_fs3:
+		return F0 + Reg(x>>27)
+	case arg_imm12:
+		return Imm(int32(x) >> 20)
+	case arg_mem_i:
+		return Mem{X0 + Reg(x>>15&31), int32(x) >> 20}
+	case arg_mem_s:
+		return Mem{X0 + Reg(x>>15&31), int32(x)>>25<<5 | int32(x>>7&31)}
+	case arg_bimm:
+		off := int32(x)>>31<<12 | int32(x>>7&1)<<11 | int32(x>>25&0x3f)<<5 | int32(x>>8&0xf)<<1
+		return PCRel(off)
+	case arg_jimm:
+		off := int32(x)>>31<<20 | int32(x>>12&0xff)<<12 | int32(x>>20&1)<<11 | int32(x>>21&0x3ff)<<1
+		return PCRel(off)
+	case arg_uimm20:
+		return Imm(x >> 12)
+	case arg_shamt6:
+		return Imm(x >> 20 & 63)
+	case arg_shamt5:
+		return Imm(x >> 20 & 31)
+	case arg_csr:
+		return CSR(x >> 20)
+	case arg_zimm:
+		return Imm(x >> 15 & 31)
+	case arg_rm:
+		return RoundingMode(x >> 12 & 7)
+	case arg_aqrl:
+		if o := MemOrder(x >> 25 & 3); o != 0 {
+			return o
+		}
+		return nil
+	case arg_amo_addr:
+		return Mem{X0 + Reg(x>>15&31), 0}
+	case arg_pred:
+		return FenceSet(x >> 24 & 15)
+	case arg_succ:
+		return FenceSet(x >> 20 & 15)
+	}
+	return nil
+}
+
+// Helpers for building 32-bit encodings out of compressed instructions.
+
+func encR(opcode, rd, funct3, rs1, rs2, funct7 uint32) uint32 {
+	return funct7<<25 | rs2<<20 | rs1<<15 | funct3<<12 | rd<<7 | opcode
+}
+
+func encI(opcode, rd, funct3, rs1 uint32, imm int32) uint32 {
+	return uint32(imm)<<20 | rs1<<15 | funct3<<12 | rd<<7 | opcode
+}
+
+func encS(opcode, funct3, rs1, rs2 uint32, imm int32) uint32 {
+	u := uint32(imm)
+	return (u>>5&0x7f)<<25 | rs2<<20 | rs1<<15 | funct3<<12 | (u&0x1f)<<7 | opcode
+}
+
+func encB(funct3, rs1, rs2 uint32, imm int32) uint32 {
+	u := uint32(imm)
+	return (u>>12&1)<<31 | (u>>5&0x3f)<<25 | rs2<<20 | rs1<<15 | funct3<<12 | (u>>1&0xf)<<8 | (u>>11&1)<<7 | 0x63
+}
+
+func encJ(rd uint32, imm int32) uint32 {
+	u := uint32(imm)
+	return (u>>20&1)<<31 | (u>>1&0x3ff)<<21 | (u>>11&1)<<20 | (u>>12&0xff)<<12 | rd<<7 | 0x6f
+}
+
+// cbit returns bit i of c shifted to bit position j.
+func cbit(c uint16, i, j uint) uint32 {
+	return uint32(c>>i&1) << j
+}
+
+// sext sign extends the low n bits of x.
+func sext(x uint32, n uint) int32 {
+	return int32(x<<(32-n)) >> (32 - n)
+}
+
+// expand returns the 32-bit instruction that the compressed
+// instruction c expands to. Some encodings mean different
+// instructions on RV32 and RV64.
+func expand(c uint16, xlen int) (uint32, bool) {
+	const (
+		sp = 2
+		ra = 1
+	)
+	funct3 := c >> 13 & 7
+	rd := uint32(c >> 7 & 31)
+	rs2 := uint32(c >> 2 & 31)
+	rdp := uint32(c>>2&7) + 8  // rd' and rs2'
+	rs1p := uint32(c>>7&7) + 8 // rs1' and rd'
+	imm6 := sext(cbit(c, 12, 5)|uint32(c>>2&31), 6)
+
+	// Offsets for the compressed loads and stores.
+	ldOff := int32(c>>10&7)<<3 | int32(c>>5&3)<<6
+	lwOff := int32(c>>10&7)<<3 | int32(cbit(c, 6, 2)|cbit(c, 5, 6))
+	ldspOff := int32(cbit(c, 12, 5)) | int32(c>>5&3)<<3 | int32(c>>2&7)<<6
+	lwspOff := int32(cbit(c, 12, 5)) | int32(c>>4&7)<<2 | int32(c>>2&3)<<6
+	sdspOff := int32(c>>10&7)<<3 | int32(c>>7&7)<<6
+	swspOff := int32(c>>9&15)<<2 | int32(c>>7&3)<<6
+
+	// Offset for C.J and C.JAL.
+	cjOff := sext(cbit(c, 12, 11)|cbit(c, 11, 4)|uint32(c>>9&3)<<8|cbit(c, 8, 10)|
+		cbit(c, 7, 6)|cbit(c, 6, 7)|uint32(c>>3&7)<<1|cbit(c, 2, 5), 12)
+
+	switch c & 3 {
+	case 0:
+		switch funct3 {
+		case 0: // C.ADDI4SPN
+			imm := int32(c>>11&3)<<4 | int32(c>>7&15)<<6 | int32(cbit(c, 6, 2)|cbit(c, 5, 3))
+			if imm == 0 {
+				return 0, false
+			}
+			return encI(0x13, rdp, 0, sp, imm), true
+		case 1: // C.FLD
+			return encI(0x07, rdp, 3, rs1p, ldOff), true
+		case 2: // C.LW
+			return encI(0x03, rdp, 2, rs1p, lwOff), true
+		case 3:
+			if xlen == 32 { // C.FLW
+				return encI(0x07, rdp, 2, rs1p, lwOff), true
+			}
+			// C.LD
+			return encI(0x03, rdp, 3, rs1p, ldOff), true
+		case 5: // C.FSD
+			return encS(0x27, 3, rs1p, rdp, ldOff), true
+		case 6: // C.SW
+			return encS(0x23, 2, rs1p, rdp, lwOff), true
+		case 7:
+			if xlen == 32 { // C.FSW
+				return encS(0x27, 2, rs1p, rdp, lwOff), true
+			}
+			// C.SD
+			return encS(0x23, 3, rs1p, rdp, ldOff), true
+		}
+
+	case 1:
+		switch funct3 {
+		case 0: // C.ADDI, C.NOP
+			return encI(0x13, rd, 0, rd, imm6), true
+		case 1:
+			if xlen == 32 { // C.JAL
+				return encJ(ra, cjOff), true
+			}
+			// C.ADDIW
+			if rd == 0 {
+				return 0, false
+			}
+			return encI(0x1b, rd, 0, rd, imm6), true
+		case 2: // C.LI
+			return encI(0x13, rd, 0, 0, imm6), true
+		case 3:
+			if rd == sp { // C.ADDI16SP
+				imm := sext(cbit(c, 12, 9)|cbit(c, 6, 4)|cbit(c, 5, 6)|uint32(c>>3&3)<<7|cbit(c, 2, 5), 10)
+				if imm == 0 {
+					return 0, false
+				}
+				return encI(0x13, sp, 0, sp, imm), true
+			}
+			// C.LUI
+			if imm6 == 0 {
+				return 0, false
+			}
+			return uint32(imm6)<<12 | rd<<7 | 0x37, true
+		case 4:
+			shamt := int32(cbit(c, 12, 5) | uint32(c>>2&31))
+			switch c >> 10 & 3 {
+			case 0: // C.SRLI
+				return encI(0x13, rs1p, 5, rs1p, shamt), true
+			case 1: // C.SRAI
+				return encI(0x13, rs1p, 5, rs1p, shamt|0x400), true
+			case 2: // C.ANDI
+				return encI(0x13, rs1p, 7, rs1p, imm6), true
+			}
+			switch c>>10&4 | c>>5&3 {
+			case 0: // C.SUB
+				return encR(0x33, rs1p, 0, rs1p, rdp, 0x20), true
+			case 1: // C.XOR
+				return encR(0x33, rs1p, 4, rs1p, rdp, 0), true
+			case 2: // C.OR
+				return encR(0x33, rs1p, 6, rs1p, rdp, 0), true
+			case 3: // C.AND
+				return encR(0x33, rs1p, 7, rs1p, rdp, 0), true
+			case 4: // C.SUBW
+				if xlen == 32 {
+					return 0, false
+				}
+				return encR(0x3b, rs1p, 0, rs1p, rdp, 0x20), true
+			case 5: // C.ADDW
+				if xlen == 32 {
+					return 0, false
+				}
+				return encR(0x3b, rs1p, 0, rs1p, rdp, 0), true
+			}
+		case 5: // C.J
+			return encJ(0, cjOff), true
+		case 6, 7: // C.BEQZ, C.BNEZ
+			off := sext(cbit(c, 12, 8)|uint32(c>>10&3)<<3|uint32(c>>5&3)<<6|uint32(c>>3&3)<<1|cbit(c, 2, 5), 9)
+			return encB(uint32(funct3-6), rs1p, 0, off), true
+		}
+
+	case 2:
+		switch funct3 {
+		case 0: // C.SLLI
+			shamt := int32(cbit(c, 12, 5) | uint32(c>>2&31))
+			return encI(0x13, rd, 1, rd, shamt), true
+		case 1: // C.FLDSP
+			return encI(0x07, rd, 3, sp, ldspOff), true
+		case 2: // C.LWSP
+			if rd == 0 {
+				return 0, false
+			}
+			return encI(0x03, rd, 2, sp, lwspOff), true
+		case 3:
+			if xlen == 32 { // C.FLWSP
+				return encI(0x07, rd, 2, sp, lwspOff), true
+			}
+			// C.LDSP
+			if rd == 0 {
+				return 0, false
+			}
+			return encI(0x03, rd, 3, sp, ldspOff), true
+		case 4:
+			switch {
+			case c>>12&1 == 0 && rs2 == 0: // C.JR
+				if rd == 0 {
+					return 0, false
+				}
+				return encI(0x67, 0, 0, rd, 0), true
+			case c>>12&1 == 0: // C.MV
+				return encR(0x33, rd, 0, 0, rs2, 0), true
+			case rd == 0 && rs2 == 0: // C.EBREAK
+				return 0x00100073, true
+			case rs2 == 0: // C.JALR
+				return encI(0x67, ra, 0, rd, 0), true
+			default: // C.ADD
+				return encR(0x33, rd, 0, rd, rs2, 0), true
+			}
+		case 5: // C.FSDSP
+			return encS(0x27, 3, sp, rs2, sdspOff), true
+		case 6: // C.SWSP
+			return encS(0x23, 2, sp, rs2, swspOff), true
+		case 7:
+			if xlen == 32 { // C.FSWSP
+				return encS(0x27, 2, sp, rs2, swspOff), true
+			}
+			// C.SDSP
+			return encS(0x23, 3, sp, rs2, sdspOff), true
+		}
+	}
+	return 0, false
+}
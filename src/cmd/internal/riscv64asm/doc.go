@@ -0,0 +1,11 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package riscv64asm implements decoding of RISC-V machine code.
+//
+// It covers RV64IMAFD and RV32IMAFD, the compressed (C) instructions, the
+// Zba, Zbb and Zbs bit-manipulation extensions, the Zknh SHA-2
+// instructions, the CSR instructions, and the SRET and WFI instructions
+// of the ratified privileged architecture.
+package riscv64asm
@@ -0,0 +1,179 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
+// This includes the pseudo-instructions that objdump prints by default, such as li, mv and ret.
+func GNUSyntax(inst Inst) string {
+	if inst.Op == 0 {
+		return "error: unknown instruction"
+	}
+	op := inst.Op.String()
+	args := inst.Args[:]
+	for i, a := range args {
+		if a == nil {
+			args = args[:i]
+			break
+		}
+	}
+
+	switch inst.Op {
+	case ADDI:
+		rd, rs, imm := args[0].(Reg), args[1].(Reg), args[2].(Imm)
+		switch {
+		case rd == X0 && rs == X0 && imm == 0:
+			return "nop"
+		case rs == X0:
+			op, args = "li", []Arg{rd, imm}
+		case imm == 0:
+			op, args = "mv", args[:2]
+		}
+	case ADD:
+		if args[1] == X0 {
+			op, args = "mv", []Arg{args[0], args[2]}
+		}
+	case ADDIW:
+		if args[2].(Imm) == 0 {
+			op, args = "sext.w", args[:2]
+		}
+	case XORI:
+		if args[2].(Imm) == -1 {
+			op, args = "not", args[:2]
+		}
+	case SLTIU:
+		if args[2].(Imm) == 1 {
+			op, args = "seqz", args[:2]
+		}
+	case SUB, SUBW:
+		if args[1] == X0 {
+			op, args = map[Op]string{SUB: "neg", SUBW: "negw"}[inst.Op], []Arg{args[0], args[2]}
+		}
+	case SLTU:
+		if args[1] == X0 {
+			op, args = "snez", []Arg{args[0], args[2]}
+		}
+	case SLT:
+		switch {
+		case args[2] == X0:
+			op, args = "sltz", args[:2]
+		case args[1] == X0:
+			op, args = "sgtz", []Arg{args[0], args[2]}
+		}
+	case BEQ, BNE, BLT, BGE:
+		rs1, rs2 := args[0].(Reg), args[1].(Reg)
+		switch {
+		case rs2 == X0:
+			op, args = map[Op]string{BEQ: "beqz", BNE: "bnez", BLT: "bltz", BGE: "bgez"}[inst.Op], []Arg{rs1, args[2]}
+		case rs1 == X0 && inst.Op == BLT:
+			op, args = "bgtz", []Arg{rs2, args[2]}
+		case rs1 == X0 && inst.Op == BGE:
+			op, args = "blez", []Arg{rs2, args[2]}
+		}
+	case JAL:
+		switch args[0] {
+		case X0:
+			op, args = "j", args[1:]
+		case X1:
+			args = args[1:]
+		}
+	case JALR:
+		rd, m := args[0].(Reg), args[1].(Mem)
+		switch {
+		case rd == X0 && m == Mem{X1, 0}:
+			return "ret"
+		case rd == X0 && m.Offset == 0:
+			op, args = "jr", []Arg{m.Base}
+		case rd == X1 && m.Offset == 0:
+			args = []Arg{m.Base}
+		}
+	case FSGNJ_S, FSGNJ_D, FSGNJN_S, FSGNJN_D, FSGNJX_S, FSGNJX_D:
+		if args[1] == args[2] {
+			alias := map[Op]string{
+				FSGNJ_S: "fmv.s", FSGNJ_D: "fmv.d",
+				FSGNJN_S: "fneg.s", FSGNJN_D: "fneg.d",
+				FSGNJX_S: "fabs.s", FSGNJX_D: "fabs.d",
+			}
+			op, args = alias[inst.Op], args[:2]
+		}
+	case CSRRS:
+		rd, csr, rs := args[0].(Reg), args[1].(CSR), args[2].(Reg)
+		if rs == X0 {
+			switch csr {
+			case 0xc00, 0xc01, 0xc02:
+				return "rd" + csr.String() + " " + rd.String()
+			case 0x001, 0x002, 0x003:
+				return map[CSR]string{1: "frflags", 2: "frrm", 3: "frcsr"}[csr] + " " + rd.String()
+			}
+			op, args = "csrr", args[:2]
+			break
+		}
+		if rd == X0 {
+			op, args = "csrs", args[1:]
+		}
+	case CSRRW:
+		rd, csr := args[0].(Reg), args[1].(CSR)
+		switch csr {
+		case 0x001, 0x002, 0x003:
+			op = map[CSR]string{1: "fsflags", 2: "fsrm", 3: "fscsr"}[csr]
+			args = []Arg{rd, args[2]}
+			if rd == X0 {
+				args = args[1:]
+			}
+		default:
+			if rd == X0 {
+				op, args = "csrw", args[1:]
+			}
+		}
+	case CSRRC, CSRRWI, CSRRSI, CSRRCI:
+		if args[0] == X0 {
+			op, args = map[Op]string{CSRRC: "csrc", CSRRWI: "csrwi", CSRRSI: "csrsi", CSRRCI: "csrci"}[inst.Op], args[1:]
+		}
+	case FENCE:
+		if args[0] == FenceI|FenceO|FenceR|FenceW && args[1] == FenceI|FenceO|FenceR|FenceW {
+			return "fence"
+		}
+	}
+
+	var strs []string
+	for _, a := range args {
+		switch a := a.(type) {
+		case MemOrder:
+			// The ordering bits are a suffix of the mnemonic.
+			op += "." + a.String()
+			continue
+		case RoundingMode:
+			// The dynamic rounding mode is the default.
+			if a == DYN {
+				continue
+			}
+		case Imm:
+			if inst.Op == LUI || inst.Op == AUIPC {
+				strs = append(strs, fmt.Sprintf("%#x", int64(a)))
+				continue
+			}
+		case Mem:
+			if isAMO(inst.Op) {
+				strs = append(strs, "("+a.Base.String()+")")
+				continue
+			}
+		}
+		strs = append(strs, a.String())
+	}
+	if len(strs) == 0 {
+		return op
+	}
+	return op + " " + strings.Join(strs, ",")
+}
+
+// isAMO reports whether op is an atomic memory operation,
+// including LR and SC.
+func isAMO(op Op) bool {
+	return LR_W <= op && op <= AMOMAXU_D
+}
@@ -0,0 +1,312 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+type Inst struct {
+	Op   Op     // Opcode mnemonic
+	Enc  uint32 // Raw encoding bits
+	Len  int    // Length of encoding in bytes: 2 for compressed instructions, 4 otherwise
+	XLEN int    // Register width the instruction was decoded for: 32 or 64
+	Args Args   // Instruction arguments, in RISC-V ISA manual order
+}
+
+func (i Inst) String() string {
+	var buf bytes.Buffer
+	buf.WriteString(i.Op.String())
+	for j, arg := range i.Args {
+		if arg == nil {
+			break
+		}
+		if j == 0 {
+			buf.WriteString(" ")
+		} else {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(arg.String())
+	}
+	return buf.String()
+}
+
+// An Op is an instruction operation.
+type Op uint16
+
+func (o Op) String() string {
+	if int(o) >= len(opstr) || opstr[o] == "" {
+		return fmt.Sprintf("Op(%d)", int(o))
+	}
+	return opstr[o]
+}
+
+// An Arg is a single instruction argument, one of these types: Reg, Imm,
+// Mem, PCRel, CSR, RoundingMode, MemOrder, or FenceSet.
+type Arg interface {
+	IsArg()
+	String() string
+}
+
+// An Args holds the instruction arguments.
+// If an instruction has fewer than 5 arguments,
+// the final elements in the array are nil.
+type Args [5]Arg
+
+// A Reg is a single register. The zero value is X0, not the absence of a
+// register.
+type Reg uint16
+
+const (
+	X0 Reg = iota
+	X1
+	X2
+	X3
+	X4
+	X5
+	X6
+	X7
+	X8
+	X9
+	X10
+	X11
+	X12
+	X13
+	X14
+	X15
+	X16
+	X17
+	X18
+	X19
+	X20
+	X21
+	X22
+	X23
+	X24
+	X25
+	X26
+	X27
+	X28
+	X29
+	X30
+	X31
+
+	F0
+	F1
+	F2
+	F3
+	F4
+	F5
+	F6
+	F7
+	F8
+	F9
+	F10
+	F11
+	F12
+	F13
+	F14
+	F15
+	F16
+	F17
+	F18
+	F19
+	F20
+	F21
+	F22
+	F23
+	F24
+	F25
+	F26
+	F27
+	F28
+	F29
+	F30
+	F31
+)
+
+var intRegNames = [...]string{
+	"zero", "ra", "sp", "gp", "tp", "t0", "t1", "t2",
+	"s0", "s1", "a0", "a1", "a2", "a3", "a4", "a5",
+	"a6", "a7", "s2", "s3", "s4", "s5", "s6", "s7",
+	"s8", "s9", "s10", "s11", "t3", "t4", "t5", "t6",
+}
+
+var floatRegNames = [...]string{
+	"ft0", "ft1", "ft2", "ft3", "ft4", "ft5", "ft6", "ft7",
+	"fs0", "fs1", "fa0", "fa1", "fa2", "fa3", "fa4", "fa5",
+	"fa6", "fa7", "fs2", "fs3", "fs4", "fs5", "fs6", "fs7",
+	"fs8", "fs9", "fs10", "fs11", "ft8", "ft9", "ft10", "ft11",
+}
+
+func (Reg) IsArg() {}
+
+// String returns the ABI name of the register.
+func (r Reg) String() string {
+	switch {
+	case r <= X31:
+		return intRegNames[r]
+	case r <= F31:
+		return floatRegNames[r-F0]
+	}
+	return fmt.Sprintf("Reg(%d)", int(r))
+}
+
+// IsFloat reports whether r is a floating point register.
+func (r Reg) IsFloat() bool {
+	return F0 <= r && r <= F31
+}
+
+// Imm is an integer immediate.
+type Imm int64
+
+func (Imm) IsArg() {}
+func (i Imm) String() string {
+	return fmt.Sprintf("%d", int64(i))
+}
+
+// A Mem is a memory reference made up of a base register and an offset.
+type Mem struct {
+	Base   Reg
+	Offset int32
+}
+
+func (Mem) IsArg() {}
+func (m Mem) String() string {
+	return fmt.Sprintf("%d(%s)", m.Offset, m.Base)
+}
+
+// PCRel is a PC-relative offset, used in branch and jump instructions.
+type PCRel int32
+
+func (PCRel) IsArg() {}
+func (r PCRel) String() string {
+	return fmt.Sprintf(".%+#x", int32(r))
+}
+
+// A CSR is a control and status register number.
+type CSR uint16
+
+var csrNames = map[CSR]string{
+	0x001: "fflags",
+	0x002: "frm",
+	0x003: "fcsr",
+	0xc00: "cycle",
+	0xc01: "time",
+	0xc02: "instret",
+	0xc80: "cycleh",
+	0xc81: "timeh",
+	0xc82: "instreth",
+	0x100: "sstatus",
+	0x104: "sie",
+	0x105: "stvec",
+	0x140: "sscratch",
+	0x141: "sepc",
+	0x142: "scause",
+	0x143: "sbadaddr",
+	0x144: "sip",
+	0x180: "sptbr",
+	0x300: "mstatus",
+	0x301: "misa",
+	0x302: "medeleg",
+	0x303: "mideleg",
+	0x304: "mie",
+	0x305: "mtvec",
+	0x340: "mscratch",
+	0x341: "mepc",
+	0x342: "mcause",
+	0x343: "mbadaddr",
+	0x344: "mip",
+	0xf11: "mvendorid",
+	0xf12: "marchid",
+	0xf13: "mimpid",
+	0xf14: "mhartid",
+}
+
+func (CSR) IsArg() {}
+
+// String returns the name of the CSR, or its number if it has no name.
+func (c CSR) String() string {
+	if s, ok := csrNames[c]; ok {
+		return s
+	}
+	return fmt.Sprintf("%#x", uint16(c))
+}
+
+// A RoundingMode is the rounding mode field of a floating point
+// instruction.
+type RoundingMode uint8
+
+const (
+	RNE RoundingMode = 0 // round to nearest, ties to even
+	RTZ RoundingMode = 1 // round towards zero
+	RDN RoundingMode = 2 // round down
+	RUP RoundingMode = 3 // round up
+	RMM RoundingMode = 4 // round to nearest, ties to max magnitude
+	DYN RoundingMode = 7 // dynamic rounding mode, from frm
+)
+
+func (RoundingMode) IsArg() {}
+func (rm RoundingMode) String() string {
+	switch rm {
+	case RNE:
+		return "rne"
+	case RTZ:
+		return "rtz"
+	case RDN:
+		return "rdn"
+	case RUP:
+		return "rup"
+	case RMM:
+		return "rmm"
+	case DYN:
+		return "dyn"
+	}
+	return fmt.Sprintf("RoundingMode(%d)", int(rm))
+}
+
+// A MemOrder holds the acquire and release bits of an atomic memory
+// operation.
+type MemOrder uint8
+
+const (
+	RL MemOrder = 1 << 0 // release
+	AQ MemOrder = 1 << 1 // acquire
+)
+
+func (MemOrder) IsArg() {}
+func (o MemOrder) String() string {
+	var s string
+	if o&AQ != 0 {
+		s += "aq"
+	}
+	if o&RL != 0 {
+		s += "rl"
+	}
+	return s
+}
+
+// A FenceSet is the predecessor or successor set of a FENCE instruction.
+type FenceSet uint8
+
+const (
+	FenceW FenceSet = 1 << iota // memory writes
+	FenceR                      // memory reads
+	FenceO                      // device output
+	FenceI                      // device input
+)
+
+func (FenceSet) IsArg() {}
+func (f FenceSet) String() string {
+	var s []string
+	for i, c := range "iorw" {
+		if f&(FenceI>>uint(i)) != 0 {
+			s = append(s, string(c))
+		}
+	}
+	return strings.Join(s, "")
+}
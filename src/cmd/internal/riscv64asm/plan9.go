@@ -0,0 +1,235 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// GoSyntax returns the Go assembler syntax for the instruction.
+// The pc is the program counter of the instruction, used for expanding
+// PC-relative addresses into absolute ones.
+// The symname function queries the symbol table for the program
+// being disassembled. It returns the name and base address of the symbol
+// containing the target, if any; otherwise it returns "", 0.
+func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64)) string {
+	if symname == nil {
+		symname = func(uint64) (string, uint64) { return "", 0 }
+	}
+	if inst.Op == 0 {
+		return "?"
+	}
+	var args []Arg
+	rm := defaultRoundingMode(inst.Op)
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		switch a := a.(type) {
+		case RoundingMode:
+			// Printed as an opcode suffix, below.
+			rm = a
+			continue
+		case MemOrder:
+			// Not expressible in Go assembly.
+			continue
+		}
+		args = append(args, a)
+	}
+
+	op := plan9OpMap[inst.Op]
+	if inst.XLEN == 32 && (inst.Op == LW || inst.Op == SW) {
+		// A word is a register on RV32.
+		op = "MOV"
+	}
+	if op == "" {
+		op = strings.ToUpper(strings.Replace(inst.Op.String(), ".", "", -1))
+	}
+	if rm != defaultRoundingMode(inst.Op) && rm <= RMM {
+		// The Go assembler takes a rounding mode other than its
+		// default as a suffix, as in FCVTLD.RNE. The dynamic
+		// rounding mode has no Go syntax.
+		op += "." + strings.ToUpper(rm.String())
+	}
+
+	switch inst.Op {
+	case ADDI, ADDIW:
+		if args[1] == X0 && inst.Op == ADDI && args[0] == X0 && args[2] == Imm(0) {
+			return "NOP"
+		}
+		if args[1] == X0 {
+			// MOV $c, R is assembled as ADDIW $c, ZERO, R.
+			return "MOV " + plan9Arg(args[2], pc, symname) + ", " + plan9Arg(args[0], pc, symname)
+		}
+		if inst.Op == ADDI && args[2] == Imm(0) {
+			return "MOV " + plan9Arg(args[1], pc, symname) + ", " + plan9Arg(args[0], pc, symname)
+		}
+	case ADD:
+		if args[1] == X0 {
+			// C.MV expands to ADD rs2, ZERO, rd.
+			return "MOV " + plan9Arg(args[2], pc, symname) + ", " + plan9Arg(args[0], pc, symname)
+		}
+	case SLTIU:
+		if args[2] == Imm(1) {
+			op, args = "SEQZ", args[:2]
+		}
+	case SLTU:
+		if args[1] == X0 {
+			op, args = "SNEZ", []Arg{args[0], args[2]}
+		}
+	case JAL:
+		switch args[0] {
+		case X0:
+			return "JMP " + plan9Arg(args[1], pc, symname)
+		case X1:
+			return "CALL " + plan9Arg(args[1], pc, symname)
+		}
+		return op + " " + plan9Args(args, pc, symname)
+	case JALR:
+		switch {
+		case args[0] == X0 && args[1] == Mem{X1, 0}:
+			return "RET"
+		case args[0] == X0:
+			return "JMP " + plan9Arg(args[1], pc, symname)
+		case args[0] == X1:
+			return "CALL " + plan9Arg(args[1], pc, symname)
+		}
+		return op + " " + plan9Args(args, pc, symname)
+	case BEQ, BNE, BLT, BGE, BLTU, BGEU:
+		return op + " " + plan9Args(args, pc, symname)
+	case SB, SH, SW, SD, FSW, FSD:
+		return op + " " + plan9Args(args, pc, symname)
+	case FSGNJ_S, FSGNJ_D, FSGNJN_S, FSGNJN_D:
+		if args[1] == args[2] {
+			alias := map[Op]string{FSGNJ_S: "MOVF", FSGNJ_D: "MOVD", FSGNJN_S: "FNEGS", FSGNJN_D: "FNEGD"}
+			op, args = alias[inst.Op], args[:2]
+		}
+	case CSRRW, CSRRS, CSRRC, CSRRWI, CSRRSI, CSRRCI:
+		if inst.Op == CSRRS && args[2] == X0 {
+			switch args[1] {
+			case CSR(0xc00):
+				return "RDCYCLE " + plan9Arg(args[0], pc, symname)
+			case CSR(0xc01):
+				return "RDTIME " + plan9Arg(args[0], pc, symname)
+			case CSR(0xc02):
+				return "RDINSTRET " + plan9Arg(args[0], pc, symname)
+			}
+		}
+		// The Go assembler takes the CSR number first:
+		// CSRRS $csr, rs1, rd.
+		args[1] = Imm(args[1].(CSR))
+		return op + " " + plan9Args([]Arg{args[1], args[2], args[0]}, pc, symname)
+	case LUI, AUIPC:
+		// The Go assembler takes a signed 20-bit immediate.
+		args[1] = Imm(int64(args[1].(Imm)) << 44 >> 44)
+	}
+
+	if len(args) == 0 {
+		return op
+	}
+	if isAMO(inst.Op) {
+		// LR, SC and the AMOs keep the address after the source
+		// register: AMOADDW rs2, (rs1), rd.
+		return op + " " + plan9Args(append(args[1:len(args):len(args)], args[0]), pc, symname)
+	}
+	// The remaining instructions take their operands in the
+	// reverse of the RISC-V manual order: ADD rs2, rs1, rd.
+	rev := make([]Arg, len(args))
+	for i, a := range args {
+		rev[len(args)-1-i] = a
+	}
+	return op + " " + plan9Args(rev, pc, symname)
+}
+
+// defaultRoundingMode returns the rounding mode the Go assembler uses
+// for op when none is given: conversions to an integer round toward
+// zero, as Go requires, and everything else rounds to nearest.
+func defaultRoundingMode(op Op) RoundingMode {
+	switch op {
+	case FCVT_W_S, FCVT_L_S, FCVT_WU_S, FCVT_LU_S, FCVT_W_D, FCVT_L_D, FCVT_WU_D, FCVT_LU_D:
+		return RTZ
+	}
+	return RNE
+}
+
+func plan9Args(args []Arg, pc uint64, symname func(uint64) (string, uint64)) string {
+	strs := make([]string, len(args))
+	for i, a := range args {
+		strs[i] = plan9Arg(a, pc, symname)
+	}
+	return strings.Join(strs, ", ")
+}
+
+func plan9Arg(arg Arg, pc uint64, symname func(uint64) (string, uint64)) string {
+	switch a := arg.(type) {
+	case Reg:
+		return plan9Reg(a)
+	case Imm:
+		return fmt.Sprintf("$%d", int64(a))
+	case Mem:
+		if a.Offset == 0 {
+			return fmt.Sprintf("(%s)", plan9Reg(a.Base))
+		}
+		return fmt.Sprintf("%d(%s)", a.Offset, plan9Reg(a.Base))
+	case PCRel:
+		addr := pc + uint64(int64(a))
+		if s, base := symname(addr); s != "" && base == addr {
+			return fmt.Sprintf("%s(SB)", s)
+		}
+		return fmt.Sprintf("%#x", addr)
+	case CSR:
+		return strings.ToUpper(a.String())
+	case FenceSet:
+		return fmt.Sprintf("$%d", int(a))
+	}
+	return strings.ToUpper(arg.String())
+}
+
+// plan9Reg returns the Go assembler name of r, which uses the ABI
+// names plus the registers reserved by the Go toolchain.
+func plan9Reg(r Reg) string {
+	switch r {
+	case X20:
+		return "CTXT"
+	case X27:
+		return "g"
+	case X31:
+		return "TMP"
+	}
+	return strings.ToUpper(r.String())
+}
+
+// plan9OpMap maps an Op to its Go assembler mnemonic, if different than
+// its GNU mnemonic with the dots removed.
+var plan9OpMap = map[Op]string{
+	ADDI:  "ADD",
+	SLTI:  "SLT",
+	SLTIU: "SLTU",
+	ANDI:  "AND",
+	ORI:   "OR",
+	XORI:  "XOR",
+	SLLI:  "SLL",
+	SRLI:  "SRL",
+	SRAI:  "SRA",
+	LB:    "MOVB",
+	LH:    "MOVH",
+	LW:    "MOVW",
+	LD:    "MOV",
+	LBU:   "MOVBU",
+	LHU:   "MOVHU",
+	LWU:   "MOVWU",
+	SB:    "MOVB",
+	SH:    "MOVH",
+	SW:    "MOVW",
+	SD:    "MOV",
+	FLW:   "MOVF",
+	FSW:   "MOVF",
+	FLD:   "MOVD",
+	FSD:   "MOVD",
+
+	FMV_X_W: "FMVXS",
+	FMV_W_X: "FMVSX",
+}
@@ -0,0 +1,638 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package riscv64asm
+
+const (
+	_ Op = iota
+	LUI
+	AUIPC
+	JAL
+	JALR
+	BEQ
+	BNE
+	BLT
+	BGE
+	BLTU
+	BGEU
+	LB
+	LH
+	LW
+	LD
+	LBU
+	LHU
+	LWU
+	SB
+	SH
+	SW
+	SD
+	ADDI
+	SLTI
+	SLTIU
+	XORI
+	ORI
+	ANDI
+	SLLI
+	SRLI
+	SRAI
+	ADD
+	SUB
+	SLL
+	SLT
+	SLTU
+	XOR
+	SRL
+	SRA
+	OR
+	AND
+	ADDIW
+	SLLIW
+	SRLIW
+	SRAIW
+	ADDW
+	SUBW
+	SLLW
+	SRLW
+	SRAW
+	FENCE_I
+	FENCE
+	ECALL
+	EBREAK
+	SRET
+	WFI
+	CSRRW
+	CSRRS
+	CSRRC
+	CSRRWI
+	CSRRSI
+	CSRRCI
+	MUL
+	MULH
+	MULHSU
+	MULHU
+	DIV
+	DIVU
+	REM
+	REMU
+	MULW
+	DIVW
+	DIVUW
+	REMW
+	REMUW
+	LR_W
+	SC_W
+	AMOSWAP_W
+	AMOADD_W
+	AMOXOR_W
+	AMOAND_W
+	AMOOR_W
+	AMOMIN_W
+	AMOMAX_W
+	AMOMINU_W
+	AMOMAXU_W
+	LR_D
+	SC_D
+	AMOSWAP_D
+	AMOADD_D
+	AMOXOR_D
+	AMOAND_D
+	AMOOR_D
+	AMOMIN_D
+	AMOMAX_D
+	AMOMINU_D
+	AMOMAXU_D
+	FLW
+	FLD
+	FSW
+	FSD
+	FMADD_S
+	FMSUB_S
+	FNMSUB_S
+	FNMADD_S
+	FADD_S
+	FSUB_S
+	FMUL_S
+	FDIV_S
+	FSQRT_S
+	FSGNJ_S
+	FSGNJN_S
+	FSGNJX_S
+	FMIN_S
+	FMAX_S
+	FLE_S
+	FLT_S
+	FEQ_S
+	FCVT_W_S
+	FCVT_S_W
+	FCVT_WU_S
+	FCVT_S_WU
+	FCVT_L_S
+	FCVT_S_L
+	FCVT_LU_S
+	FCVT_S_LU
+	FMV_X_W
+	FCLASS_S
+	FMV_W_X
+	FMADD_D
+	FMSUB_D
+	FNMSUB_D
+	FNMADD_D
+	FADD_D
+	FSUB_D
+	FMUL_D
+	FDIV_D
+	FSQRT_D
+	FSGNJ_D
+	FSGNJN_D
+	FSGNJX_D
+	FMIN_D
+	FMAX_D
+	FLE_D
+	FLT_D
+	FEQ_D
+	FCVT_W_D
+	FCVT_D_W
+	FCVT_WU_D
+	FCVT_D_WU
+	FCVT_L_D
+	FCVT_D_L
+	FCVT_LU_D
+	FCVT_D_LU
+	FMV_X_D
+	FCLASS_D
+	FMV_D_X
+	FCVT_S_D
+	FCVT_D_S
+	ADD_UW
+	SH1ADD
+	SH2ADD
+	SH3ADD
+	SH1ADD_UW
+	SH2ADD_UW
+	SH3ADD_UW
+	SLLI_UW
+	ANDN
+	ORN
+	XNOR
+	CLZ
+	CLZW
+	CTZ
+	CTZW
+	CPOP
+	CPOPW
+	MAX
+	MAXU
+	MIN
+	MINU
+	SEXT_B
+	SEXT_H
+	ZEXT_H
+	ROL
+	ROLW
+	ROR
+	RORI
+	RORIW
+	RORW
+	ORC_B
+	REV8
+	BCLR
+	BCLRI
+	BEXT
+	BEXTI
+	BINV
+	BINVI
+	BSET
+	BSETI
+	SHA256SIG0
+	SHA256SIG1
+	SHA256SUM0
+	SHA256SUM1
+	SHA512SIG0
+	SHA512SIG1
+	SHA512SUM0
+	SHA512SUM1
+)
+
+var opstr = [...]string{
+	LUI:       "lui",
+	AUIPC:     "auipc",
+	JAL:       "jal",
+	JALR:      "jalr",
+	BEQ:       "beq",
+	BNE:       "bne",
+	BLT:       "blt",
+	BGE:       "bge",
+	BLTU:      "bltu",
+	BGEU:      "bgeu",
+	LB:        "lb",
+	LH:        "lh",
+	LW:        "lw",
+	LD:        "ld",
+	LBU:       "lbu",
+	LHU:       "lhu",
+	LWU:       "lwu",
+	SB:        "sb",
+	SH:        "sh",
+	SW:        "sw",
+	SD:        "sd",
+	ADDI:      "addi",
+	SLTI:      "slti",
+	SLTIU:     "sltiu",
+	XORI:      "xori",
+	ORI:       "ori",
+	ANDI:      "andi",
+	SLLI:      "slli",
+	SRLI:      "srli",
+	SRAI:      "srai",
+	ADD:       "add",
+	SUB:       "sub",
+	SLL:       "sll",
+	SLT:       "slt",
+	SLTU:      "sltu",
+	XOR:       "xor",
+	SRL:       "srl",
+	SRA:       "sra",
+	OR:        "or",
+	AND:       "and",
+	ADDIW:     "addiw",
+	SLLIW:     "slliw",
+	SRLIW:     "srliw",
+	SRAIW:     "sraiw",
+	ADDW:      "addw",
+	SUBW:      "subw",
+	SLLW:      "sllw",
+	SRLW:      "srlw",
+	SRAW:      "sraw",
+	FENCE_I:   "fence.i",
+	FENCE:     "fence",
+	ECALL:     "ecall",
+	EBREAK:    "ebreak",
+	SRET:      "sret",
+	WFI:       "wfi",
+	CSRRW:     "csrrw",
+	CSRRS:     "csrrs",
+	CSRRC:     "csrrc",
+	CSRRWI:    "csrrwi",
+	CSRRSI:    "csrrsi",
+	CSRRCI:    "csrrci",
+	MUL:       "mul",
+	MULH:      "mulh",
+	MULHSU:    "mulhsu",
+	MULHU:     "mulhu",
+	DIV:       "div",
+	DIVU:      "divu",
+	REM:       "rem",
+	REMU:      "remu",
+	MULW:      "mulw",
+	DIVW:      "divw",
+	DIVUW:     "divuw",
+	REMW:      "remw",
+	REMUW:     "remuw",
+	LR_W:      "lr.w",
+	SC_W:      "sc.w",
+	AMOSWAP_W: "amoswap.w",
+	AMOADD_W:  "amoadd.w",
+	AMOXOR_W:  "amoxor.w",
+	AMOAND_W:  "amoand.w",
+	AMOOR_W:   "amoor.w",
+	AMOMIN_W:  "amomin.w",
+	AMOMAX_W:  "amomax.w",
+	AMOMINU_W: "amominu.w",
+	AMOMAXU_W: "amomaxu.w",
+	LR_D:      "lr.d",
+	SC_D:      "sc.d",
+	AMOSWAP_D: "amoswap.d",
+	AMOADD_D:  "amoadd.d",
+	AMOXOR_D:  "amoxor.d",
+	AMOAND_D:  "amoand.d",
+	AMOOR_D:   "amoor.d",
+	AMOMIN_D:  "amomin.d",
+	AMOMAX_D:  "amomax.d",
+	AMOMINU_D: "amominu.d",
+	AMOMAXU_D: "amomaxu.d",
+	FLW:       "flw",
+	FLD:       "fld",
+	FSW:       "fsw",
+	FSD:       "fsd",
+	FMADD_S:   "fmadd.s",
+	FMSUB_S:   "fmsub.s",
+	FNMSUB_S:  "fnmsub.s",
+	FNMADD_S:  "fnmadd.s",
+	FADD_S:    "fadd.s",
+	FSUB_S:    "fsub.s",
+	FMUL_S:    "fmul.s",
+	FDIV_S:    "fdiv.s",
+	FSQRT_S:   "fsqrt.s",
+	FSGNJ_S:   "fsgnj.s",
+	FSGNJN_S:  "fsgnjn.s",
+	FSGNJX_S:  "fsgnjx.s",
+	FMIN_S:    "fmin.s",
+	FMAX_S:    "fmax.s",
+	FLE_S:     "fle.s",
+	FLT_S:     "flt.s",
+	FEQ_S:     "feq.s",
+	FCVT_W_S:  "fcvt.w.s",
+	FCVT_S_W:  "fcvt.s.w",
+	FCVT_WU_S: "fcvt.wu.s",
+	FCVT_S_WU: "fcvt.s.wu",
+	FCVT_L_S:  "fcvt.l.s",
+	FCVT_S_L:  "fcvt.s.l",
+	FCVT_LU_S: "fcvt.lu.s",
+	FCVT_S_LU: "fcvt.s.lu",
+	FMV_X_W:   "fmv.x.w",
+	FCLASS_S:  "fclass.s",
+	FMV_W_X:   "fmv.w.x",
+	FMADD_D:   "fmadd.d",
+	FMSUB_D:   "fmsub.d",
+	FNMSUB_D:  "fnmsub.d",
+	FNMADD_D:  "fnmadd.d",
+	FADD_D:    "fadd.d",
+	FSUB_D:    "fsub.d",
+	FMUL_D:    "fmul.d",
+	FDIV_D:    "fdiv.d",
+	FSQRT_D:   "fsqrt.d",
+	FSGNJ_D:   "fsgnj.d",
+	FSGNJN_D:  "fsgnjn.d",
+	FSGNJX_D:  "fsgnjx.d",
+	FMIN_D:    "fmin.d",
+	FMAX_D:    "fmax.d",
+	FLE_D:     "fle.d",
+	FLT_D:     "flt.d",
+	FEQ_D:     "feq.d",
+	FCVT_W_D:  "fcvt.w.d",
+	FCVT_D_W:  "fcvt.d.w",
+	FCVT_WU_D: "fcvt.wu.d",
+	FCVT_D_WU: "fcvt.d.wu",
+	FCVT_L_D:  "fcvt.l.d",
+	FCVT_D_L:  "fcvt.d.l",
+	FCVT_LU_D: "fcvt.lu.d",
+	FCVT_D_LU: "fcvt.d.lu",
+	FMV_X_D:   "fmv.x.d",
+	FCLASS_D:  "fclass.d",
+	FMV_D_X:   "fmv.d.x",
+	FCVT_S_D:  "fcvt.s.d",
+	FCVT_D_S:  "fcvt.d.s",
+	ADD_UW:    "add.uw",
+	SH1ADD:    "sh1add",
+	SH2ADD:    "sh2add",
+	SH3ADD:    "sh3add",
+	SH1ADD_UW: "sh1add.uw",
+	SH2ADD_UW: "sh2add.uw",
+	SH3ADD_UW: "sh3add.uw",
+	SLLI_UW:   "slli.uw",
+	ANDN:      "andn",
+	ORN:       "orn",
+	XNOR:      "xnor",
+	CLZ:       "clz",
+	CLZW:      "clzw",
+	CTZ:       "ctz",
+	CTZW:      "ctzw",
+	CPOP:      "cpop",
+	CPOPW:     "cpopw",
+	MAX:       "max",
+	MAXU:      "maxu",
+	MIN:       "min",
+	MINU:      "minu",
+	SEXT_B:    "sext.b",
+	SEXT_H:    "sext.h",
+	ZEXT_H:    "zext.h",
+	ROL:       "rol",
+	ROLW:      "rolw",
+	ROR:       "ror",
+	RORI:      "rori",
+	RORIW:     "roriw",
+	RORW:      "rorw",
+	ORC_B:     "orc.b",
+	REV8:      "rev8",
+	BCLR:      "bclr",
+	BCLRI:     "bclri",
+	BEXT:      "bext",
+	BEXTI:     "bexti",
+	BINV:      "binv",
+	BINVI:     "binvi",
+	BSET:      "bset",
+	BSETI:     "bseti",
+
+	SHA256SIG0: "sha256sig0",
+	SHA256SIG1: "sha256sig1",
+	SHA256SUM0: "sha256sum0",
+	SHA256SUM1: "sha256sum1",
+	SHA512SIG0: "sha512sig0",
+	SHA512SIG1: "sha512sig1",
+	SHA512SUM0: "sha512sum0",
+	SHA512SUM1: "sha512sum1",
+}
+
+// instFormats lists the 32-bit instruction encodings. The first entry whose
+// mask and value match an instruction is used.
+var instFormats = [...]instFormat{
+	{LUI, 0x00007f, 0x000037, [5]argType{arg_rd, arg_uimm20}},
+	{AUIPC, 0x00007f, 0x000017, [5]argType{arg_rd, arg_uimm20}},
+	{JAL, 0x00007f, 0x00006f, [5]argType{arg_rd, arg_jimm}},
+	{JALR, 0x00707f, 0x000067, [5]argType{arg_rd, arg_mem_i}},
+	{BEQ, 0x00707f, 0x000063, [5]argType{arg_rs1, arg_rs2, arg_bimm}},
+	{BNE, 0x00707f, 0x001063, [5]argType{arg_rs1, arg_rs2, arg_bimm}},
+	{BLT, 0x00707f, 0x004063, [5]argType{arg_rs1, arg_rs2, arg_bimm}},
+	{BGE, 0x00707f, 0x005063, [5]argType{arg_rs1, arg_rs2, arg_bimm}},
+	{BLTU, 0x00707f, 0x006063, [5]argType{arg_rs1, arg_rs2, arg_bimm}},
+	{BGEU, 0x00707f, 0x007063, [5]argType{arg_rs1, arg_rs2, arg_bimm}},
+	{LB, 0x00707f, 0x000003, [5]argType{arg_rd, arg_mem_i}},
+	{LH, 0x00707f, 0x001003, [5]argType{arg_rd, arg_mem_i}},
+	{LW, 0x00707f, 0x002003, [5]argType{arg_rd, arg_mem_i}},
+	{LD, 0x00707f, 0x003003, [5]argType{arg_rd, arg_mem_i}},
+	{LBU, 0x00707f, 0x004003, [5]argType{arg_rd, arg_mem_i}},
+	{LHU, 0x00707f, 0x005003, [5]argType{arg_rd, arg_mem_i}},
+	{LWU, 0x00707f, 0x006003, [5]argType{arg_rd, arg_mem_i}},
+	{SB, 0x00707f, 0x000023, [5]argType{arg_rs2, arg_mem_s}},
+	{SH, 0x00707f, 0x001023, [5]argType{arg_rs2, arg_mem_s}},
+	{SW, 0x00707f, 0x002023, [5]argType{arg_rs2, arg_mem_s}},
+	{SD, 0x00707f, 0x003023, [5]argType{arg_rs2, arg_mem_s}},
+	{ADDI, 0x00707f, 0x000013, [5]argType{arg_rd, arg_rs1, arg_imm12}},
+	{SLTI, 0x00707f, 0x002013, [5]argType{arg_rd, arg_rs1, arg_imm12}},
+	{SLTIU, 0x00707f, 0x003013, [5]argType{arg_rd, arg_rs1, arg_imm12}},
+	{XORI, 0x00707f, 0x004013, [5]argType{arg_rd, arg_rs1, arg_imm12}},
+	{ORI, 0x00707f, 0x006013, [5]argType{arg_rd, arg_rs1, arg_imm12}},
+	{ANDI, 0x00707f, 0x007013, [5]argType{arg_rd, arg_rs1, arg_imm12}},
+	{SLLI, 0xfc00707f, 0x001013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{SRLI, 0xfc00707f, 0x005013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{SRAI, 0xfc00707f, 0x40005013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{ADD, 0xfe00707f, 0x000033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SUB, 0xfe00707f, 0x40000033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SLL, 0xfe00707f, 0x001033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SLT, 0xfe00707f, 0x002033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SLTU, 0xfe00707f, 0x003033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{XOR, 0xfe00707f, 0x004033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SRL, 0xfe00707f, 0x005033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SRA, 0xfe00707f, 0x40005033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{OR, 0xfe00707f, 0x006033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{AND, 0xfe00707f, 0x007033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{ADDIW, 0x00707f, 0x00001b, [5]argType{arg_rd, arg_rs1, arg_imm12}},
+	{SLLIW, 0xfe00707f, 0x00101b, [5]argType{arg_rd, arg_rs1, arg_shamt5}},
+	{SRLIW, 0xfe00707f, 0x00501b, [5]argType{arg_rd, arg_rs1, arg_shamt5}},
+	{SRAIW, 0xfe00707f, 0x4000501b, [5]argType{arg_rd, arg_rs1, arg_shamt5}},
+	{ADDW, 0xfe00707f, 0x00003b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SUBW, 0xfe00707f, 0x4000003b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SLLW, 0xfe00707f, 0x00103b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SRLW, 0xfe00707f, 0x00503b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SRAW, 0xfe00707f, 0x4000503b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{FENCE_I, 0x00707f, 0x00100f, [5]argType{}},
+	{FENCE, 0xf00fffff, 0x00000f, [5]argType{arg_pred, arg_succ}},
+	{ECALL, 0xffffffff, 0x000073, [5]argType{}},
+	{EBREAK, 0xffffffff, 0x100073, [5]argType{}},
+	{SRET, 0xffffffff, 0x10200073, [5]argType{}},
+	{WFI, 0xffffffff, 0x10500073, [5]argType{}},
+	{CSRRW, 0x00707f, 0x001073, [5]argType{arg_rd, arg_csr, arg_rs1}},
+	{CSRRS, 0x00707f, 0x002073, [5]argType{arg_rd, arg_csr, arg_rs1}},
+	{CSRRC, 0x00707f, 0x003073, [5]argType{arg_rd, arg_csr, arg_rs1}},
+	{CSRRWI, 0x00707f, 0x005073, [5]argType{arg_rd, arg_csr, arg_zimm}},
+	{CSRRSI, 0x00707f, 0x006073, [5]argType{arg_rd, arg_csr, arg_zimm}},
+	{CSRRCI, 0x00707f, 0x007073, [5]argType{arg_rd, arg_csr, arg_zimm}},
+	{MUL, 0xfe00707f, 0x2000033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{MULH, 0xfe00707f, 0x2001033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{MULHSU, 0xfe00707f, 0x2002033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{MULHU, 0xfe00707f, 0x2003033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{DIV, 0xfe00707f, 0x2004033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{DIVU, 0xfe00707f, 0x2005033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{REM, 0xfe00707f, 0x2006033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{REMU, 0xfe00707f, 0x2007033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{MULW, 0xfe00707f, 0x200003b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{DIVW, 0xfe00707f, 0x200403b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{DIVUW, 0xfe00707f, 0x200503b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{REMW, 0xfe00707f, 0x200603b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{REMUW, 0xfe00707f, 0x200703b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{LR_W, 0xf9f0707f, 0x1000202f, [5]argType{arg_rd, arg_amo_addr, arg_aqrl}},
+	{SC_W, 0xf800707f, 0x1800202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOSWAP_W, 0xf800707f, 0x800202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOADD_W, 0xf800707f, 0x00202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOXOR_W, 0xf800707f, 0x2000202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOAND_W, 0xf800707f, 0x6000202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOOR_W, 0xf800707f, 0x4000202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMIN_W, 0xf800707f, 0x8000202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMAX_W, 0xf800707f, 0xa000202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMINU_W, 0xf800707f, 0xc000202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMAXU_W, 0xf800707f, 0xe000202f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{LR_D, 0xf9f0707f, 0x1000302f, [5]argType{arg_rd, arg_amo_addr, arg_aqrl}},
+	{SC_D, 0xf800707f, 0x1800302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOSWAP_D, 0xf800707f, 0x800302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOADD_D, 0xf800707f, 0x00302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOXOR_D, 0xf800707f, 0x2000302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOAND_D, 0xf800707f, 0x6000302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOOR_D, 0xf800707f, 0x4000302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMIN_D, 0xf800707f, 0x8000302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMAX_D, 0xf800707f, 0xa000302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMINU_D, 0xf800707f, 0xc000302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{AMOMAXU_D, 0xf800707f, 0xe000302f, [5]argType{arg_rd, arg_rs2, arg_amo_addr, arg_aqrl}},
+	{FLW, 0x00707f, 0x002007, [5]argType{arg_fd, arg_mem_i}},
+	{FLD, 0x00707f, 0x003007, [5]argType{arg_fd, arg_mem_i}},
+	{FSW, 0x00707f, 0x002027, [5]argType{arg_fs2, arg_mem_s}},
+	{FSD, 0x00707f, 0x003027, [5]argType{arg_fs2, arg_mem_s}},
+	{FMADD_S, 0x600007f, 0x000043, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FMSUB_S, 0x600007f, 0x000047, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FNMSUB_S, 0x600007f, 0x00004b, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FNMADD_S, 0x600007f, 0x00004f, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FADD_S, 0xfe00007f, 0x000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FSUB_S, 0xfe00007f, 0x8000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FMUL_S, 0xfe00007f, 0x10000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FDIV_S, 0xfe00007f, 0x18000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FSQRT_S, 0xfff0007f, 0x58000053, [5]argType{arg_fd, arg_fs1, arg_rm}},
+	{FSGNJ_S, 0xfe00707f, 0x20000053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FSGNJN_S, 0xfe00707f, 0x20001053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FSGNJX_S, 0xfe00707f, 0x20002053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FMIN_S, 0xfe00707f, 0x28000053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FMAX_S, 0xfe00707f, 0x28001053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FLE_S, 0xfe00707f, 0xa0000053, [5]argType{arg_rd, arg_fs1, arg_fs2}},
+	{FLT_S, 0xfe00707f, 0xa0001053, [5]argType{arg_rd, arg_fs1, arg_fs2}},
+	{FEQ_S, 0xfe00707f, 0xa0002053, [5]argType{arg_rd, arg_fs1, arg_fs2}},
+	{FCVT_W_S, 0xfff0007f, 0xc0000053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_S_W, 0xfff0007f, 0xd0000053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FCVT_WU_S, 0xfff0007f, 0xc0100053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_S_WU, 0xfff0007f, 0xd0100053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FCVT_L_S, 0xfff0007f, 0xc0200053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_S_L, 0xfff0007f, 0xd0200053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FCVT_LU_S, 0xfff0007f, 0xc0300053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_S_LU, 0xfff0007f, 0xd0300053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FMV_X_W, 0xfff0707f, 0xe0000053, [5]argType{arg_rd, arg_fs1}},
+	{FCLASS_S, 0xfff0707f, 0xe0001053, [5]argType{arg_rd, arg_fs1}},
+	{FMV_W_X, 0xfff0707f, 0xf0000053, [5]argType{arg_fd, arg_rs1}},
+	{FMADD_D, 0x600007f, 0x2000043, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FMSUB_D, 0x600007f, 0x2000047, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FNMSUB_D, 0x600007f, 0x200004b, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FNMADD_D, 0x600007f, 0x200004f, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_fs3, arg_rm}},
+	{FADD_D, 0xfe00007f, 0x2000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FSUB_D, 0xfe00007f, 0xa000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FMUL_D, 0xfe00007f, 0x12000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FDIV_D, 0xfe00007f, 0x1a000053, [5]argType{arg_fd, arg_fs1, arg_fs2, arg_rm}},
+	{FSQRT_D, 0xfff0007f, 0x5a000053, [5]argType{arg_fd, arg_fs1, arg_rm}},
+	{FSGNJ_D, 0xfe00707f, 0x22000053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FSGNJN_D, 0xfe00707f, 0x22001053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FSGNJX_D, 0xfe00707f, 0x22002053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FMIN_D, 0xfe00707f, 0x2a000053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FMAX_D, 0xfe00707f, 0x2a001053, [5]argType{arg_fd, arg_fs1, arg_fs2}},
+	{FLE_D, 0xfe00707f, 0xa2000053, [5]argType{arg_rd, arg_fs1, arg_fs2}},
+	{FLT_D, 0xfe00707f, 0xa2001053, [5]argType{arg_rd, arg_fs1, arg_fs2}},
+	{FEQ_D, 0xfe00707f, 0xa2002053, [5]argType{arg_rd, arg_fs1, arg_fs2}},
+	{FCVT_W_D, 0xfff0007f, 0xc2000053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_D_W, 0xfff0007f, 0xd2000053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FCVT_WU_D, 0xfff0007f, 0xc2100053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_D_WU, 0xfff0007f, 0xd2100053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FCVT_L_D, 0xfff0007f, 0xc2200053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_D_L, 0xfff0007f, 0xd2200053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FCVT_LU_D, 0xfff0007f, 0xc2300053, [5]argType{arg_rd, arg_fs1, arg_rm}},
+	{FCVT_D_LU, 0xfff0007f, 0xd2300053, [5]argType{arg_fd, arg_rs1, arg_rm}},
+	{FMV_X_D, 0xfff0707f, 0xe2000053, [5]argType{arg_rd, arg_fs1}},
+	{FCLASS_D, 0xfff0707f, 0xe2001053, [5]argType{arg_rd, arg_fs1}},
+	{FMV_D_X, 0xfff0707f, 0xf2000053, [5]argType{arg_fd, arg_rs1}},
+	{FCVT_S_D, 0xfff0007f, 0x40100053, [5]argType{arg_fd, arg_fs1, arg_rm}},
+	{FCVT_D_S, 0xfff0007f, 0x42000053, [5]argType{arg_fd, arg_fs1, arg_rm}},
+	{ADD_UW, 0xfe00707f, 0x800003b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SH1ADD, 0xfe00707f, 0x20002033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SH2ADD, 0xfe00707f, 0x20004033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SH3ADD, 0xfe00707f, 0x20006033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SH1ADD_UW, 0xfe00707f, 0x2000203b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SH2ADD_UW, 0xfe00707f, 0x2000403b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SH3ADD_UW, 0xfe00707f, 0x2000603b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SLLI_UW, 0xfc00707f, 0x800101b, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{ANDN, 0xfe00707f, 0x40007033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{ORN, 0xfe00707f, 0x40006033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{XNOR, 0xfe00707f, 0x40004033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{CLZ, 0xfff0707f, 0x60001013, [5]argType{arg_rd, arg_rs1}},
+	{CLZW, 0xfff0707f, 0x6000101b, [5]argType{arg_rd, arg_rs1}},
+	{CTZ, 0xfff0707f, 0x60101013, [5]argType{arg_rd, arg_rs1}},
+	{CTZW, 0xfff0707f, 0x6010101b, [5]argType{arg_rd, arg_rs1}},
+	{CPOP, 0xfff0707f, 0x60201013, [5]argType{arg_rd, arg_rs1}},
+	{CPOPW, 0xfff0707f, 0x6020101b, [5]argType{arg_rd, arg_rs1}},
+	{MAX, 0xfe00707f, 0xa006033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{MAXU, 0xfe00707f, 0xa007033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{MIN, 0xfe00707f, 0xa004033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{MINU, 0xfe00707f, 0xa005033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{SEXT_B, 0xfff0707f, 0x60401013, [5]argType{arg_rd, arg_rs1}},
+	{SEXT_H, 0xfff0707f, 0x60501013, [5]argType{arg_rd, arg_rs1}},
+	{ZEXT_H, 0xfff0707f, 0x800403b, [5]argType{arg_rd, arg_rs1}},
+	{ZEXT_H, 0xfff0707f, 0x8004033, [5]argType{arg_rd, arg_rs1}},
+	{ROL, 0xfe00707f, 0x60001033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{ROLW, 0xfe00707f, 0x6000103b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{ROR, 0xfe00707f, 0x60005033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{RORI, 0xfc00707f, 0x60005013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{RORIW, 0xfe00707f, 0x6000501b, [5]argType{arg_rd, arg_rs1, arg_shamt5}},
+	{RORW, 0xfe00707f, 0x6000503b, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{ORC_B, 0xfff0707f, 0x28705013, [5]argType{arg_rd, arg_rs1}},
+	{REV8, 0xfff0707f, 0x6b805013, [5]argType{arg_rd, arg_rs1}},
+	{REV8, 0xfff0707f, 0x69805013, [5]argType{arg_rd, arg_rs1}},
+	{BCLR, 0xfe00707f, 0x48001033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{BCLRI, 0xfc00707f, 0x48001013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{BEXT, 0xfe00707f, 0x48005033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{BEXTI, 0xfc00707f, 0x48005013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{BINV, 0xfe00707f, 0x68001033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{BINVI, 0xfc00707f, 0x68001013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{BSET, 0xfe00707f, 0x28001033, [5]argType{arg_rd, arg_rs1, arg_rs2}},
+	{BSETI, 0xfc00707f, 0x28001013, [5]argType{arg_rd, arg_rs1, arg_shamt6}},
+	{SHA256SIG0, 0xfff0707f, 0x10201013, [5]argType{arg_rd, arg_rs1}},
+	{SHA256SIG1, 0xfff0707f, 0x10301013, [5]argType{arg_rd, arg_rs1}},
+	{SHA256SUM0, 0xfff0707f, 0x10001013, [5]argType{arg_rd, arg_rs1}},
+	{SHA256SUM1, 0xfff0707f, 0x10101013, [5]argType{arg_rd, arg_rs1}},
+	{SHA512SIG0, 0xfff0707f, 0x10601013, [5]argType{arg_rd, arg_rs1}},
+	{SHA512SIG1, 0xfff0707f, 0x10701013, [5]argType{arg_rd, arg_rs1}},
+	{SHA512SUM0, 0xfff0707f, 0x10401013, [5]argType{arg_rd, arg_rs1}},
+	{SHA512SUM1, 0xfff0707f, 0x10501013, [5]argType{arg_rd, arg_rs1}},
+}
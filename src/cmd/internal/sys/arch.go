@@ -20,6 +20,7 @@ const (
 	MIPS64
 	PPC64
 	RISCV
+	RISCV32
 	S390X
 )
 
@@ -166,7 +167,17 @@ var ArchRISCV = &Arch{
 	IntSize:   8,
 	PtrSize:   8,
 	RegSize:   8,
-	MinLC:     4,
+	MinLC:     2, // compressed instructions
+}
+
+var ArchRISCV32 = &Arch{
+	Name:      "riscv32",
+	Family:    RISCV32,
+	ByteOrder: binary.LittleEndian,
+	IntSize:   4,
+	PtrSize:   4,
+	RegSize:   4,
+	MinLC:     2, // compressed instructions
 }
 
 var ArchS390X = &Arch{
@@ -191,5 +202,7 @@ var Archs = [...]*Arch{
 	ArchMIPS64LE,
 	ArchPPC64,
 	ArchPPC64LE,
+	ArchRISCV,
+	ArchRISCV32,
 	ArchS390X,
 }
@@ -0,0 +1,374 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"cmd/internal/obj/riscv"
+	"debug/dwarf"
+	"debug/elf"
+	"encoding/binary"
+	"internal/testenv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const riscvFramesGo = `package main
+
+func leafFrame(x int) int
+func leafNoFrame(x int) int
+
+//go:noinline
+func caller(x int) int {
+	return leafFrame(x) + leafNoFrame(x)
+}
+
+func main() {
+	println(caller(1))
+}
+`
+
+const riscvFramesAsm = `#include "textflag.h"
+
+TEXT ·leafFrame(SB),NOSPLIT,$16-16
+	MOV	x+0(FP), A0
+	MOV	A0, 8(X2)
+	MOV	8(X2), A0
+	MOV	A0, ret+8(FP)
+	RET
+
+TEXT ·leafNoFrame(SB),NOSPLIT,$-8-16
+	MOV	x+0(FP), A0
+	MOV	A0, ret+8(FP)
+	RET
+`
+
+// cfiRow is a row of the call frame information table: the CFA is
+// cfaReg+cfaOff and, if raSaved, RA is saved at CFA+raOff.
+type cfiRow struct {
+	loc     uint64
+	cfaReg  uint64
+	cfaOff  int64
+	raSaved bool
+	raOff   int64
+}
+
+type cfiCIE struct {
+	codeAlign uint64
+	dataAlign int64
+	raReg     uint64
+	insts     []byte
+}
+
+func uleb128(b []byte) (uint64, []byte) {
+	var v uint64
+	for shift := uint(0); ; shift += 7 {
+		c := b[0]
+		b = b[1:]
+		v |= uint64(c&0x7f) << shift
+		if c&0x80 == 0 {
+			return v, b
+		}
+	}
+}
+
+func sleb128(b []byte) (int64, []byte) {
+	var v int64
+	var shift uint
+	for {
+		c := b[0]
+		b = b[1:]
+		v |= int64(c&0x7f) << shift
+		shift += 7
+		if c&0x80 == 0 {
+			if shift < 64 && c&0x40 != 0 {
+				v |= -1 << shift
+			}
+			return v, b
+		}
+	}
+}
+
+// execCFI runs the CFA instructions in insts, which apply to code
+// starting at loc, and appends the rows they describe to rows.
+func execCFI(t *testing.T, cie *cfiCIE, row cfiRow, insts []byte, rows []cfiRow) (cfiRow, []cfiRow) {
+	for len(insts) > 0 {
+		op := insts[0]
+		insts = insts[1:]
+		var reg, u uint64
+		var s int64
+		switch {
+		case op == 0: // DW_CFA_nop
+		case op&0xc0 == 0x40: // DW_CFA_advance_loc
+			rows = append(rows, row)
+			row.loc += uint64(op&0x3f) * cie.codeAlign
+		case op == 0x02: // DW_CFA_advance_loc1
+			rows = append(rows, row)
+			row.loc += uint64(insts[0]) * cie.codeAlign
+			insts = insts[1:]
+		case op == 0x03: // DW_CFA_advance_loc2
+			rows = append(rows, row)
+			row.loc += uint64(binary.LittleEndian.Uint16(insts)) * cie.codeAlign
+			insts = insts[2:]
+		case op == 0x04: // DW_CFA_advance_loc4
+			rows = append(rows, row)
+			row.loc += uint64(binary.LittleEndian.Uint32(insts)) * cie.codeAlign
+			insts = insts[4:]
+		case op == 0x0c: // DW_CFA_def_cfa
+			row.cfaReg, insts = uleb128(insts)
+			u, insts = uleb128(insts)
+			row.cfaOff = int64(u)
+		case op == 0x13: // DW_CFA_def_cfa_offset_sf
+			s, insts = sleb128(insts)
+			row.cfaOff = s * cie.dataAlign
+		case op == 0x08: // DW_CFA_same_value
+			reg, insts = uleb128(insts)
+			if reg == cie.raReg {
+				row.raSaved = false
+			}
+		case op == 0x05: // DW_CFA_offset_extended
+			reg, insts = uleb128(insts)
+			u, insts = uleb128(insts)
+			if reg == cie.raReg {
+				row.raSaved, row.raOff = true, int64(u)*cie.dataAlign
+			}
+		case op == 0x11: // DW_CFA_offset_extended_sf
+			reg, insts = uleb128(insts)
+			s, insts = sleb128(insts)
+			if reg == cie.raReg {
+				row.raSaved, row.raOff = true, s*cie.dataAlign
+			}
+		case op == 0x14: // DW_CFA_val_offset
+			_, insts = uleb128(insts)
+			_, insts = uleb128(insts)
+		default:
+			t.Fatalf("unexpected CFA instruction %#x", op)
+		}
+	}
+	return row, rows
+}
+
+// readFrames decodes the CIE and FDEs in a 64-bit little-endian
+// .debug_frame section and returns the rows of each FDE, keyed by
+// its initial location.
+func readFrames(t *testing.T, data []byte) (*cfiCIE, map[uint64][]cfiRow) {
+	var cie *cfiCIE
+	var initial cfiRow
+	fdes := make(map[uint64][]cfiRow)
+	for len(data) > 0 {
+		length := binary.LittleEndian.Uint32(data)
+		entry := data[4 : 4+length]
+		data = data[4+length:]
+		id := binary.LittleEndian.Uint32(entry)
+		entry = entry[4:]
+		if id == 0xffffffff {
+			if version := entry[0]; version != 3 {
+				t.Fatalf("CIE has version %d, want 3", version)
+			}
+			if entry[1] != 0 {
+				t.Fatalf("CIE has an augmentation")
+			}
+			cie = new(cfiCIE)
+			b := entry[2:]
+			cie.codeAlign, b = uleb128(b)
+			cie.dataAlign, b = sleb128(b)
+			cie.raReg, b = uleb128(b)
+			cie.insts = b
+			initial, _ = execCFI(t, cie, cfiRow{}, cie.insts, nil)
+			continue
+		}
+		if cie == nil {
+			t.Fatal("FDE before CIE")
+		}
+		loc := binary.LittleEndian.Uint64(entry)
+		size := binary.LittleEndian.Uint64(entry[8:])
+		row := initial
+		row.loc = loc
+		row, rows := execCFI(t, cie, row, entry[16:], nil)
+		if row.loc < loc+size {
+			rows = append(rows, row)
+		}
+		fdes[loc] = rows
+	}
+	return cie, fdes
+}
+
+func rowAt(rows []cfiRow, pc uint64) (cfiRow, bool) {
+	for i := len(rows) - 1; i >= 0; i-- {
+		if rows[i].loc <= pc {
+			return rows[i], true
+		}
+	}
+	return cfiRow{}, false
+}
+
+// TestRISCVDebugFrame checks that the call frame information the
+// linker writes for RISC-V agrees with the code: stepping through each
+// function up to its return, the CFA must always be the SP on entry,
+// and RA must only be described as saved once it has been stored.
+func TestRISCVDebugFrame(t *testing.T) {
+	testenv.MustHaveGoBuild(t)
+
+	dir, err := ioutil.TempDir("", "riscvframe")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	out, err := buildRISCV(t, dir, map[string]string{
+		"main.go":        riscvFramesGo,
+		"frames_riscv.s": riscvFramesAsm,
+	}, "")
+	if err != nil {
+		t.Fatalf("go build failed: %v\n%s", err, out)
+	}
+
+	f, err := elf.Open(filepath.Join(dir, "a.out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	// Find the functions through the debugging information.
+	d, err := f.DWARF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	type span struct{ lo, hi uint64 }
+	funcs := make(map[string]span)
+	r := d.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag != dwarf.TagSubprogram {
+			continue
+		}
+		name, _ := e.Val(dwarf.AttrName).(string)
+		lo, _ := e.Val(dwarf.AttrLowpc).(uint64)
+		hi, _ := e.Val(dwarf.AttrHighpc).(uint64)
+		funcs[name] = span{lo, hi}
+		r.SkipChildren()
+	}
+
+	sect := f.Section(".debug_frame")
+	if sect == nil {
+		t.Fatal("missing .debug_frame section")
+	}
+	data, err := sect.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	cie, fdes := readFrames(t, data)
+
+	sp := uint64(riscv.DWARFRegisters[riscv.REG_SP])
+	ra := uint64(riscv.DWARFRegisters[riscv.REG_RA])
+	if sp != 2 || ra != 1 {
+		t.Fatalf("SP and RA are DWARF registers %d and %d, want 2 and 1", sp, ra)
+	}
+	if cie.raReg != ra {
+		t.Errorf("CIE return address register is %d, want %d", cie.raReg, ra)
+	}
+
+	text := f.Section(".text")
+	code, err := text.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name  string
+		frame int64 // frame size, including the saved RA
+	}{
+		{"main.leafFrame", 24},
+		{"main.leafNoFrame", 0},
+		{"main.caller", -1},
+	} {
+		fn, ok := funcs[test.name]
+		if !ok {
+			t.Errorf("%s: no DW_TAG_subprogram", test.name)
+			continue
+		}
+		rows, ok := fdes[fn.lo]
+		if !ok {
+			t.Errorf("%s: no FDE at %#x", test.name, fn.lo)
+			continue
+		}
+
+		var spDelta int64 // bytes allocated below the CFA
+		raStored := false // RA has been stored at CFA+raOff
+		var raOff int64
+		maxDelta := int64(0)
+		for pc := fn.lo; pc < fn.hi; {
+			row, ok := rowAt(rows, pc)
+			if !ok {
+				t.Errorf("%s: no CFI row at %#x", test.name, pc)
+				break
+			}
+			if row.cfaReg != sp || row.cfaOff != spDelta {
+				t.Errorf("%s: at %#x CFA is r%d%+d, want r%d%+d", test.name, pc, row.cfaReg, row.cfaOff, sp, spDelta)
+			}
+			if row.raSaved && (!raStored || row.raOff != raOff) {
+				t.Errorf("%s: at %#x RA described as saved at CFA%+d before it is stored", test.name, pc, row.raOff)
+			}
+			if spDelta > 0 && !row.raSaved {
+				t.Errorf("%s: at %#x RA not described as saved in a %d byte frame", test.name, pc, spDelta)
+			}
+
+			off := pc - text.Addr
+			ins := uint32(binary.LittleEndian.Uint16(code[off:]))
+			size := uint64(2)
+			if ins&3 == 3 {
+				ins = binary.LittleEndian.Uint32(code[off:])
+				size = 4
+			}
+			ret := false
+			switch {
+			case ins&0x707f == 0x13 && ins>>7&0x1f == 2 && ins>>15&0x1f == 2: // ADDI SP, SP, imm
+				spDelta -= int64(int32(ins) >> 20)
+			case ins&0x707f == 0x3023 && ins>>15&0x1f == 2 && ins>>20&0x1f == 1: // SD RA, imm(SP)
+				imm := int64(int32(ins)>>25<<5) | int64(ins>>7&0x1f)
+				raStored, raOff = true, imm-spDelta
+			case ins == 0x8067: // JALR ZERO, RA, 0
+				ret = true
+			case size == 2 && ins&0xef83 == 0x0101: // C.ADDI SP, imm
+				imm := int64(ins>>12&1)<<5 | int64(ins>>2&0x1f)
+				if imm&(1<<5) != 0 {
+					imm -= 1 << 6
+				}
+				spDelta -= imm
+			case size == 2 && ins&0xef83 == 0x6101: // C.ADDI16SP
+				imm := int64(ins>>12&1)<<9 | int64(ins>>3&3)<<7 | int64(ins>>5&1)<<6 | int64(ins>>2&1)<<5 | int64(ins>>6&1)<<4
+				if imm&(1<<9) != 0 {
+					imm -= 1 << 10
+				}
+				spDelta -= imm
+			case ins == 0x8082: // C.JR RA
+				ret = true
+			}
+			if spDelta > maxDelta {
+				maxDelta = spDelta
+			}
+			if ret {
+				break
+			}
+			pc += size
+		}
+		if spDelta != 0 {
+			t.Errorf("%s: returns with %d bytes still allocated", test.name, spDelta)
+		}
+		if test.frame >= 0 && maxDelta != test.frame {
+			t.Errorf("%s: frame is %d bytes, want %d", test.name, maxDelta, test.frame)
+		}
+		if maxDelta > 0 && !raStored {
+			t.Errorf("%s: has a %d byte frame but does not save RA", test.name, maxDelta)
+		}
+	}
+}
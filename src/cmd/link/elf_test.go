@@ -0,0 +1,280 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"debug/elf"
+	"internal/testenv"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+)
+
+const riscvDynimportGo = `package main
+
+import _ "unsafe"
+
+//go:cgo_import_dynamic libstandin_answer answer "libstandin.so"
+//go:cgo_import_dynamic _ _ "libstandin.so"
+
+//go:linkname libstandin_answer libstandin_answer
+var libstandin_answer byte
+
+var answerAddr = &libstandin_answer
+
+func callAnswer() int
+
+func main() {
+	println(callAnswer(), answerAddr)
+}
+`
+
+const riscvDynimportAsm = `#include "textflag.h"
+
+TEXT ·callAnswer(SB),NOSPLIT,$8-8
+	MOV	$libstandin_answer(SB), T0
+	JALR	RA, T0
+	MOV	A0, ret+0(FP)
+	RET
+`
+
+const riscvPlainGo = `package main
+
+func main() {
+	println("hello")
+}
+`
+
+// buildRISCV writes files to dir and cross-compiles them for linux/riscv
+// into dir/a.out, passing flags to go build.
+func buildRISCV(t *testing.T, dir string, files map[string]string, ldflags string, flags ...string) ([]byte, error) {
+	for name, src := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0666); err != nil {
+			t.Fatal(err)
+		}
+	}
+	args := append([]string{"build", "-o", "a.out", "-ldflags", ldflags}, flags...)
+	cmd := exec.Command(testenv.GoToolPath(t), args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOOS=linux", "GOARCH=riscv", "CGO_ENABLED=0")
+	return cmd.CombinedOutput()
+}
+
+func TestRISCVDynamicImport(t *testing.T) {
+	testenv.MustHaveGoBuild(t)
+
+	dir, err := ioutil.TempDir("", "riscvdyn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	out, err := buildRISCV(t, dir, map[string]string{
+		"main.go":        riscvDynimportGo,
+		"answer_riscv.s": riscvDynimportAsm,
+	}, "")
+	if err != nil {
+		t.Fatalf("go build failed: %v\n%s", err, out)
+	}
+
+	f, err := elf.Open(filepath.Join(dir, "a.out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if f.Machine != elf.EM_RISCV {
+		t.Fatalf("got machine %v, want %v", f.Machine, elf.EM_RISCV)
+	}
+
+	libs, err := f.ImportedLibraries()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, lib := range libs {
+		if lib == "libstandin.so" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("DT_NEEDED libstandin.so missing, got %q", libs)
+	}
+
+	for _, name := range []string{".interp", ".plt", ".got.plt", ".dynsym"} {
+		if f.Section(name) == nil {
+			t.Errorf("missing %s section", name)
+		}
+	}
+	if plt := f.Section(".plt"); plt != nil && plt.Size != 32+16 {
+		t.Errorf(".plt has size %d, want %d", plt.Size, 32+16)
+	}
+
+	checkRela := func(name string, want elf.R_RISCV) {
+		sect := f.Section(name)
+		if sect == nil {
+			t.Errorf("missing %s section", name)
+			return
+		}
+		data, err := sect.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(data) == 0 || len(data)%24 != 0 {
+			t.Errorf("%s has bad size %d", name, len(data))
+			return
+		}
+		for i := 0; i < len(data); i += 24 {
+			info := f.ByteOrder.Uint64(data[i+8:])
+			if elf.R_RISCV(elf.R_TYPE64(info)) == want {
+				return
+			}
+		}
+		t.Errorf("%s has no %v relocation", name, want)
+	}
+	checkRela(".rela.plt", elf.R_RISCV_JUMP_SLOT)
+	checkRela(".rela", elf.R_RISCV_64)
+}
+
+func TestRISCVPIE(t *testing.T) {
+	testenv.MustHaveGoBuild(t)
+
+	dir, err := ioutil.TempDir("", "riscvpie")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	out, err := buildRISCV(t, dir, map[string]string{"main.go": riscvPlainGo}, "", "-buildmode=pie")
+	if err != nil {
+		t.Fatalf("go build failed: %v\n%s", err, out)
+	}
+
+	f, err := elf.Open(filepath.Join(dir, "a.out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if f.Type != elf.ET_DYN {
+		t.Fatalf("got type %v, want %v", f.Type, elf.ET_DYN)
+	}
+
+	// Text must not need relocating at load time.
+	dyn := f.Section(".dynamic")
+	if dyn == nil {
+		t.Fatal("missing .dynamic section")
+	}
+	data, err := dyn.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i+16 <= len(data); i += 16 {
+		tag := elf.DynTag(f.ByteOrder.Uint64(data[i:]))
+		val := f.ByteOrder.Uint64(data[i+8:])
+		if tag == elf.DT_TEXTREL || tag == elf.DT_FLAGS && val&uint64(elf.DF_TEXTREL) != 0 {
+			t.Errorf("dynamic section has %v", tag)
+		}
+	}
+
+	// The dynamic relocations should all be R_RISCV_RELATIVE,
+	// since the program imports no symbols.
+	var nrel int
+	for _, sect := range f.Sections {
+		if sect.Type != elf.SHT_RELA || sect.Flags&elf.SHF_ALLOC == 0 {
+			continue
+		}
+		data, err := sect.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i := 0; i+24 <= len(data); i += 24 {
+			off := f.ByteOrder.Uint64(data[i:])
+			info := f.ByteOrder.Uint64(data[i+8:])
+			if typ := elf.R_RISCV(elf.R_TYPE64(info)); typ != elf.R_RISCV_RELATIVE {
+				t.Fatalf("%s has %v relocation at %#x", sect.Name, typ, off)
+			}
+			nrel++
+		}
+	}
+	if nrel == 0 {
+		t.Error("no R_RISCV_RELATIVE relocations found")
+	}
+}
+
+func TestRISCVExternalObject(t *testing.T) {
+	testenv.MustHaveGoBuild(t)
+
+	tmpdir, err := ioutil.TempDir("", "riscvext")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tmpdir)
+
+	// Use true(1) as the external linker so that only the object file
+	// handed to it is produced. The go command then fails to find the
+	// executable, which is expected.
+	ldflags := "-linkmode=external -extld=true -tmpdir=" + tmpdir
+	out, _ := buildRISCV(t, tmpdir, map[string]string{"main.go": riscvPlainGo}, ldflags)
+
+	f, err := elf.Open(filepath.Join(tmpdir, "go.o"))
+	if err != nil {
+		t.Fatalf("%v\n%s", err, out)
+	}
+	defer f.Close()
+
+	if f.Type != elf.ET_REL {
+		t.Fatalf("got type %v, want %v", f.Type, elf.ET_REL)
+	}
+	syms, err := f.Symbols()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sect := f.Section(".rela.text")
+	if sect == nil {
+		t.Fatal("missing .rela.text section")
+	}
+	data, err := sect.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Every R_RISCV_PCREL_HI20 must be followed by a PCREL_LO12 for
+	// the next instruction that refers to a label at the AUIPC.
+	var nhi int
+	for i := 0; i+24 <= len(data); i += 24 {
+		off := f.ByteOrder.Uint64(data[i:])
+		info := f.ByteOrder.Uint64(data[i+8:])
+		if elf.R_RISCV(elf.R_TYPE64(info)) != elf.R_RISCV_PCREL_HI20 {
+			continue
+		}
+		nhi++
+		if i+48 > len(data) {
+			t.Fatalf("PCREL_HI20 at %#x is the last relocation", off)
+		}
+		loOff := f.ByteOrder.Uint64(data[i+24:])
+		loInfo := f.ByteOrder.Uint64(data[i+32:])
+		switch elf.R_RISCV(elf.R_TYPE64(loInfo)) {
+		case elf.R_RISCV_PCREL_LO12_I, elf.R_RISCV_PCREL_LO12_S:
+		default:
+			t.Fatalf("PCREL_HI20 at %#x followed by %v", off, elf.R_RISCV(elf.R_TYPE64(loInfo)))
+		}
+		if loOff != off+4 {
+			t.Fatalf("PCREL_LO12 at %#x, want %#x", loOff, off+4)
+		}
+		symIdx := int(elf.R_SYM64(loInfo))
+		if symIdx < 1 || symIdx > len(syms) {
+			t.Fatalf("PCREL_LO12 at %#x has bad symbol index %d", loOff, symIdx)
+		}
+		if sym := syms[symIdx-1]; sym.Value != off {
+			t.Fatalf("PCREL_LO12 at %#x refers to %s at %#x, want %#x", loOff, sym.Name, sym.Value, off)
+		}
+	}
+	if nhi == 0 {
+		t.Error("no R_RISCV_PCREL_HI20 relocations found")
+	}
+}
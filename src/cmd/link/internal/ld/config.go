@@ -184,7 +184,7 @@ func mustLinkExternal(ctxt *Link) (res bool, reason string) {
 	// Internally linking cgo is incomplete on some architectures.
 	// https://golang.org/issue/10373
 	// https://golang.org/issue/14449
-	if iscgo && SysArch.InFamily(sys.ARM64, sys.MIPS64, sys.MIPS) {
+	if iscgo && SysArch.InFamily(sys.ARM64, sys.MIPS64, sys.MIPS, sys.RISCV, sys.RISCV32) {
 		return true, obj.GOARCH + " does not support internal cgo"
 	}
 
@@ -196,7 +196,7 @@ func mustLinkExternal(ctxt *Link) (res bool, reason string) {
 		return true, "buildmode=c-shared"
 	case BuildmodePIE:
 		switch obj.GOOS + "/" + obj.GOARCH {
-		case "linux/amd64":
+		case "linux/amd64", "linux/riscv":
 		default:
 			// Internal linking does not support TLS_IE.
 			return true, "buildmode=pie"
@@ -227,6 +227,12 @@ func (d *deadcodepass) init() {
 		}
 		names = append(names, "runtime.read_tls_fallback")
 	}
+	if SysArch.InFamily(sys.RISCV, sys.RISCV32) {
+		// runtime.goriscv is set by the linker and kept even
+		// if the program does not use it, so that every binary
+		// records the extensions it was built to use.
+		names = append(names, "runtime.goriscv")
+	}
 
 	if Buildmode == BuildmodeShared {
 		// Mark all symbols defined in this library as reachable when
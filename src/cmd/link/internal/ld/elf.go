@@ -643,6 +643,23 @@ const (
 	R_PPC64_REL16_HI          = 251
 	R_PPC64_REL16_HA          = 252
 
+	R_RISCV_NONE         = 0
+	R_RISCV_32           = 1
+	R_RISCV_64           = 2
+	R_RISCV_RELATIVE     = 3
+	R_RISCV_COPY         = 4
+	R_RISCV_JUMP_SLOT    = 5
+	R_RISCV_TLS_TPREL64  = 11
+	R_RISCV_CALL         = 18
+	R_RISCV_CALL_PLT     = 19
+	R_RISCV_GOT_HI20     = 20
+	R_RISCV_PCREL_HI20   = 23
+	R_RISCV_PCREL_LO12_I = 24
+	R_RISCV_PCREL_LO12_S = 25
+	R_RISCV_HI20         = 26
+	R_RISCV_LO12_I       = 27
+	R_RISCV_LO12_S       = 28
+
 	R_SPARC_NONE     = 0
 	R_SPARC_8        = 1
 	R_SPARC_16       = 2
@@ -920,7 +937,7 @@ var buildinfo []byte
 func Elfinit(ctxt *Link) {
 	Iself = true
 
-	if SysArch.InFamily(sys.AMD64, sys.ARM64, sys.MIPS64, sys.PPC64, sys.RISCV, sys.S390X) {
+	if SysArch.InFamily(sys.AMD64, sys.ARM64, sys.MIPS64, sys.PPC64, sys.RISCV, sys.RISCV32, sys.S390X) {
 		elfRelType = ".rela"
 	} else {
 		elfRelType = ".rel"
@@ -948,7 +965,7 @@ func Elfinit(ctxt *Link) {
 		ehdr.shentsize = ELF64SHDRSIZE /* Must be ELF64SHDRSIZE */
 
 	// 32-bit architectures
-	case sys.ARM, sys.MIPS:
+	case sys.ARM, sys.MIPS, sys.RISCV32:
 		if SysArch.Family == sys.ARM {
 			// we use EABI on linux/arm, freebsd/arm, netbsd/arm.
 			if Headtype == obj.Hlinux || Headtype == obj.Hfreebsd || Headtype == obj.Hnetbsd {
@@ -2213,7 +2230,7 @@ func Asmbelf(ctxt *Link, symo int64) {
 		eh.machine = EM_386
 	case sys.PPC64:
 		eh.machine = EM_PPC64
-	case sys.RISCV:
+	case sys.RISCV, sys.RISCV32:
 		eh.machine = EM_RISCV
 	case sys.S390X:
 		eh.machine = EM_S390
@@ -509,6 +509,14 @@ func (ctxt *Link) loadlib() {
 			Adduint8(ctxt, s, uint8(obj.GOARM))
 		}
 
+		// On RISC-V, record the value of GORISCV, so that the binary
+		// says which extensions it was built to use.
+		if SysArch.InFamily(sys.RISCV, sys.RISCV32) {
+			s := ctxt.Syms.Lookup("runtime.goriscv", 0)
+			s.Type = obj.SNOPTRDATA
+			addstrdata(ctxt, s.Name, obj.GORISCV.String())
+		}
+
 		if obj.Framepointer_enabled(obj.GOOS, obj.GOARCH) {
 			s := ctxt.Syms.Lookup("runtime.framepointer_enabled", 0)
 			s.Type = obj.SRODATA
@@ -1259,6 +1267,10 @@ func hostlinkArchArgs() []string {
 		return []string{"-mabi=64"}
 	case sys.MIPS:
 		return []string{"-mabi=32"}
+	case sys.RISCV:
+		return []string{"-march=rv64gc", "-mabi=lp64d"}
+	case sys.RISCV32:
+		return []string{"-march=rv32gc", "-mabi=ilp32d"}
 	}
 	return nil
 }
@@ -35,26 +35,364 @@ import (
 	"log"
 )
 
+// Instruction encodings used to build the PLT. The registers follow the
+// RISC-V ELF psABI: t3 holds the target loaded from .got.plt, t1 the return
+// address of the PLT entry, and t0 and t2 are scratch.
+const (
+	insnSubT1T1T3   = 0x41c30333 // SUB   T3, T1, T1
+	insnAUIPCT2     = 0x00000397 // AUIPC $0, T2
+	insnADDIT2T2    = 0x00038393 // ADDI  $0, T2, T2
+	insnLDT3T2      = 0x0003be03 // LD    0(T2), T3
+	insnADDIT1T1M44 = 0xfd430313 // ADDI  $-44, T1, T1
+	insnLDT0T2P8    = 0x0083b283 // LD    8(T2), T0
+	insnSRLIT1T1    = 0x00135313 // SRLI  $1, T1, T1
+	insnJRT3        = 0x000e0067 // JALR  ZERO, T3
+	insnAUIPCT3     = 0x00000e17 // AUIPC $0, T3
+	insnLDT3T3      = 0x000e3e03 // LD    0(T3), T3
+	insnJALRT1T3    = 0x000e0367 // JALR  T1, T3
+	insnNOP         = 0x00000013 // ADDI  $0, ZERO, ZERO
+
+	// pltHeaderSize is the size of the PLT header generated by
+	// elfsetupplt. It is baked into insnADDIT1T1M44 (-(32 + 12)).
+	pltHeaderSize = 32
+)
+
 func gentext(ctxt *ld.Link) {
 }
 
+// addpcrelpair appends an AUIPC and I-type instruction pair to s, with a
+// R_RISCV_PCREL_ITYPE relocation that points the pair at t+add.
+func addpcrelpair(ctxt *ld.Link, s *ld.Symbol, auipc, itype uint32, t *ld.Symbol, add int64) {
+	off := ld.Adduint32(ctxt, s, auipc)
+	ld.Adduint32(ctxt, s, itype)
+	r := ld.Addrel(s)
+	r.Sym = t
+	r.Off = int32(off)
+	r.Add = add
+	r.Type = obj.R_RISCV_PCREL_ITYPE
+	r.Siz = 8
+}
+
+// adddynrela adds an entry to rel asking the dynamic linker to write the
+// 8-byte address that r refers to into s.
 func adddynrela(ctxt *ld.Link, rel *ld.Symbol, s *ld.Symbol, r *ld.Reloc) {
-	log.Fatalf("adddynrela not implemented")
+	targ := r.Sym
+	ld.Addaddrplus(ctxt, rel, s, int64(r.Off))
+	if targ.Type == obj.SDYNIMPORT {
+		ld.Adddynsym(ctxt, targ)
+		ld.Adduint64(ctxt, rel, ld.ELF64_R_INFO(uint32(targ.Dynid), ld.R_RISCV_64))
+		ld.Adduint64(ctxt, rel, uint64(r.Add))
+	} else {
+		// The symbol is in this module, so the dynamic linker
+		// only needs to add the load address.
+		ld.Adduint64(ctxt, rel, ld.ELF64_R_INFO(0, ld.R_RISCV_RELATIVE))
+		ld.Addaddrplus(ctxt, rel, targ, r.Add)
+	}
 }
 
 func adddynrel(ctxt *ld.Link, s *ld.Symbol, r *ld.Reloc) bool {
-	log.Fatalf("adddynrel not implemented")
+	targ := r.Sym
+
+	switch r.Type {
+	default:
+		if r.Type >= 256 {
+			ld.Errorf(s, "unexpected relocation type %d", r.Type)
+			return false
+		}
+
+	case obj.R_RISCV_PCREL_ITYPE:
+		if targ.Type != obj.SDYNIMPORT {
+			// nothing to do, the relocation will be laid out in reloc
+			return true
+		}
+		// Only an AUIPC + ADDI pair, which computes the address of the
+		// symbol, can be pointed at a PLT entry. Loading the value of a
+		// dynamic symbol would require a copy relocation.
+		if itype := ctxt.Arch.ByteOrder.Uint32(s.P[r.Off+4:]); itype&0x707f != 0x13 {
+			ld.Errorf(s, "unsupported load of dynamic symbol %s", targ.Name)
+			return false
+		}
+		addpltsym(ctxt, targ)
+		r.Sym = ctxt.Syms.Lookup(".plt", 0)
+		r.Add += int64(targ.Plt)
+		return true
+
+	case obj.R_RISCV_GOT_PCREL_ITYPE:
+		if targ.Type != obj.SDYNIMPORT {
+			// Everything else is in this module, so archreloc
+			// turns the load from the GOT into an ADDI of the
+			// symbol itself.
+			return true
+		}
+		addgotsym(ctxt, targ)
+		r.Type = obj.R_RISCV_PCREL_ITYPE
+		r.Sym = ctxt.Syms.Lookup(".got", 0)
+		r.Add += int64(targ.Got)
+		return true
+
+	case obj.R_RISCV_PCREL_STYPE:
+		if targ.Type == obj.SDYNIMPORT {
+			ld.Errorf(s, "unsupported store to dynamic symbol %s", targ.Name)
+			return false
+		}
+		return true
+
+	case obj.R_CALLRISCV:
+		// The call itself goes through the AUIPC + ADDI pair preceding
+		// the JALR; just keep the stack check from following the call
+		// into another module.
+		if targ.Type == obj.SDYNIMPORT {
+			addpltsym(ctxt, targ)
+			r.Sym = ctxt.Syms.Lookup(".plt", 0)
+			r.Add += int64(targ.Plt)
+		}
+		return true
+
+	case obj.R_ADDR:
+		if s.Type == obj.STEXT && ld.Iself {
+			// The code is asking for the address of an external
+			// function. We provide it with the address of the
+			// correspondent GOT symbol.
+			addgotsym(ctxt, targ)
+			r.Sym = ctxt.Syms.Lookup(".got", 0)
+			r.Add += int64(targ.Got)
+			return true
+		}
+
+		// Process dynamic relocations for the data sections.
+		if ld.Buildmode == ld.BuildmodePIE && ld.Linkmode == ld.LinkInternal {
+			// When internally linking a PIE, every R_ADDR in the
+			// data needs a dynamic relocation, except for the
+			// ones that are created while generating the
+			// dynamic relocations themselves. Those are resolved
+			// statically once addresses have been assigned; see
+			// the amd64 linker for the details.
+			switch s.Name {
+			case ".dynsym", ".rela", ".got.plt", ".dynamic":
+				return false
+			}
+		} else {
+			// Either internally linking a static executable,
+			// in which case we can resolve these relocations
+			// statically in the 'reloc' phase, or externally
+			// linking, in which case the relocation will be
+			// prepared in the 'reloc' phase and passed to the
+			// external linker in the 'asmb' phase.
+			if s.Type != obj.SDATA && s.Type != obj.SRODATA {
+				break
+			}
+		}
+
+		if ld.Iself {
+			if r.Siz != 8 {
+				ld.Errorf(s, "unexpected %d byte R_ADDR relocation for %s", r.Siz, targ.Name)
+				return false
+			}
+			adddynrela(ctxt, ctxt.Syms.Lookup(".rela", 0), s, r)
+			r.Type = 256 // ignore during relocsym
+			return true
+		}
+	}
+
 	return false
 }
 
+// pcrelHi20Name returns the name of the local symbol that labels the AUIPC
+// instruction at addr. The R_RISCV_PCREL_LO12_I and R_RISCV_PCREL_LO12_S
+// relocations passed to the external linker must refer to the AUIPC paired
+// with them, not to the target symbol.
+func pcrelHi20Name(addr int64) string {
+	return fmt.Sprintf(".Lpcrel_hi%x", addr)
+}
+
+// genpcrelhi20syms creates a local symbol for every AUIPC instruction that
+// carries an external R_RISCV_PCREL_* relocation, named by pcrelHi20Name.
+// It must be called after reloc has set up the external relocations.
+func genpcrelhi20syms(ctxt *ld.Link) []*ld.Symbol {
+	var syms []*ld.Symbol
+	for _, s := range ctxt.Textp {
+		for ri := range s.R {
+			r := &s.R[ri]
+			if r.Done != 0 {
+				continue
+			}
+			switch r.Type {
+			case obj.R_RISCV_PCREL_ITYPE, obj.R_RISCV_PCREL_STYPE, obj.R_RISCV_GOT_PCREL_ITYPE:
+			default:
+				continue
+			}
+			sym := ctxt.Syms.Lookup(pcrelHi20Name(s.Value+int64(r.Off)), 0)
+			sym.Type = obj.STEXT
+			sym.Attr |= ld.AttrLocal | ld.AttrReachable
+			sym.Value = s.Value + int64(r.Off)
+			sym.Outer = s
+			sym.Sect = s.Sect
+			syms = append(syms, sym)
+		}
+	}
+	return syms
+}
+
 func elfreloc1(ctxt *ld.Link, r *ld.Reloc, sectoff int64) int {
-	log.Printf("elfreloc1")
-	return -1
+	// Elf32_Rela and Elf64_Rela differ only in the width of their
+	// fields and in how r_info packs the symbol and type.
+	put := ld.Thearch.Vput
+	info := func(sym int32, typ uint64) uint64 { return uint64(sym)<<32 | typ }
+	if ld.SysArch.PtrSize == 4 {
+		put = func(v uint64) { ld.Thearch.Lput(uint32(v)) }
+		info = func(sym int32, typ uint64) uint64 { return uint64(sym)<<8 | typ }
+	}
+
+	put(uint64(sectoff))
+
+	elfsym := r.Xsym.ElfsymForReloc()
+	switch r.Type {
+	default:
+		return -1
+
+	case obj.R_ADDR:
+		switch r.Siz {
+		case 4:
+			put(info(elfsym, ld.R_RISCV_32))
+		case 8:
+			put(info(elfsym, ld.R_RISCV_64))
+		default:
+			return -1
+		}
+
+	case obj.R_RISCV_PCREL_ITYPE, obj.R_RISCV_PCREL_STYPE, obj.R_RISCV_GOT_PCREL_ITYPE:
+		// Two relocations: R_RISCV_PCREL_HI20 (or R_RISCV_GOT_HI20)
+		// against the target for the AUIPC, and
+		// R_RISCV_PCREL_LO12_[IS] against the AUIPC for the following
+		// instruction.
+		// All the text is in the first section of the text segment.
+		hi20 := ctxt.Syms.ROLookup(pcrelHi20Name(int64(ld.Segtext.Sect.Vaddr)+sectoff), 0)
+		if hi20 == nil {
+			return -1
+		}
+		hi := uint64(ld.R_RISCV_PCREL_HI20)
+		if r.Type == obj.R_RISCV_GOT_PCREL_ITYPE {
+			hi = ld.R_RISCV_GOT_HI20
+		}
+		lo12 := uint64(ld.R_RISCV_PCREL_LO12_I)
+		if r.Type == obj.R_RISCV_PCREL_STYPE {
+			lo12 = ld.R_RISCV_PCREL_LO12_S
+		}
+		put(info(elfsym, hi))
+		put(uint64(r.Xadd))
+		put(uint64(sectoff + 4))
+		put(info(hi20.Elfsym, lo12))
+		put(0)
+		return 0
+	}
+	put(uint64(r.Xadd))
+
+	return 0
 }
 
 func elfsetupplt(ctxt *ld.Link) {
-	log.Printf("elfsetuplt")
-	return
+	plt := ctxt.Syms.Lookup(".plt", 0)
+	got := ctxt.Syms.Lookup(".got.plt", 0)
+	if plt.Size == 0 {
+		// The PLT header, from the RISC-V ELF psABI. It computes the
+		// .got.plt offset of the entry that jumped here and hands
+		// it, along with the link map, to _dl_runtime_resolve.
+		//
+		//	SUB	T3, T1, T1		// T1 = entry + 12 - PLT
+		//	AUIPC	$got_hi, T2
+		//	ADDI	$got_lo, T2, T2		// T2 = .got.plt
+		//	LD	0(T2), T3		// T3 = _dl_runtime_resolve
+		//	ADDI	$-(hdr + 12), T1, T1	// T1 = entry offset
+		//	LD	8(T2), T0		// T0 = link map
+		//	SRLI	$1, T1, T1		// T1 = .got.plt offset
+		//	JALR	ZERO, T3
+		ld.Adduint32(ctxt, plt, insnSubT1T1T3)
+		addpcrelpair(ctxt, plt, insnAUIPCT2, insnADDIT2T2, got, 0)
+		ld.Adduint32(ctxt, plt, insnLDT3T2)
+		ld.Adduint32(ctxt, plt, insnADDIT1T1M44)
+		ld.Adduint32(ctxt, plt, insnLDT0T2P8)
+		ld.Adduint32(ctxt, plt, insnSRLIT1T1)
+		ld.Adduint32(ctxt, plt, insnJRT3)
+
+		// The first two .got.plt entries are reserved for the dynamic
+		// linker: _dl_runtime_resolve and the link map.
+		// assume got->size == 0 too
+		ld.Adduint64(ctxt, got, 0)
+		ld.Adduint64(ctxt, got, 0)
+	}
+}
+
+func addpltsym(ctxt *ld.Link, s *ld.Symbol) {
+	if s.Plt >= 0 {
+		return
+	}
+
+	// The PLT and GOT below are laid out for RV64.
+	if ld.SysArch.PtrSize == 4 {
+		ld.Errorf(s, "addpltsym: dynamic linking is not supported on riscv32")
+		return
+	}
+
+	ld.Adddynsym(ctxt, s)
+
+	if !ld.Iself {
+		ld.Errorf(s, "addpltsym: unsupported binary format")
+		return
+	}
+
+	plt := ctxt.Syms.Lookup(".plt", 0)
+	got := ctxt.Syms.Lookup(".got.plt", 0)
+	rela := ctxt.Syms.Lookup(".rela.plt", 0)
+	if plt.Size == 0 {
+		elfsetupplt(ctxt)
+	}
+
+	s.Plt = int32(plt.Size)
+
+	//	AUIPC	$got_hi, T3
+	//	LD	$got_lo(T3), T3
+	//	JALR	T1, T3
+	//	NOP
+	addpcrelpair(ctxt, plt, insnAUIPCT3, insnLDT3T3, got, got.Size)
+	ld.Adduint32(ctxt, plt, insnJALRT1T3)
+	ld.Adduint32(ctxt, plt, insnNOP)
+
+	// Until it is resolved, the .got.plt entry points at the PLT header.
+	ld.Addaddrplus(ctxt, got, plt, 0)
+
+	// rela
+	ld.Addaddrplus(ctxt, rela, got, got.Size-8)
+	ld.Adduint64(ctxt, rela, ld.ELF64_R_INFO(uint32(s.Dynid), ld.R_RISCV_JUMP_SLOT))
+	ld.Adduint64(ctxt, rela, 0)
+}
+
+func addgotsym(ctxt *ld.Link, s *ld.Symbol) {
+	if s.Got >= 0 {
+		return
+	}
+
+	// The PLT and GOT below are laid out for RV64.
+	if ld.SysArch.PtrSize == 4 {
+		ld.Errorf(s, "addgotsym: dynamic linking is not supported on riscv32")
+		return
+	}
+
+	ld.Adddynsym(ctxt, s)
+	got := ctxt.Syms.Lookup(".got", 0)
+	s.Got = int32(got.Size)
+	ld.Adduint64(ctxt, got, 0)
+
+	if !ld.Iself {
+		ld.Errorf(s, "addgotsym: unsupported binary format")
+		return
+	}
+
+	// RISC-V has no GLOB_DAT relocation; the GOT entry is filled in with
+	// a plain 64-bit address.
+	rela := ctxt.Syms.Lookup(".rela", 0)
+	ld.Addaddrplus(ctxt, rela, got, int64(s.Got))
+	ld.Adduint64(ctxt, rela, ld.ELF64_R_INFO(uint32(s.Dynid), ld.R_RISCV_64))
+	ld.Adduint64(ctxt, rela, 0)
 }
 
 func machoreloc1(s *ld.Symbol, r *ld.Reloc, sectoff int64) int {
@@ -63,10 +401,55 @@ func machoreloc1(s *ld.Symbol, r *ld.Reloc, sectoff int64) int {
 }
 
 func archreloc(ctxt *ld.Link, r *ld.Reloc, s *ld.Symbol, val *int64) int {
+	if ld.Linkmode == ld.LinkExternal {
+		switch r.Type {
+		case obj.R_CALLRISCV:
+			// Nothing to do.
+			return 0
+
+		case obj.R_RISCV_PCREL_ITYPE, obj.R_RISCV_PCREL_STYPE, obj.R_RISCV_GOT_PCREL_ITYPE:
+			r.Done = 0
+
+			// set up addend for eventual relocation via outer symbol.
+			rs := r.Sym
+			r.Xadd = r.Add
+			for rs.Outer != nil {
+				r.Xadd += ld.Symaddr(rs) - ld.Symaddr(rs.Outer)
+				rs = rs.Outer
+			}
+
+			if rs.Type != obj.SHOSTOBJ && rs.Type != obj.SDYNIMPORT && rs.Sect == nil {
+				ld.Errorf(s, "missing section for %s", rs.Name)
+			}
+			r.Xsym = rs
+
+			// The instruction immediates are left as zero; the
+			// external linker fills them in.
+			return 0
+
+		default:
+			return -1
+		}
+	}
+
 	switch r.Type {
 	case obj.R_CALLRISCV:
 		// Nothing to do.
 
+	case obj.R_RISCV_GOT_PCREL_ITYPE:
+		// The symbol is in this module, so there is no need to go
+		// through the GOT: replace the load with an ADDI of the same
+		// registers and resolve the pair like R_RISCV_PCREL_ITYPE.
+		second := uint32(*val >> 32)
+		if second&0x7f != 0x03 {
+			ld.Errorf(s, "R_RISCV_GOT_PCREL_ITYPE relocation for %s is not on a load", r.Sym.Name)
+			return 0
+		}
+		second = second&^(0x7<<12|0x7f) | 0x13
+		*val = int64(second)<<32 | int64(uint32(*val))
+		r.Type = obj.R_RISCV_PCREL_ITYPE
+		return archreloc(ctxt, r, s, val)
+
 	case obj.R_RISCV_PCREL_ITYPE, obj.R_RISCV_PCREL_STYPE:
 		pc := s.Value + int64(r.Off)
 		off := ld.Symaddr(r.Sym) + r.Add - pc
@@ -193,7 +576,17 @@ func asmb(ctxt *ld.Link) {
 				if ctxt.Debugvlog != 0 {
 					fmt.Fprintf(ctxt.Bso, "%5.2f elfsym\n", obj.Cputime())
 				}
-				ld.Asmelfsym(ctxt)
+				if ld.Linkmode == ld.LinkExternal {
+					// Emit the AUIPC labels alongside the
+					// text symbols, but keep them out of
+					// ctxt.Textp for everything else.
+					textp := ctxt.Textp
+					ctxt.Textp = append(textp[:len(textp):len(textp)], genpcrelhi20syms(ctxt)...)
+					ld.Asmelfsym(ctxt)
+					ctxt.Textp = textp
+				} else {
+					ld.Asmelfsym(ctxt)
+				}
 				ld.Cflush()
 				ld.Cwrite(ld.Elfstrdat)
 
@@ -63,11 +63,3 @@ const (
 	MinAlign  = 1
 	FuncAlign = 8
 )
-
-/* Used by ../internal/ld/dwarf.go */
-const (
-	// From GNU GCC REGISTER_NAMES
-	// https://github.com/riscv/riscv-gnu-toolchain/blob/master/gcc/gcc/config/riscv/riscv.h#L858
-	DWARFREGSP = 2
-	DWARFREGLR = 1
-)
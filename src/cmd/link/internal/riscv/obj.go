@@ -29,10 +29,10 @@ package riscv
 
 import (
 	"cmd/internal/obj"
+	"cmd/internal/obj/riscv"
 	"cmd/internal/sys"
 	"cmd/link/internal/ld"
 	"fmt"
-	"log"
 )
 
 func Main() {
@@ -42,12 +42,15 @@ func Main() {
 
 func linkarchinit() {
 	ld.SysArch = sys.ArchRISCV
+	if obj.GOARCH == "riscv32" {
+		ld.SysArch = sys.ArchRISCV32
+	}
 
 	ld.Thearch.Funcalign = FuncAlign
 	ld.Thearch.Maxalign = MaxAlign
 	ld.Thearch.Minalign = MinAlign
-	ld.Thearch.Dwarfregsp = DWARFREGSP
-	ld.Thearch.Dwarfreglr = DWARFREGLR
+	ld.Thearch.Dwarfregsp = int(riscv.DWARFRegisters[riscv.REG_SP])
+	ld.Thearch.Dwarfreglr = int(riscv.DWARFRegisters[riscv.REG_RA])
 
 	ld.Thearch.Adddynrel = adddynrel
 	ld.Thearch.Archinit = archinit
@@ -65,7 +68,10 @@ func linkarchinit() {
 	ld.Thearch.Append32 = ld.Append32l
 	ld.Thearch.Append64 = ld.Append64l
 
-	ld.Thearch.Linuxdynld = "/lib/ld.so.1"
+	ld.Thearch.Linuxdynld = "/lib/ld-linux-riscv64-lp64d.so.1"
+	if ld.SysArch.PtrSize == 4 {
+		ld.Thearch.Linuxdynld = "/lib/ld-linux-riscv32-ilp32d.so.1"
+	}
 
 	// TODO: FreeBSD and NetBSD have RISCV ports, but we don't support
 	// them yet.
@@ -78,22 +84,6 @@ func linkarchinit() {
 }
 
 func archinit(ctxt *ld.Link) {
-	// getgoextlinkenabled is based on GO_EXTLINK_ENABLED when
-	// Go was built; see ../../make.bash.
-	if ld.Linkmode == ld.LinkAuto && obj.Getgoextlinkenabled() == "0" {
-		ld.Linkmode = ld.LinkInternal
-	}
-
-	switch ld.Headtype {
-	default:
-		if ld.Linkmode == ld.LinkAuto {
-			ld.Linkmode = ld.LinkInternal
-		}
-		if ld.Linkmode == ld.LinkExternal && obj.Getgoextlinkenabled() != "1" {
-			log.Fatalf("cannot use -linkmode=external with -H %v", ld.Headtype)
-		}
-	}
-
 	switch ld.Headtype {
 	default:
 		ld.Exitf("unknown -H option: %v", ld.Headtype)
@@ -53,7 +53,7 @@ func main() {
 		mips64.Init()
 	case "ppc64", "ppc64le":
 		ppc64.Init()
-	case "riscv":
+	case "riscv", "riscv32":
 		riscv.Main()
 	case "s390x":
 		s390x.Init()
@@ -80,6 +80,14 @@ var ppcNeed = []string{
 	"RET",
 }
 
+var riscvNeed = []string{
+	"fmthello.go:6",
+	"TEXT main.main(SB)",
+	"JMP main.main(SB)",
+	"CALL (TMP)",
+	"RET",
+}
+
 var target = flag.String("target", "", "test disassembly of `goos/goarch` binary")
 
 // objdump is fully cross platform: it can handle binaries
@@ -124,6 +132,8 @@ func testDisasm(t *testing.T, flags ...string) {
 		need = append(need, armNeed...)
 	case "ppc64", "ppc64le":
 		need = append(need, ppcNeed...)
+	case "riscv", "riscv32":
+		need = append(need, riscvNeed...)
 	}
 
 	out, err = exec.Command(exe, "-s", "main.main", hello).CombinedOutput()
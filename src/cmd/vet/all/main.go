@@ -320,6 +320,7 @@ var nbits = map[string]int{
 	"ppc64le":  64,
 	"s390x":    64,
 	"riscv":    64,
+	"riscv32":  32,
 }
 
 // archAsmX maps architectures to the suffix usually used for their assembly files,
@@ -81,6 +81,7 @@ var (
 	asmArchPpc64LE  = asmArch{"ppc64le", size88, false, "R1", true}
 	asmArchS390X    = asmArch{"s390x", size88, true, "R15", true}
 	asmArchRISCV    = asmArch{"riscv", size88, false, "SP", true}
+	asmArchRISCV32  = asmArch{"riscv32", size44, false, "SP", true}
 
 	arches = []*asmArch{
 		&asmArch386,
@@ -96,6 +97,7 @@ var (
 		&asmArchPpc64LE,
 		&asmArchS390X,
 		&asmArchRISCV,
+		&asmArchRISCV32,
 	}
 )
 
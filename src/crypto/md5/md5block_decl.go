@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// +build amd64 amd64p32 386 arm ppc64le s390x
+// +build amd64 amd64p32 386 arm ppc64le s390x riscv
 
 package md5
 
@@ -0,0 +1,249 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// MD5 for RISC-V.
+//
+// The whole block is loaded into sixteen registers, so the rounds run
+// without touching memory. Only the low 32 bits of each register are
+// meaningful: additions and logic carry garbage into the upper bits,
+// and the 32-bit shifts used for rotations ignore it.
+
+// The message words.
+#define M0 T0
+#define M1 T1
+#define M2 T2
+#define M3 T3
+#define M4 T4
+#define M5 T5
+#define M6 S0
+#define M7 S1
+#define M8 S2
+#define M9 S3
+#define M10 S4
+#define M11 S5
+#define M12 S6
+#define M13 S7
+#define M14 S8
+#define M15 S9
+
+// Round constants are sign-extended so that MOV builds them with LUI
+// and ADDIW instead of loading them from the literal pool.
+#define K32(c) $((c) - (((c)>>31)<<32))
+
+#ifdef GORISCV_b
+#define ROL32(s, x) \
+	RORIW	$(32-s), x, x
+#else
+#define ROL32(s, x) \
+	SLLIW	$s, x, S10; \
+	SRLIW	$(32-s), x, x; \
+	OR	S10, x
+#endif
+
+// LOAD32 loads the little-endian word at off(A1) into x a byte at a
+// time, as p need not be aligned.
+#define LOAD32(off, x) \
+	MOVBU	off+3(A1), x; \
+	MOVBU	off+2(A1), A7; \
+	SLL	$8, x; \
+	OR	A7, x; \
+	MOVBU	off+1(A1), A7; \
+	SLL	$8, x; \
+	OR	A7, x; \
+	MOVBU	off+0(A1), A7; \
+	SLL	$8, x; \
+	OR	A7, x
+
+// a = b + (a + F(b, c, d) + x + const) <<< shift
+// where F(b, c, d) = d ^ (b & (c ^ d)).
+#define ROUND1(a, b, c, d, x, const, shift) \
+	MOV	K32(const), A7; \
+	ADD	x, a; \
+	ADD	A7, a; \
+	XOR	c, d, A7; \
+	AND	b, A7; \
+	XOR	d, A7; \
+	ADD	A7, a; \
+	ROL32(shift, a); \
+	ADD	b, a
+
+// G(b, c, d) = c ^ (d & (b ^ c)).
+#define ROUND2(a, b, c, d, x, const, shift) \
+	MOV	K32(const), A7; \
+	ADD	x, a; \
+	ADD	A7, a; \
+	XOR	b, c, A7; \
+	AND	d, A7; \
+	XOR	c, A7; \
+	ADD	A7, a; \
+	ROL32(shift, a); \
+	ADD	b, a
+
+// H(b, c, d) = b ^ c ^ d.
+#define ROUND3(a, b, c, d, x, const, shift) \
+	MOV	K32(const), A7; \
+	ADD	x, a; \
+	ADD	A7, a; \
+	XOR	b, c, A7; \
+	XOR	d, A7; \
+	ADD	A7, a; \
+	ROL32(shift, a); \
+	ADD	b, a
+
+// I(b, c, d) = c ^ (b | ^d).
+#define ROUND4(a, b, c, d, x, const, shift) \
+	MOV	K32(const), A7; \
+	ADD	x, a; \
+	ADD	A7, a; \
+	XOR	$-1, d, A7; \
+	OR	b, A7; \
+	XOR	c, A7; \
+	ADD	A7, a; \
+	ROL32(shift, a); \
+	ADD	b, a
+
+// func block(dig *digest, p []byte)
+TEXT ·block(SB),NOSPLIT,$0-32
+	MOV	dig+0(FP), A0
+	MOV	p_base+8(FP), A1
+	MOV	p_len+16(FP), A2
+	SRL	$6, A2
+	SLL	$6, A2
+	ADD	A1, A2	// end of the last whole block
+
+	MOVWU	(0*4)(A0), A3
+	MOVWU	(1*4)(A0), A4
+	MOVWU	(2*4)(A0), A5
+	MOVWU	(3*4)(A0), A6
+
+	BEQ	A1, A2, done
+
+loop:
+	AND	$3, A1, A7
+	BNE	A7, ZERO, unaligned
+	MOVWU	(0*4)(A1), M0
+	MOVWU	(1*4)(A1), M1
+	MOVWU	(2*4)(A1), M2
+	MOVWU	(3*4)(A1), M3
+	MOVWU	(4*4)(A1), M4
+	MOVWU	(5*4)(A1), M5
+	MOVWU	(6*4)(A1), M6
+	MOVWU	(7*4)(A1), M7
+	MOVWU	(8*4)(A1), M8
+	MOVWU	(9*4)(A1), M9
+	MOVWU	(10*4)(A1), M10
+	MOVWU	(11*4)(A1), M11
+	MOVWU	(12*4)(A1), M12
+	MOVWU	(13*4)(A1), M13
+	MOVWU	(14*4)(A1), M14
+	MOVWU	(15*4)(A1), M15
+	JMP	rounds
+
+unaligned:
+	LOAD32((0*4), M0)
+	LOAD32((1*4), M1)
+	LOAD32((2*4), M2)
+	LOAD32((3*4), M3)
+	LOAD32((4*4), M4)
+	LOAD32((5*4), M5)
+	LOAD32((6*4), M6)
+	LOAD32((7*4), M7)
+	LOAD32((8*4), M8)
+	LOAD32((9*4), M9)
+	LOAD32((10*4), M10)
+	LOAD32((11*4), M11)
+	LOAD32((12*4), M12)
+	LOAD32((13*4), M13)
+	LOAD32((14*4), M14)
+	LOAD32((15*4), M15)
+
+rounds:
+	ROUND1(A3, A4, A5, A6, M0, 0xd76aa478, 7)
+	ROUND1(A6, A3, A4, A5, M1, 0xe8c7b756, 12)
+	ROUND1(A5, A6, A3, A4, M2, 0x242070db, 17)
+	ROUND1(A4, A5, A6, A3, M3, 0xc1bdceee, 22)
+	ROUND1(A3, A4, A5, A6, M4, 0xf57c0faf, 7)
+	ROUND1(A6, A3, A4, A5, M5, 0x4787c62a, 12)
+	ROUND1(A5, A6, A3, A4, M6, 0xa8304613, 17)
+	ROUND1(A4, A5, A6, A3, M7, 0xfd469501, 22)
+	ROUND1(A3, A4, A5, A6, M8, 0x698098d8, 7)
+	ROUND1(A6, A3, A4, A5, M9, 0x8b44f7af, 12)
+	ROUND1(A5, A6, A3, A4, M10, 0xffff5bb1, 17)
+	ROUND1(A4, A5, A6, A3, M11, 0x895cd7be, 22)
+	ROUND1(A3, A4, A5, A6, M12, 0x6b901122, 7)
+	ROUND1(A6, A3, A4, A5, M13, 0xfd987193, 12)
+	ROUND1(A5, A6, A3, A4, M14, 0xa679438e, 17)
+	ROUND1(A4, A5, A6, A3, M15, 0x49b40821, 22)
+
+	ROUND2(A3, A4, A5, A6, M1, 0xf61e2562, 5)
+	ROUND2(A6, A3, A4, A5, M6, 0xc040b340, 9)
+	ROUND2(A5, A6, A3, A4, M11, 0x265e5a51, 14)
+	ROUND2(A4, A5, A6, A3, M0, 0xe9b6c7aa, 20)
+	ROUND2(A3, A4, A5, A6, M5, 0xd62f105d, 5)
+	ROUND2(A6, A3, A4, A5, M10, 0x02441453, 9)
+	ROUND2(A5, A6, A3, A4, M15, 0xd8a1e681, 14)
+	ROUND2(A4, A5, A6, A3, M4, 0xe7d3fbc8, 20)
+	ROUND2(A3, A4, A5, A6, M9, 0x21e1cde6, 5)
+	ROUND2(A6, A3, A4, A5, M14, 0xc33707d6, 9)
+	ROUND2(A5, A6, A3, A4, M3, 0xf4d50d87, 14)
+	ROUND2(A4, A5, A6, A3, M8, 0x455a14ed, 20)
+	ROUND2(A3, A4, A5, A6, M13, 0xa9e3e905, 5)
+	ROUND2(A6, A3, A4, A5, M2, 0xfcefa3f8, 9)
+	ROUND2(A5, A6, A3, A4, M7, 0x676f02d9, 14)
+	ROUND2(A4, A5, A6, A3, M12, 0x8d2a4c8a, 20)
+
+	ROUND3(A3, A4, A5, A6, M5, 0xfffa3942, 4)
+	ROUND3(A6, A3, A4, A5, M8, 0x8771f681, 11)
+	ROUND3(A5, A6, A3, A4, M11, 0x6d9d6122, 16)
+	ROUND3(A4, A5, A6, A3, M14, 0xfde5380c, 23)
+	ROUND3(A3, A4, A5, A6, M1, 0xa4beea44, 4)
+	ROUND3(A6, A3, A4, A5, M4, 0x4bdecfa9, 11)
+	ROUND3(A5, A6, A3, A4, M7, 0xf6bb4b60, 16)
+	ROUND3(A4, A5, A6, A3, M10, 0xbebfbc70, 23)
+	ROUND3(A3, A4, A5, A6, M13, 0x289b7ec6, 4)
+	ROUND3(A6, A3, A4, A5, M0, 0xeaa127fa, 11)
+	ROUND3(A5, A6, A3, A4, M3, 0xd4ef3085, 16)
+	ROUND3(A4, A5, A6, A3, M6, 0x04881d05, 23)
+	ROUND3(A3, A4, A5, A6, M9, 0xd9d4d039, 4)
+	ROUND3(A6, A3, A4, A5, M12, 0xe6db99e5, 11)
+	ROUND3(A5, A6, A3, A4, M15, 0x1fa27cf8, 16)
+	ROUND3(A4, A5, A6, A3, M2, 0xc4ac5665, 23)
+
+	ROUND4(A3, A4, A5, A6, M0, 0xf4292244, 6)
+	ROUND4(A6, A3, A4, A5, M7, 0x432aff97, 10)
+	ROUND4(A5, A6, A3, A4, M14, 0xab9423a7, 15)
+	ROUND4(A4, A5, A6, A3, M5, 0xfc93a039, 21)
+	ROUND4(A3, A4, A5, A6, M12, 0x655b59c3, 6)
+	ROUND4(A6, A3, A4, A5, M3, 0x8f0ccc92, 10)
+	ROUND4(A5, A6, A3, A4, M10, 0xffeff47d, 15)
+	ROUND4(A4, A5, A6, A3, M1, 0x85845dd1, 21)
+	ROUND4(A3, A4, A5, A6, M8, 0x6fa87e4f, 6)
+	ROUND4(A6, A3, A4, A5, M15, 0xfe2ce6e0, 10)
+	ROUND4(A5, A6, A3, A4, M6, 0xa3014314, 15)
+	ROUND4(A4, A5, A6, A3, M13, 0x4e0811a1, 21)
+	ROUND4(A3, A4, A5, A6, M4, 0xf7537e82, 6)
+	ROUND4(A6, A3, A4, A5, M11, 0xbd3af235, 10)
+	ROUND4(A5, A6, A3, A4, M2, 0x2ad7d2bb, 15)
+	ROUND4(A4, A5, A6, A3, M9, 0xeb86d391, 21)
+
+	MOVWU	(0*4)(A0), A7
+	ADD	A7, A3
+	MOVW	A3, (0*4)(A0)
+	MOVWU	(1*4)(A0), A7
+	ADD	A7, A4
+	MOVW	A4, (1*4)(A0)
+	MOVWU	(2*4)(A0), A7
+	ADD	A7, A5
+	MOVW	A5, (2*4)(A0)
+	MOVWU	(3*4)(A0), A7
+	ADD	A7, A6
+	MOVW	A6, (3*4)(A0)
+
+	ADD	$64, A1
+	BNE	A1, A2, loop
+
+done:
+	RET
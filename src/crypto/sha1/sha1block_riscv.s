@@ -0,0 +1,265 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// SHA1 block routine for RISC-V. See sha1block.go for Go equivalent.
+//
+// The sixteen message schedule words live in registers, as do the
+// five working variables, leaving two scratch registers (A0 and S10).
+// Only the low 32 bits of each register are meaningful: additions and
+// logic carry garbage into the upper bits, and the 32-bit shifts used
+// for rotations ignore it.
+//
+// As in sha1block_amd64.s, the register rotation is implemented by
+// rotating the arguments to the round macros.
+
+// The message schedule.
+#define W0 T0
+#define W1 T1
+#define W2 T2
+#define W3 T3
+#define W4 T4
+#define W5 T5
+#define W6 S0
+#define W7 S1
+#define W8 S2
+#define W9 S3
+#define W10 S4
+#define W11 S5
+#define W12 S6
+#define W13 S7
+#define W14 S8
+#define W15 S9
+
+// Round constants are sign-extended so that MOV builds them with LUI
+// and ADDIW instead of loading them from the literal pool.
+#define K32(c) $((c) - (((c)>>31)<<32))
+
+#ifdef GORISCV_b
+#define ROL32(s, x, y) \
+	RORIW	$(32-s), x, y
+#else
+#define ROL32(s, x, y) \
+	SLLIW	$s, x, S10; \
+	SRLIW	$(32-s), x, y; \
+	OR	S10, y
+#endif
+
+// LOAD32BE loads the big-endian word at off(A1) into x a byte at a
+// time, as p need not be aligned.
+#define LOAD32BE(off, x) \
+	MOVBU	off+0(A1), x; \
+	MOVBU	off+1(A1), A0; \
+	SLL	$8, x; \
+	OR	A0, x; \
+	MOVBU	off+2(A1), A0; \
+	SLL	$8, x; \
+	OR	A0, x; \
+	MOVBU	off+3(A1), A0; \
+	SLL	$8, x; \
+	OR	A0, x
+
+// w = (w ^ w3 ^ w8 ^ w14) <<< 1
+#define SHUFFLE(w, w3, w8, w14) \
+	XOR	w3, w; \
+	XOR	w8, w; \
+	XOR	w14, w; \
+	ROL32(1, w, w)
+
+// Each FUNC leaves its result in A0.
+#define FUNC1(a, b, c, d, e) \
+	XOR	c, d, A0; \
+	AND	b, A0; \
+	XOR	d, A0
+
+#define FUNC2(a, b, c, d, e) \
+	XOR	b, c, A0; \
+	XOR	d, A0
+
+#define FUNC3(a, b, c, d, e) \
+	OR	b, c, A0; \
+	AND	d, A0; \
+	AND	b, c, S10; \
+	OR	S10, A0
+
+#define FUNC4 FUNC2
+
+// e += FUNC + w + const + (a <<< 5); b <<<= 30
+#define MIX(a, b, c, d, e, w, const) \
+	ADD	A0, e; \
+	ADD	w, e; \
+	MOV	K32(const), A0; \
+	ADD	A0, e; \
+	ROL32(5, a, A0); \
+	ADD	A0, e; \
+	ROL32(30, b, b)
+
+#define ROUND1(a, b, c, d, e, w) \
+	FUNC1(a, b, c, d, e); \
+	MIX(a, b, c, d, e, w, 0x5A827999)
+
+#define ROUND1x(a, b, c, d, e, w, w3, w8, w14) \
+	SHUFFLE(w, w3, w8, w14); \
+	FUNC1(a, b, c, d, e); \
+	MIX(a, b, c, d, e, w, 0x5A827999)
+
+#define ROUND2(a, b, c, d, e, w, w3, w8, w14) \
+	SHUFFLE(w, w3, w8, w14); \
+	FUNC2(a, b, c, d, e); \
+	MIX(a, b, c, d, e, w, 0x6ED9EBA1)
+
+#define ROUND3(a, b, c, d, e, w, w3, w8, w14) \
+	SHUFFLE(w, w3, w8, w14); \
+	FUNC3(a, b, c, d, e); \
+	MIX(a, b, c, d, e, w, 0x8F1BBCDC)
+
+#define ROUND4(a, b, c, d, e, w, w3, w8, w14) \
+	SHUFFLE(w, w3, w8, w14); \
+	FUNC4(a, b, c, d, e); \
+	MIX(a, b, c, d, e, w, 0xCA62C1D6)
+
+// func block(dig *digest, p []byte)
+TEXT ·block(SB),NOSPLIT,$0-32
+	MOV	dig+0(FP), A0
+	MOV	p_base+8(FP), A1
+	MOV	p_len+16(FP), A2
+	SRL	$6, A2
+	SLL	$6, A2
+	ADD	A1, A2	// end of the last whole block
+
+	MOVWU	(0*4)(A0), A3
+	MOVWU	(1*4)(A0), A4
+	MOVWU	(2*4)(A0), A5
+	MOVWU	(3*4)(A0), A6
+	MOVWU	(4*4)(A0), A7
+
+	BEQ	A1, A2, done
+
+loop:
+	LOAD32BE((0*4), W0)
+	LOAD32BE((1*4), W1)
+	LOAD32BE((2*4), W2)
+	LOAD32BE((3*4), W3)
+	LOAD32BE((4*4), W4)
+	LOAD32BE((5*4), W5)
+	LOAD32BE((6*4), W6)
+	LOAD32BE((7*4), W7)
+	LOAD32BE((8*4), W8)
+	LOAD32BE((9*4), W9)
+	LOAD32BE((10*4), W10)
+	LOAD32BE((11*4), W11)
+	LOAD32BE((12*4), W12)
+	LOAD32BE((13*4), W13)
+	LOAD32BE((14*4), W14)
+	LOAD32BE((15*4), W15)
+
+	ROUND1(A3, A4, A5, A6, A7, W0)
+	ROUND1(A7, A3, A4, A5, A6, W1)
+	ROUND1(A6, A7, A3, A4, A5, W2)
+	ROUND1(A5, A6, A7, A3, A4, W3)
+	ROUND1(A4, A5, A6, A7, A3, W4)
+	ROUND1(A3, A4, A5, A6, A7, W5)
+	ROUND1(A7, A3, A4, A5, A6, W6)
+	ROUND1(A6, A7, A3, A4, A5, W7)
+	ROUND1(A5, A6, A7, A3, A4, W8)
+	ROUND1(A4, A5, A6, A7, A3, W9)
+	ROUND1(A3, A4, A5, A6, A7, W10)
+	ROUND1(A7, A3, A4, A5, A6, W11)
+	ROUND1(A6, A7, A3, A4, A5, W12)
+	ROUND1(A5, A6, A7, A3, A4, W13)
+	ROUND1(A4, A5, A6, A7, A3, W14)
+	ROUND1(A3, A4, A5, A6, A7, W15)
+	ROUND1x(A7, A3, A4, A5, A6, W0, W13, W8, W2)
+	ROUND1x(A6, A7, A3, A4, A5, W1, W14, W9, W3)
+	ROUND1x(A5, A6, A7, A3, A4, W2, W15, W10, W4)
+	ROUND1x(A4, A5, A6, A7, A3, W3, W0, W11, W5)
+
+	ROUND2(A3, A4, A5, A6, A7, W4, W1, W12, W6)
+	ROUND2(A7, A3, A4, A5, A6, W5, W2, W13, W7)
+	ROUND2(A6, A7, A3, A4, A5, W6, W3, W14, W8)
+	ROUND2(A5, A6, A7, A3, A4, W7, W4, W15, W9)
+	ROUND2(A4, A5, A6, A7, A3, W8, W5, W0, W10)
+	ROUND2(A3, A4, A5, A6, A7, W9, W6, W1, W11)
+	ROUND2(A7, A3, A4, A5, A6, W10, W7, W2, W12)
+	ROUND2(A6, A7, A3, A4, A5, W11, W8, W3, W13)
+	ROUND2(A5, A6, A7, A3, A4, W12, W9, W4, W14)
+	ROUND2(A4, A5, A6, A7, A3, W13, W10, W5, W15)
+	ROUND2(A3, A4, A5, A6, A7, W14, W11, W6, W0)
+	ROUND2(A7, A3, A4, A5, A6, W15, W12, W7, W1)
+	ROUND2(A6, A7, A3, A4, A5, W0, W13, W8, W2)
+	ROUND2(A5, A6, A7, A3, A4, W1, W14, W9, W3)
+	ROUND2(A4, A5, A6, A7, A3, W2, W15, W10, W4)
+	ROUND2(A3, A4, A5, A6, A7, W3, W0, W11, W5)
+	ROUND2(A7, A3, A4, A5, A6, W4, W1, W12, W6)
+	ROUND2(A6, A7, A3, A4, A5, W5, W2, W13, W7)
+	ROUND2(A5, A6, A7, A3, A4, W6, W3, W14, W8)
+	ROUND2(A4, A5, A6, A7, A3, W7, W4, W15, W9)
+
+	ROUND3(A3, A4, A5, A6, A7, W8, W5, W0, W10)
+	ROUND3(A7, A3, A4, A5, A6, W9, W6, W1, W11)
+	ROUND3(A6, A7, A3, A4, A5, W10, W7, W2, W12)
+	ROUND3(A5, A6, A7, A3, A4, W11, W8, W3, W13)
+	ROUND3(A4, A5, A6, A7, A3, W12, W9, W4, W14)
+	ROUND3(A3, A4, A5, A6, A7, W13, W10, W5, W15)
+	ROUND3(A7, A3, A4, A5, A6, W14, W11, W6, W0)
+	ROUND3(A6, A7, A3, A4, A5, W15, W12, W7, W1)
+	ROUND3(A5, A6, A7, A3, A4, W0, W13, W8, W2)
+	ROUND3(A4, A5, A6, A7, A3, W1, W14, W9, W3)
+	ROUND3(A3, A4, A5, A6, A7, W2, W15, W10, W4)
+	ROUND3(A7, A3, A4, A5, A6, W3, W0, W11, W5)
+	ROUND3(A6, A7, A3, A4, A5, W4, W1, W12, W6)
+	ROUND3(A5, A6, A7, A3, A4, W5, W2, W13, W7)
+	ROUND3(A4, A5, A6, A7, A3, W6, W3, W14, W8)
+	ROUND3(A3, A4, A5, A6, A7, W7, W4, W15, W9)
+	ROUND3(A7, A3, A4, A5, A6, W8, W5, W0, W10)
+	ROUND3(A6, A7, A3, A4, A5, W9, W6, W1, W11)
+	ROUND3(A5, A6, A7, A3, A4, W10, W7, W2, W12)
+	ROUND3(A4, A5, A6, A7, A3, W11, W8, W3, W13)
+
+	ROUND4(A3, A4, A5, A6, A7, W12, W9, W4, W14)
+	ROUND4(A7, A3, A4, A5, A6, W13, W10, W5, W15)
+	ROUND4(A6, A7, A3, A4, A5, W14, W11, W6, W0)
+	ROUND4(A5, A6, A7, A3, A4, W15, W12, W7, W1)
+	ROUND4(A4, A5, A6, A7, A3, W0, W13, W8, W2)
+	ROUND4(A3, A4, A5, A6, A7, W1, W14, W9, W3)
+	ROUND4(A7, A3, A4, A5, A6, W2, W15, W10, W4)
+	ROUND4(A6, A7, A3, A4, A5, W3, W0, W11, W5)
+	ROUND4(A5, A6, A7, A3, A4, W4, W1, W12, W6)
+	ROUND4(A4, A5, A6, A7, A3, W5, W2, W13, W7)
+	ROUND4(A3, A4, A5, A6, A7, W6, W3, W14, W8)
+	ROUND4(A7, A3, A4, A5, A6, W7, W4, W15, W9)
+	ROUND4(A6, A7, A3, A4, A5, W8, W5, W0, W10)
+	ROUND4(A5, A6, A7, A3, A4, W9, W6, W1, W11)
+	ROUND4(A4, A5, A6, A7, A3, W10, W7, W2, W12)
+	ROUND4(A3, A4, A5, A6, A7, W11, W8, W3, W13)
+	ROUND4(A7, A3, A4, A5, A6, W12, W9, W4, W14)
+	ROUND4(A6, A7, A3, A4, A5, W13, W10, W5, W15)
+	ROUND4(A5, A6, A7, A3, A4, W14, W11, W6, W0)
+	ROUND4(A4, A5, A6, A7, A3, W15, W12, W7, W1)
+
+	// The schedule is dead; reuse its registers to add in the
+	// previous state.
+	MOV	dig+0(FP), W0
+	MOVWU	(0*4)(W0), W1
+	ADD	W1, A3
+	MOVW	A3, (0*4)(W0)
+	MOVWU	(1*4)(W0), W1
+	ADD	W1, A4
+	MOVW	A4, (1*4)(W0)
+	MOVWU	(2*4)(W0), W1
+	ADD	W1, A5
+	MOVW	A5, (2*4)(W0)
+	MOVWU	(3*4)(W0), W1
+	ADD	W1, A6
+	MOVW	A6, (3*4)(W0)
+	MOVWU	(4*4)(W0), W1
+	ADD	W1, A7
+	MOVW	A7, (4*4)(W0)
+
+	ADD	$64, A1
+	BNE	A1, A2, loop
+
+done:
+	RET
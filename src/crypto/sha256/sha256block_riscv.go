@@ -0,0 +1,12 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sha256
+
+// zknhCheck reports whether the CPU has the Zknh SHA-2
+// instructions, as detected by the runtime.
+func zknhCheck() bool
+
+// useZknh selects the Zknh rounds in block.
+var useZknh = zknhCheck()
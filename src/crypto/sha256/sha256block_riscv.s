@@ -0,0 +1,317 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// SHA256 block routine for RISC-V. See sha256block.go for Go equivalent.
+//
+// The eight working variables and the sixteen message schedule words
+// are all kept in registers, which leaves two scratch registers (S9
+// and S10) and needs RA as well. Having a frame makes the prologue save
+// RA; the block pointer and the end of the input are kept in the frame
+// above it.
+//
+// Only the low 32 bits of each register are meaningful: additions and
+// logic carry garbage into the upper bits, which the rotations and the
+// final MOVW discard.
+//
+// There are two copies of the rounds, both expanded from ROUNDS: one
+// computing the Σ and σ functions from shifts, and one using the Zknh
+// instructions, chosen for each block from useZknh. The message loading
+// and the digest update are shared.
+//
+// The register rotation is implemented by rotating the arguments to
+// the round macros instead of by explicit move instructions.
+
+// The message schedule.
+#define W0 T0
+#define W1 T1
+#define W2 T2
+#define W3 T3
+#define W4 T4
+#define W5 T5
+#define W6 S0
+#define W7 S1
+#define W8 S2
+#define W9 S3
+#define W10 S4
+#define W11 S5
+#define W12 S6
+#define W13 S7
+#define W14 S8
+#define W15 RA
+
+// Round constants are sign-extended so that MOV builds them with LUI
+// and ADDIW instead of loading them from the literal pool.
+#define K32(c) $((c) - (((c)>>31)<<32))
+
+// LOAD32BE loads the big-endian word at off(S9) into x a byte at a
+// time, as p need not be aligned.
+#define LOAD32BE(off, x) \
+	MOVBU	off+0(S9), x; \
+	MOVBU	off+1(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+2(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+3(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x
+
+// S9 = x>>>r1 ^ x>>>r2 ^ x>>>r3, computed as
+// (x ^ (x ^ x>>>(r3-r2))>>>(r2-r1))>>>r1.
+#ifdef GORISCV_b
+#define SIGMA(x, r1, r2, r3) \
+	RORIW	$(r3-r2), x, S9; \
+	XOR	x, S9; \
+	RORIW	$(r2-r1), S9, S9; \
+	XOR	x, S9; \
+	RORIW	$r1, S9, S9
+#else
+// With x duplicated into both halves of S10, the low 32 bits of a
+// right shift by up to 32 are a rotation of x, and XORing further
+// copies in keeps that true for the remaining shifts.
+#define SIGMA(x, r1, r2, r3) \
+	SLL	$32, x, S10; \
+	SRL	$32, S10, S9; \
+	OR	S9, S10; \
+	SRL	$(r3-r2), S10, S9; \
+	XOR	S10, S9; \
+	SRL	$(r2-r1), S9; \
+	XOR	S10, S9; \
+	SRL	$r1, S9
+#endif
+
+// S9 = x>>>r1 ^ x>>>r2 ^ x>>s
+#ifdef GORISCV_b
+#define SSIGMA(x, r1, r2, s) \
+	RORIW	$(r2-r1), x, S9; \
+	XOR	x, S9; \
+	RORIW	$r1, S9, S9; \
+	SRLIW	$s, x, S10; \
+	XOR	S10, S9
+#else
+#define SSIGMA(x, r1, r2, s) \
+	SLL	$32, x, S10; \
+	SRL	$32, S10, S9; \
+	OR	S9, S10; \
+	SRL	$(r2-r1), S10, S9; \
+	XOR	S10, S9; \
+	SRL	$r1, S9; \
+	SRLIW	$s, x, S10; \
+	XOR	S10, S9
+#endif
+
+// w += σ0(w15) + σ1(w2) + w7, with σ0 and σ1 computed by sig0 and sig1.
+#define SCHED(sig0, sig1, w, w2, w7, w15) \
+	sig0; \
+	ADD	S9, w; \
+	sig1; \
+	ADD	S9, w; \
+	ADD	w7, w
+
+// h += Σ1(e) + Ch(e, f, g) + w + const; d += h; h += Σ0(a) + Maj(a, b, c)
+// where Ch(e, f, g) = g ^ (e & (f ^ g)) and
+// Maj(a, b, c) = b ^ ((a ^ b) & (b ^ c)).
+#define ROUNDF(sum0, sum1, a, b, c, d, e, f, g, h, w, const) \
+	sum1; \
+	ADD	S9, h; \
+	XOR	f, g, S9; \
+	AND	e, S9; \
+	XOR	g, S9; \
+	ADD	S9, h; \
+	ADD	w, h; \
+	MOV	const, S9; \
+	ADD	S9, h; \
+	ADD	h, d; \
+	sum0; \
+	ADD	S9, h; \
+	XOR	a, b, S9; \
+	XOR	b, c, S10; \
+	AND	S10, S9; \
+	XOR	b, S9; \
+	ADD	S9, h
+
+#define BSIG0(x) SIGMA(x, 2, 13, 22)
+#define BSIG1(x) SIGMA(x, 6, 11, 25)
+#define SSIG0(x) SSIGMA(x, 7, 18, 3)
+#define SSIG1(x) SSIGMA(x, 17, 19, 10)
+
+#define ZBSIG0(x) SHA256SUM0 x, S9
+#define ZBSIG1(x) SHA256SUM1 x, S9
+#define ZSSIG0(x) SHA256SIG0 x, S9
+#define ZSSIG1(x) SHA256SIG1 x, S9
+
+#define ROUND(a, b, c, d, e, f, g, h, w, const) \
+	ROUNDF(BSIG0(a), BSIG1(e), a, b, c, d, e, f, g, h, w, K32(const))
+
+#define ROUNDx(a, b, c, d, e, f, g, h, w, w2, w7, w15, const) \
+	SCHED(SSIG0(w15), SSIG1(w2), w, w2, w7, w15); \
+	ROUND(a, b, c, d, e, f, g, h, w, const)
+
+#define ZROUND(a, b, c, d, e, f, g, h, w, const) \
+	ROUNDF(ZBSIG0(a), ZBSIG1(e), a, b, c, d, e, f, g, h, w, K32(const))
+
+#define ZROUNDx(a, b, c, d, e, f, g, h, w, w2, w7, w15, const) \
+	SCHED(ZSSIG0(w15), ZSSIG1(w2), w, w2, w7, w15); \
+	ZROUND(a, b, c, d, e, f, g, h, w, const)
+
+// ROUNDS does the 64 rounds on a block, with round and roundx
+// expanding to the rounds without and with message scheduling.
+#define ROUNDS(round, roundx) \
+	round(A0, A1, A2, A3, A4, A5, A6, A7, W0, 0x428a2f98); \
+	round(A7, A0, A1, A2, A3, A4, A5, A6, W1, 0x71374491); \
+	round(A6, A7, A0, A1, A2, A3, A4, A5, W2, 0xb5c0fbcf); \
+	round(A5, A6, A7, A0, A1, A2, A3, A4, W3, 0xe9b5dba5); \
+	round(A4, A5, A6, A7, A0, A1, A2, A3, W4, 0x3956c25b); \
+	round(A3, A4, A5, A6, A7, A0, A1, A2, W5, 0x59f111f1); \
+	round(A2, A3, A4, A5, A6, A7, A0, A1, W6, 0x923f82a4); \
+	round(A1, A2, A3, A4, A5, A6, A7, A0, W7, 0xab1c5ed5); \
+	round(A0, A1, A2, A3, A4, A5, A6, A7, W8, 0xd807aa98); \
+	round(A7, A0, A1, A2, A3, A4, A5, A6, W9, 0x12835b01); \
+	round(A6, A7, A0, A1, A2, A3, A4, A5, W10, 0x243185be); \
+	round(A5, A6, A7, A0, A1, A2, A3, A4, W11, 0x550c7dc3); \
+	round(A4, A5, A6, A7, A0, A1, A2, A3, W12, 0x72be5d74); \
+	round(A3, A4, A5, A6, A7, A0, A1, A2, W13, 0x80deb1fe); \
+	round(A2, A3, A4, A5, A6, A7, A0, A1, W14, 0x9bdc06a7); \
+	round(A1, A2, A3, A4, A5, A6, A7, A0, W15, 0xc19bf174); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W0, W14, W9, W1, 0xe49b69c1); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W1, W15, W10, W2, 0xefbe4786); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W2, W0, W11, W3, 0x0fc19dc6); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W3, W1, W12, W4, 0x240ca1cc); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W4, W2, W13, W5, 0x2de92c6f); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W5, W3, W14, W6, 0x4a7484aa); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W6, W4, W15, W7, 0x5cb0a9dc); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W7, W5, W0, W8, 0x76f988da); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W8, W6, W1, W9, 0x983e5152); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W9, W7, W2, W10, 0xa831c66d); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W10, W8, W3, W11, 0xb00327c8); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W11, W9, W4, W12, 0xbf597fc7); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W12, W10, W5, W13, 0xc6e00bf3); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W13, W11, W6, W14, 0xd5a79147); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W14, W12, W7, W15, 0x06ca6351); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W15, W13, W8, W0, 0x14292967); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W0, W14, W9, W1, 0x27b70a85); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W1, W15, W10, W2, 0x2e1b2138); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W2, W0, W11, W3, 0x4d2c6dfc); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W3, W1, W12, W4, 0x53380d13); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W4, W2, W13, W5, 0x650a7354); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W5, W3, W14, W6, 0x766a0abb); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W6, W4, W15, W7, 0x81c2c92e); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W7, W5, W0, W8, 0x92722c85); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W8, W6, W1, W9, 0xa2bfe8a1); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W9, W7, W2, W10, 0xa81a664b); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W10, W8, W3, W11, 0xc24b8b70); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W11, W9, W4, W12, 0xc76c51a3); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W12, W10, W5, W13, 0xd192e819); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W13, W11, W6, W14, 0xd6990624); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W14, W12, W7, W15, 0xf40e3585); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W15, W13, W8, W0, 0x106aa070); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W0, W14, W9, W1, 0x19a4c116); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W1, W15, W10, W2, 0x1e376c08); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W2, W0, W11, W3, 0x2748774c); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W3, W1, W12, W4, 0x34b0bcb5); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W4, W2, W13, W5, 0x391c0cb3); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W5, W3, W14, W6, 0x4ed8aa4a); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W6, W4, W15, W7, 0x5b9cca4f); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W7, W5, W0, W8, 0x682e6ff3); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W8, W6, W1, W9, 0x748f82ee); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W9, W7, W2, W10, 0x78a5636f); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W10, W8, W3, W11, 0x84c87814); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W11, W9, W4, W12, 0x8cc70208); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W12, W10, W5, W13, 0x90befffa); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W13, W11, W6, W14, 0xa4506ceb); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W14, W12, W7, W15, 0xbef9a3f7); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W15, W13, W8, W0, 0xc67178f2)
+
+// func block(dig *digest, p []byte)
+TEXT ·block(SB),NOSPLIT,$16-32
+	MOV	p_base+8(FP), S9
+	MOV	p_len+16(FP), S10
+	SRL	$6, S10
+	SLL	$6, S10
+	ADD	S9, S10	// end of the last whole block
+	BEQ	S9, S10, done
+	MOV	S9, 8(X2)
+	MOV	S10, 16(X2)
+
+	MOV	dig+0(FP), S9
+	MOVWU	(0*4)(S9), A0
+	MOVWU	(1*4)(S9), A1
+	MOVWU	(2*4)(S9), A2
+	MOVWU	(3*4)(S9), A3
+	MOVWU	(4*4)(S9), A4
+	MOVWU	(5*4)(S9), A5
+	MOVWU	(6*4)(S9), A6
+	MOVWU	(7*4)(S9), A7
+
+loop:
+	MOV	8(X2), S9
+	LOAD32BE((0*4), W0)
+	LOAD32BE((1*4), W1)
+	LOAD32BE((2*4), W2)
+	LOAD32BE((3*4), W3)
+	LOAD32BE((4*4), W4)
+	LOAD32BE((5*4), W5)
+	LOAD32BE((6*4), W6)
+	LOAD32BE((7*4), W7)
+	LOAD32BE((8*4), W8)
+	LOAD32BE((9*4), W9)
+	LOAD32BE((10*4), W10)
+	LOAD32BE((11*4), W11)
+	LOAD32BE((12*4), W12)
+	LOAD32BE((13*4), W13)
+	LOAD32BE((14*4), W14)
+	LOAD32BE((15*4), W15)
+	ADD	$64, S9
+	MOV	S9, 8(X2)
+
+	MOVBU	·useZknh(SB), S10
+	BNE	S10, ZERO, zknh
+	ROUNDS(ROUND, ROUNDx)
+	JMP	update
+
+zknh:
+	ROUNDS(ZROUND, ZROUNDx)
+
+update:
+	MOV	dig+0(FP), W0
+	MOVWU	(0*4)(W0), W1
+	ADD	W1, A0
+	MOVW	A0, (0*4)(W0)
+	MOVWU	(1*4)(W0), W1
+	ADD	W1, A1
+	MOVW	A1, (1*4)(W0)
+	MOVWU	(2*4)(W0), W1
+	ADD	W1, A2
+	MOVW	A2, (2*4)(W0)
+	MOVWU	(3*4)(W0), W1
+	ADD	W1, A3
+	MOVW	A3, (3*4)(W0)
+	MOVWU	(4*4)(W0), W1
+	ADD	W1, A4
+	MOVW	A4, (4*4)(W0)
+	MOVWU	(5*4)(W0), W1
+	ADD	W1, A5
+	MOVW	A5, (5*4)(W0)
+	MOVWU	(6*4)(W0), W1
+	ADD	W1, A6
+	MOVW	A6, (6*4)(W0)
+	MOVWU	(7*4)(W0), W1
+	ADD	W1, A7
+	MOVW	A7, (7*4)(W0)
+
+	MOV	8(X2), W0
+	MOV	16(X2), W1
+	BNE	W0, W1, loop
+
+done:
+	RET
+
+// func zknhCheck() bool
+TEXT ·zknhCheck(SB),NOSPLIT,$0-1
+	MOVBU	runtime·support_zknh(SB), T0
+	MOVB	T0, ret+0(FP)
+	RET
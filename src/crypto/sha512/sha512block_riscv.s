@@ -0,0 +1,321 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// SHA512 block routine for RISC-V. See sha512block.go for Go equivalent.
+//
+// The eight working variables and the sixteen message schedule words
+// are all kept in registers, which leaves two scratch registers (S9
+// and S10) and needs RA as well. Having a frame makes the prologue save
+// RA; the block pointer and the end of the input are kept in the frame
+// above it.
+//
+// There are two copies of the rounds, both expanded from ROUNDS: one
+// computing the Σ and σ functions from shifts, and one using the Zknh
+// instructions, chosen for each block from useZknh. The message loading
+// and the digest update are shared.
+//
+// The register rotation is implemented by rotating the arguments to
+// the round macros instead of by explicit move instructions.
+
+// The message schedule.
+#define W0 T0
+#define W1 T1
+#define W2 T2
+#define W3 T3
+#define W4 T4
+#define W5 T5
+#define W6 S0
+#define W7 S1
+#define W8 S2
+#define W9 S3
+#define W10 S4
+#define W11 S5
+#define W12 S6
+#define W13 S7
+#define W14 S8
+#define W15 RA
+
+// LOAD64BE loads the big-endian doubleword at off(S9) into x a byte at
+// a time, as p need not be aligned.
+#define LOAD64BE(off, x) \
+	MOVBU	off+0(S9), x; \
+	MOVBU	off+1(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+2(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+3(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+4(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+5(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+6(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x; \
+	MOVBU	off+7(S9), S10; \
+	SLL	$8, x; \
+	OR	S10, x
+
+// y = x>>>n. S10 is clobbered unless Zbb is available.
+#ifdef GORISCV_b
+#define ROR(n, x, y) \
+	RORI	$n, x, y
+#else
+#define ROR(n, x, y) \
+	SRL	$n, x, S10; \
+	SLL	$(64-n), x, y; \
+	OR	S10, y
+#endif
+
+// S9 = x>>>r1 ^ x>>>r2 ^ x>>>r3, computed as
+// (x ^ (x ^ x>>>(r3-r2))>>>(r2-r1))>>>r1.
+#define SIGMA(x, r1, r2, r3) \
+	ROR((r3-r2), x, S9); \
+	XOR	x, S9; \
+	ROR((r2-r1), S9, S9); \
+	XOR	x, S9; \
+	ROR(r1, S9, S9)
+
+// S9 = x>>>r1 ^ x>>>r2 ^ x>>s
+#define SSIGMA(x, r1, r2, s) \
+	ROR((r2-r1), x, S9); \
+	XOR	x, S9; \
+	ROR(r1, S9, S9); \
+	SRL	$s, x, S10; \
+	XOR	S10, S9
+
+// w += σ0(w15) + σ1(w2) + w7, with σ0 and σ1 computed by sig0 and sig1.
+#define SCHED(sig0, sig1, w, w2, w7, w15) \
+	sig0; \
+	ADD	S9, w; \
+	sig1; \
+	ADD	S9, w; \
+	ADD	w7, w
+
+// h += Σ1(e) + Ch(e, f, g) + w + const; d += h; h += Σ0(a) + Maj(a, b, c)
+// where Ch(e, f, g) = g ^ (e & (f ^ g)) and
+// Maj(a, b, c) = b ^ ((a ^ b) & (b ^ c)).
+#define ROUNDF(sum0, sum1, a, b, c, d, e, f, g, h, w, const) \
+	sum1; \
+	ADD	S9, h; \
+	XOR	f, g, S9; \
+	AND	e, S9; \
+	XOR	g, S9; \
+	ADD	S9, h; \
+	ADD	w, h; \
+	MOV	const, S9; \
+	ADD	S9, h; \
+	ADD	h, d; \
+	sum0; \
+	ADD	S9, h; \
+	XOR	a, b, S9; \
+	XOR	b, c, S10; \
+	AND	S10, S9; \
+	XOR	b, S9; \
+	ADD	S9, h
+
+#define BSIG0(x) SIGMA(x, 28, 34, 39)
+#define BSIG1(x) SIGMA(x, 14, 18, 41)
+#define SSIG0(x) SSIGMA(x, 1, 8, 7)
+#define SSIG1(x) SSIGMA(x, 19, 61, 6)
+
+#define ZBSIG0(x) SHA512SUM0 x, S9
+#define ZBSIG1(x) SHA512SUM1 x, S9
+#define ZSSIG0(x) SHA512SIG0 x, S9
+#define ZSSIG1(x) SHA512SIG1 x, S9
+
+#define ROUND(a, b, c, d, e, f, g, h, w, const) \
+	ROUNDF(BSIG0(a), BSIG1(e), a, b, c, d, e, f, g, h, w, $const)
+
+#define ROUNDx(a, b, c, d, e, f, g, h, w, w2, w7, w15, const) \
+	SCHED(SSIG0(w15), SSIG1(w2), w, w2, w7, w15); \
+	ROUND(a, b, c, d, e, f, g, h, w, const)
+
+#define ZROUND(a, b, c, d, e, f, g, h, w, const) \
+	ROUNDF(ZBSIG0(a), ZBSIG1(e), a, b, c, d, e, f, g, h, w, $const)
+
+#define ZROUNDx(a, b, c, d, e, f, g, h, w, w2, w7, w15, const) \
+	SCHED(ZSSIG0(w15), ZSSIG1(w2), w, w2, w7, w15); \
+	ZROUND(a, b, c, d, e, f, g, h, w, const)
+
+// ROUNDS does the 80 rounds on a block, with round and roundx
+// expanding to the rounds without and with message scheduling.
+#define ROUNDS(round, roundx) \
+	round(A0, A1, A2, A3, A4, A5, A6, A7, W0, 0x428a2f98d728ae22); \
+	round(A7, A0, A1, A2, A3, A4, A5, A6, W1, 0x7137449123ef65cd); \
+	round(A6, A7, A0, A1, A2, A3, A4, A5, W2, 0xb5c0fbcfec4d3b2f); \
+	round(A5, A6, A7, A0, A1, A2, A3, A4, W3, 0xe9b5dba58189dbbc); \
+	round(A4, A5, A6, A7, A0, A1, A2, A3, W4, 0x3956c25bf348b538); \
+	round(A3, A4, A5, A6, A7, A0, A1, A2, W5, 0x59f111f1b605d019); \
+	round(A2, A3, A4, A5, A6, A7, A0, A1, W6, 0x923f82a4af194f9b); \
+	round(A1, A2, A3, A4, A5, A6, A7, A0, W7, 0xab1c5ed5da6d8118); \
+	round(A0, A1, A2, A3, A4, A5, A6, A7, W8, 0xd807aa98a3030242); \
+	round(A7, A0, A1, A2, A3, A4, A5, A6, W9, 0x12835b0145706fbe); \
+	round(A6, A7, A0, A1, A2, A3, A4, A5, W10, 0x243185be4ee4b28c); \
+	round(A5, A6, A7, A0, A1, A2, A3, A4, W11, 0x550c7dc3d5ffb4e2); \
+	round(A4, A5, A6, A7, A0, A1, A2, A3, W12, 0x72be5d74f27b896f); \
+	round(A3, A4, A5, A6, A7, A0, A1, A2, W13, 0x80deb1fe3b1696b1); \
+	round(A2, A3, A4, A5, A6, A7, A0, A1, W14, 0x9bdc06a725c71235); \
+	round(A1, A2, A3, A4, A5, A6, A7, A0, W15, 0xc19bf174cf692694); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W0, W14, W9, W1, 0xe49b69c19ef14ad2); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W1, W15, W10, W2, 0xefbe4786384f25e3); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W2, W0, W11, W3, 0x0fc19dc68b8cd5b5); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W3, W1, W12, W4, 0x240ca1cc77ac9c65); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W4, W2, W13, W5, 0x2de92c6f592b0275); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W5, W3, W14, W6, 0x4a7484aa6ea6e483); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W6, W4, W15, W7, 0x5cb0a9dcbd41fbd4); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W7, W5, W0, W8, 0x76f988da831153b5); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W8, W6, W1, W9, 0x983e5152ee66dfab); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W9, W7, W2, W10, 0xa831c66d2db43210); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W10, W8, W3, W11, 0xb00327c898fb213f); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W11, W9, W4, W12, 0xbf597fc7beef0ee4); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W12, W10, W5, W13, 0xc6e00bf33da88fc2); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W13, W11, W6, W14, 0xd5a79147930aa725); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W14, W12, W7, W15, 0x06ca6351e003826f); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W15, W13, W8, W0, 0x142929670a0e6e70); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W0, W14, W9, W1, 0x27b70a8546d22ffc); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W1, W15, W10, W2, 0x2e1b21385c26c926); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W2, W0, W11, W3, 0x4d2c6dfc5ac42aed); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W3, W1, W12, W4, 0x53380d139d95b3df); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W4, W2, W13, W5, 0x650a73548baf63de); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W5, W3, W14, W6, 0x766a0abb3c77b2a8); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W6, W4, W15, W7, 0x81c2c92e47edaee6); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W7, W5, W0, W8, 0x92722c851482353b); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W8, W6, W1, W9, 0xa2bfe8a14cf10364); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W9, W7, W2, W10, 0xa81a664bbc423001); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W10, W8, W3, W11, 0xc24b8b70d0f89791); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W11, W9, W4, W12, 0xc76c51a30654be30); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W12, W10, W5, W13, 0xd192e819d6ef5218); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W13, W11, W6, W14, 0xd69906245565a910); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W14, W12, W7, W15, 0xf40e35855771202a); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W15, W13, W8, W0, 0x106aa07032bbd1b8); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W0, W14, W9, W1, 0x19a4c116b8d2d0c8); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W1, W15, W10, W2, 0x1e376c085141ab53); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W2, W0, W11, W3, 0x2748774cdf8eeb99); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W3, W1, W12, W4, 0x34b0bcb5e19b48a8); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W4, W2, W13, W5, 0x391c0cb3c5c95a63); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W5, W3, W14, W6, 0x4ed8aa4ae3418acb); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W6, W4, W15, W7, 0x5b9cca4f7763e373); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W7, W5, W0, W8, 0x682e6ff3d6b2b8a3); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W8, W6, W1, W9, 0x748f82ee5defb2fc); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W9, W7, W2, W10, 0x78a5636f43172f60); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W10, W8, W3, W11, 0x84c87814a1f0ab72); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W11, W9, W4, W12, 0x8cc702081a6439ec); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W12, W10, W5, W13, 0x90befffa23631e28); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W13, W11, W6, W14, 0xa4506cebde82bde9); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W14, W12, W7, W15, 0xbef9a3f7b2c67915); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W15, W13, W8, W0, 0xc67178f2e372532b); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W0, W14, W9, W1, 0xca273eceea26619c); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W1, W15, W10, W2, 0xd186b8c721c0c207); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W2, W0, W11, W3, 0xeada7dd6cde0eb1e); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W3, W1, W12, W4, 0xf57d4f7fee6ed178); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W4, W2, W13, W5, 0x06f067aa72176fba); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W5, W3, W14, W6, 0x0a637dc5a2c898a6); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W6, W4, W15, W7, 0x113f9804bef90dae); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W7, W5, W0, W8, 0x1b710b35131c471b); \
+	roundx(A0, A1, A2, A3, A4, A5, A6, A7, W8, W6, W1, W9, 0x28db77f523047d84); \
+	roundx(A7, A0, A1, A2, A3, A4, A5, A6, W9, W7, W2, W10, 0x32caab7b40c72493); \
+	roundx(A6, A7, A0, A1, A2, A3, A4, A5, W10, W8, W3, W11, 0x3c9ebe0a15c9bebc); \
+	roundx(A5, A6, A7, A0, A1, A2, A3, A4, W11, W9, W4, W12, 0x431d67c49c100d4c); \
+	roundx(A4, A5, A6, A7, A0, A1, A2, A3, W12, W10, W5, W13, 0x4cc5d4becb3e42b6); \
+	roundx(A3, A4, A5, A6, A7, A0, A1, A2, W13, W11, W6, W14, 0x597f299cfc657e2a); \
+	roundx(A2, A3, A4, A5, A6, A7, A0, A1, W14, W12, W7, W15, 0x5fcb6fab3ad6faec); \
+	roundx(A1, A2, A3, A4, A5, A6, A7, A0, W15, W13, W8, W0, 0x6c44198c4a475817)
+
+// func block(dig *digest, p []byte)
+TEXT ·block(SB),NOSPLIT,$16-32
+	MOV	p_base+8(FP), S9
+	MOV	p_len+16(FP), S10
+	SRL	$7, S10
+	SLL	$7, S10
+	ADD	S9, S10	// end of the last whole block
+	BEQ	S9, S10, done
+	MOV	S9, 8(X2)
+	MOV	S10, 16(X2)
+
+	MOV	dig+0(FP), S9
+	MOV	(0*8)(S9), A0
+	MOV	(1*8)(S9), A1
+	MOV	(2*8)(S9), A2
+	MOV	(3*8)(S9), A3
+	MOV	(4*8)(S9), A4
+	MOV	(5*8)(S9), A5
+	MOV	(6*8)(S9), A6
+	MOV	(7*8)(S9), A7
+
+loop:
+	MOV	8(X2), S9
+	LOAD64BE((0*8), W0)
+	LOAD64BE((1*8), W1)
+	LOAD64BE((2*8), W2)
+	LOAD64BE((3*8), W3)
+	LOAD64BE((4*8), W4)
+	LOAD64BE((5*8), W5)
+	LOAD64BE((6*8), W6)
+	LOAD64BE((7*8), W7)
+	LOAD64BE((8*8), W8)
+	LOAD64BE((9*8), W9)
+	LOAD64BE((10*8), W10)
+	LOAD64BE((11*8), W11)
+	LOAD64BE((12*8), W12)
+	LOAD64BE((13*8), W13)
+	LOAD64BE((14*8), W14)
+	LOAD64BE((15*8), W15)
+	ADD	$128, S9
+	MOV	S9, 8(X2)
+
+	MOVBU	·useZknh(SB), S10
+	BNE	S10, ZERO, zknh
+	ROUNDS(ROUND, ROUNDx)
+	JMP	update
+
+zknh:
+	ROUNDS(ZROUND, ZROUNDx)
+
+update:
+	MOV	dig+0(FP), W0
+	MOV	(0*8)(W0), W1
+	ADD	W1, A0
+	MOV	A0, (0*8)(W0)
+	MOV	(1*8)(W0), W1
+	ADD	W1, A1
+	MOV	A1, (1*8)(W0)
+	MOV	(2*8)(W0), W1
+	ADD	W1, A2
+	MOV	A2, (2*8)(W0)
+	MOV	(3*8)(W0), W1
+	ADD	W1, A3
+	MOV	A3, (3*8)(W0)
+	MOV	(4*8)(W0), W1
+	ADD	W1, A4
+	MOV	A4, (4*8)(W0)
+	MOV	(5*8)(W0), W1
+	ADD	W1, A5
+	MOV	A5, (5*8)(W0)
+	MOV	(6*8)(W0), W1
+	ADD	W1, A6
+	MOV	A6, (6*8)(W0)
+	MOV	(7*8)(W0), W1
+	ADD	W1, A7
+	MOV	A7, (7*8)(W0)
+
+	MOV	8(X2), W0
+	MOV	16(X2), W1
+	BNE	W0, W1, loop
+
+done:
+	RET
+
+// func zknhCheck() bool
+TEXT ·zknhCheck(SB),NOSPLIT,$0-1
+	MOVBU	runtime·support_zknh(SB), T0
+	MOVB	T0, ret+0(FP)
+	RET
@@ -0,0 +1,38 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sha512
+
+import (
+	"crypto/rand"
+	"testing"
+)
+
+func testBlockZknh(t *testing.T, zknh bool) {
+	saved := useZknh
+	useZknh = zknh
+	defer func() { useZknh = saved }()
+	gen, asm := New().(*digest), New().(*digest)
+	buf := make([]byte, BlockSize*20) // arbitrary factor
+	rand.Read(buf)
+	blockGeneric(gen, buf)
+	block(asm, buf)
+	if *gen != *asm {
+		t.Errorf("block with useZknh=%v and blockGeneric resulted in different states", zknh)
+	}
+}
+
+// Tests the rounds that use the Zknh instructions. See also TestBlockGeneric.
+func TestBlockZknh(t *testing.T) {
+	if !zknhCheck() {
+		t.Skipf("Zknh instructions unavailable")
+	}
+	testBlockZknh(t, true)
+}
+
+// Tests the rounds that do not use the Zknh instructions, which
+// TestBlockGeneric does not reach on a CPU with Zknh.
+func TestBlockNoZknh(t *testing.T) {
+	testBlockZknh(t, false)
+}
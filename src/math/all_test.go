@@ -878,6 +878,41 @@ var ceilSC = []float64{
 	NaN(),
 }
 
+// Floor, Ceil and Trunc of these must not lose precision, and a
+// result of zero keeps the sign of the input.
+var vffracSC = []float64{
+	-0.5,
+	0.5,
+	1<<52 - 0.5,
+	-(1<<52 - 0.5),
+	1<<52 + 1,
+	MaxFloat64,
+}
+var floorfracSC = []float64{
+	-1,
+	0,
+	1<<52 - 1,
+	-(1 << 52),
+	1<<52 + 1,
+	MaxFloat64,
+}
+var ceilfracSC = []float64{
+	Copysign(0, -1),
+	1,
+	1 << 52,
+	-(1<<52 - 1),
+	1<<52 + 1,
+	MaxFloat64,
+}
+var truncfracSC = []float64{
+	Copysign(0, -1),
+	0,
+	1<<52 - 1,
+	-(1<<52 - 1),
+	1<<52 + 1,
+	MaxFloat64,
+}
+
 var vfcopysignSC = []float64{
 	Inf(-1),
 	Inf(1),
@@ -1077,6 +1112,30 @@ var fminSC = []float64{
 	NaN(),
 }
 
+var fmaC = []struct{ x, y, z, want float64 }{
+	// Results that differ from x*y+z rounded twice.
+	{0.026165071994904893, 1.76695011700359e+08, -4.623237702280455e+06, -2.9955542535226845e-12},
+	{0.011676442054194339, -4.1928801912501066e-05, 4.895792259331114e-07, -9.92037942702291e-23},
+	{0.06085051400826158, -0.2857361274176251, 0.017387190224092613, 3.88535048246299e-19},
+	{-130485.4479663868, -5.0512241861128964e-05, -6.5911125070358905, -2.0476454598352504e-15},
+	{-3.5382881564221046e-08, 3.794242617827767e+07, 1.3425123717251988, -2.30504270815788e-16},
+	{-4.837928687184059e-08, -0.5868346761726029, -2.8390643144898023e-08, 5.7559008851928974e-24},
+	{-4.91992222901003e-25, 2.6726973302342413e-199, -8.204690552031203e-240, -1.3149463006435206e-223},
+	{-1150.6436478667063, 7.282497107587991e+192, -1.2237493015190175e+191, -8.379681412383936e+195},
+	{-0.053294830366773155, -1.966251036548489e+115, -2.787752810465465e+110, 1.0476313792323894e+114},
+	{3.833797636289328e-75, -7.666859213540108e-122, -2.8924505873365894e-198, -2.968243178936678e-196},
+	{MaxFloat64, 2, -MaxFloat64, MaxFloat64},
+
+	// Special cases.
+	{-1e-200, 1e-200, 0, Copysign(0, -1)},
+	{-1, 1, 1, 0},
+	{1, 1, Copysign(0, -1), 1},
+	{Inf(1), 0, 1, NaN()},
+	{Inf(1), 1, Inf(-1), NaN()},
+	{2, 3, Inf(-1), Inf(-1)},
+	{NaN(), 1, 1, NaN()},
+}
+
 var vffmodSC = [][2]float64{
 	{Inf(-1), Inf(-1)},
 	{Inf(-1), -Pi},
@@ -1966,6 +2025,11 @@ func TestCeil(t *testing.T) {
 			t.Errorf("Ceil(%g) = %g, want %g", vfceilSC[i], f, ceilSC[i])
 		}
 	}
+	for i := 0; i < len(vffracSC); i++ {
+		if f := Ceil(vffracSC[i]); !alike(ceilfracSC[i], f) {
+			t.Errorf("Ceil(%g) = %g, want %g", vffracSC[i], f, ceilfracSC[i])
+		}
+	}
 }
 
 func TestCopysign(t *testing.T) {
@@ -2145,6 +2209,11 @@ func TestFloor(t *testing.T) {
 			t.Errorf("Floor(%g) = %g, want %g", vfceilSC[i], f, ceilSC[i])
 		}
 	}
+	for i := 0; i < len(vffracSC); i++ {
+		if f := Floor(vffracSC[i]); !alike(floorfracSC[i], f) {
+			t.Errorf("Floor(%g) = %g, want %g", vffracSC[i], f, floorfracSC[i])
+		}
+	}
 }
 
 func TestMax(t *testing.T) {
@@ -2196,6 +2265,14 @@ func TestMod(t *testing.T) {
 	}
 }
 
+func TestFMA(t *testing.T) {
+	for _, c := range fmaC {
+		if got := FMA(c.x, c.y, c.z); !alike(got, c.want) {
+			t.Errorf("FMA(%g, %g, %g) = %g, want %g", c.x, c.y, c.z, got, c.want)
+		}
+	}
+}
+
 func TestFrexp(t *testing.T) {
 	for i := 0; i < len(vf); i++ {
 		if f, j := Frexp(vf[i]); !veryclose(frexp[i].f, f) || frexp[i].i != j {
@@ -2642,6 +2719,11 @@ func TestTrunc(t *testing.T) {
 			t.Errorf("Trunc(%g) = %g, want %g", vfceilSC[i], f, ceilSC[i])
 		}
 	}
+	for i := 0; i < len(vffracSC); i++ {
+		if f := Trunc(vffracSC[i]); !alike(truncfracSC[i], f) {
+			t.Errorf("Trunc(%g) = %g, want %g", vffracSC[i], f, truncfracSC[i])
+		}
+	}
 }
 
 func TestY0(t *testing.T) {
@@ -2918,6 +3000,12 @@ func BenchmarkMod(b *testing.B) {
 	}
 }
 
+func BenchmarkFMA(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		FMA(E, Pi, Phi)
+	}
+}
+
 func BenchmarkFrexp(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 		Frexp(8)
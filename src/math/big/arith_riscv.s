@@ -0,0 +1,399 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !math_big_pure_go
+
+#include "textflag.h"
+
+// This file provides fast assembly versions for the elementary
+// arithmetic operations on vectors implemented in arith.go.
+
+// RISC-V has no carry flag. Carries and borrows are recovered with
+// SLTU: the sum a+b wrapped around if and only if it is less than a,
+// and a-b borrowed if and only if a is less than b.
+
+// BITLEN sets n to the number of bits needed to represent x, clobbering
+// x and t. Without the B extension, it is a branch-free binary search.
+#ifdef GORISCV_b
+#define BITLEN(x, n, t) \
+	CLZ	x, t; \
+	MOV	$64, n; \
+	SUB	t, n
+#else
+#define BITLEN(x, n, t) \
+	MOV	ZERO, n; \
+	SRL	$32, x, t; SNEZ t, t; SLL $5, t; SRL t, x; ADD t, n; \
+	SRL	$16, x, t; SNEZ t, t; SLL $4, t; SRL t, x; ADD t, n; \
+	SRL	$8, x, t; SNEZ t, t; SLL $3, t; SRL t, x; ADD t, n; \
+	SRL	$4, x, t; SNEZ t, t; SLL $2, t; SRL t, x; ADD t, n; \
+	SRL	$2, x, t; SNEZ t, t; SLL $1, t; SRL t, x; ADD t, n; \
+	SRL	$1, x, t; SNEZ t, t; SRL t, x; ADD t, n; \
+	ADD	x, n
+#endif
+
+// func mulWW(x, y Word) (z1, z0 Word)
+TEXT ·mulWW(SB),NOSPLIT,$0
+	MOV	x+0(FP), A0
+	MOV	y+8(FP), A1
+	MULHU	A1, A0, A2
+	MUL	A1, A0, A3
+	MOV	A2, z1+16(FP)
+	MOV	A3, z0+24(FP)
+	RET
+
+
+// func divWW(x1, x0, y Word) (q, r Word)
+TEXT ·divWW(SB),NOSPLIT,$0
+	JMP	·divWW_g(SB) // RISC-V has no multiword division
+
+
+// func addVV(z, x, y []Word) (c Word)
+TEXT ·addVV(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	y+48(FP), A3
+	MOV	ZERO, A4	// c
+	SLL	$3, A1
+	ADD	A0, A1	// end of z
+loop:
+	BEQ	A0, A1, done
+	MOV	(A2), T0
+	MOV	(A3), T1
+	ADD	T1, T0, T2
+	SLTU	T0, T2, T3	// carry out of x+y
+	ADD	A4, T2
+	SLTU	A4, T2, A4	// carry out of adding c
+	OR	T3, A4
+	MOV	T2, (A0)
+	ADD	$8, A0
+	ADD	$8, A2
+	ADD	$8, A3
+	JMP	loop
+done:
+	MOV	A4, c+72(FP)
+	RET
+
+
+// func subVV(z, x, y []Word) (c Word)
+TEXT ·subVV(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	y+48(FP), A3
+	MOV	ZERO, A4	// c
+	SLL	$3, A1
+	ADD	A0, A1	// end of z
+loop:
+	BEQ	A0, A1, done
+	MOV	(A2), T0
+	MOV	(A3), T1
+	SUB	T1, T0, T2
+	SLTU	T1, T0, T3	// borrow out of x-y
+	SLTU	A4, T2, T4	// borrow out of subtracting c
+	SUB	A4, T2
+	OR	T3, T4, A4
+	MOV	T2, (A0)
+	ADD	$8, A0
+	ADD	$8, A2
+	ADD	$8, A3
+	JMP	loop
+done:
+	MOV	A4, c+72(FP)
+	RET
+
+
+// func addVW(z, x []Word, y Word) (c Word)
+TEXT ·addVW(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	y+48(FP), A4	// c
+	SLL	$3, A1
+	ADD	A0, A1	// end of z
+loop:
+	BEQ	A0, A1, done
+	MOV	(A2), T0
+	ADD	A4, T0
+	SLTU	A4, T0, A4
+	MOV	T0, (A0)
+	ADD	$8, A0
+	ADD	$8, A2
+	JMP	loop
+done:
+	MOV	A4, c+56(FP)
+	RET
+
+
+// func subVW(z, x []Word, y Word) (c Word)
+TEXT ·subVW(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	y+48(FP), A4	// c
+	SLL	$3, A1
+	ADD	A0, A1	// end of z
+loop:
+	BEQ	A0, A1, done
+	MOV	(A2), T0
+	SUB	A4, T0, T1
+	SLTU	A4, T0, A4
+	MOV	T1, (A0)
+	ADD	$8, A0
+	ADD	$8, A2
+	JMP	loop
+done:
+	MOV	A4, c+56(FP)
+	RET
+
+
+// Shifts use only the low six bits of the shift amount, so the
+// complementary shift 64-s is done as -s, and s == 0 is a plain copy.
+
+// func shlVU(z, x []Word, s uint) (c Word)
+TEXT ·shlVU(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	s+48(FP), A3
+	BEQ	A1, ZERO, len0
+	SLL	$3, A1
+	ADD	A1, A2	// end of x
+	ADD	A0, A1	// end of z
+	BEQ	A3, ZERO, copy
+	SUB	A3, ZERO, A4	// 64-s
+	MOV	-8(A2), T0	// x[n-1]
+	SRL	A4, T0, T1
+	MOV	T1, c+56(FP)
+	ADD	$-8, A1
+	ADD	$-8, A2
+loop:
+	// Go from the top down, so that z may overlap the top of x.
+	BEQ	A1, A0, last
+	MOV	-8(A2), T1
+	SLL	A3, T0
+	SRL	A4, T1, T2
+	OR	T2, T0
+	MOV	T0, (A1)
+	MOV	T1, T0
+	ADD	$-8, A1
+	ADD	$-8, A2
+	JMP	loop
+last:
+	SLL	A3, T0
+	MOV	T0, (A0)
+	RET
+copy:
+	BEQ	A1, A0, len0
+	MOV	-8(A2), T0
+	MOV	T0, -8(A1)
+	ADD	$-8, A1
+	ADD	$-8, A2
+	JMP	copy
+len0:
+	MOV	ZERO, c+56(FP)
+	RET
+
+
+// func shrVU(z, x []Word, s uint) (c Word)
+TEXT ·shrVU(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	s+48(FP), A3
+	BEQ	A1, ZERO, len0
+	SLL	$3, A1
+	ADD	A0, A1	// end of z
+	BEQ	A3, ZERO, copy
+	SUB	A3, ZERO, A4	// 64-s
+	MOV	(A2), T0	// x[0]
+	SLL	A4, T0, T1
+	MOV	T1, c+56(FP)
+	ADD	$-8, A1
+loop:
+	// Go from the bottom up, so that z may overlap the bottom of x.
+	BEQ	A0, A1, last
+	MOV	8(A2), T1
+	SRL	A3, T0
+	SLL	A4, T1, T2
+	OR	T2, T0
+	MOV	T0, (A0)
+	MOV	T1, T0
+	ADD	$8, A0
+	ADD	$8, A2
+	JMP	loop
+last:
+	SRL	A3, T0
+	MOV	T0, (A1)
+	RET
+copy:
+	BEQ	A0, A1, len0
+	MOV	(A2), T0
+	MOV	T0, (A0)
+	ADD	$8, A0
+	ADD	$8, A2
+	JMP	copy
+len0:
+	MOV	ZERO, c+56(FP)
+	RET
+
+
+// func mulAddVWW(z, x []Word, y, r Word) (c Word)
+TEXT ·mulAddVWW(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	y+48(FP), A3
+	MOV	r+56(FP), A4	// c
+	SLL	$3, A1
+	ADD	A0, A1	// end of z
+loop:
+	BEQ	A0, A1, done
+	MOV	(A2), T0
+	MULHU	A3, T0, T1
+	MUL	A3, T0
+	ADD	A4, T0
+	SLTU	A4, T0, T2
+	ADD	T2, T1, A4
+	MOV	T0, (A0)
+	ADD	$8, A0
+	ADD	$8, A2
+	JMP	loop
+done:
+	MOV	A4, c+64(FP)
+	RET
+
+
+// func addMulVVW(z, x []Word, y Word) (c Word)
+TEXT ·addMulVVW(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	x+24(FP), A2
+	MOV	y+48(FP), A3
+	MOV	ZERO, A4	// c
+	SLL	$3, A1
+	ADD	A0, A1	// end of z
+loop:
+	// x*y + z + c cannot overflow two words, so the carries
+	// into the high word need no carries of their own.
+	BEQ	A0, A1, done
+	MOV	(A2), T0
+	MOV	(A0), T1
+	MULHU	A3, T0, T2
+	MUL	A3, T0
+	ADD	T1, T0
+	SLTU	T1, T0, T3
+	ADD	T3, T2
+	ADD	A4, T0
+	SLTU	A4, T0, T3
+	ADD	T3, T2, A4
+	MOV	T0, (A0)
+	ADD	$8, A0
+	ADD	$8, A2
+	JMP	loop
+done:
+	MOV	A4, c+56(FP)
+	RET
+
+
+// func divWVW(z []Word, xn Word, x []Word, y Word) (r Word)
+//
+// Each step is divWW_g (Warren, Hacker's Delight, p. 152), dividing by
+// 32-bit halves with DIVU and REMU. The divisor is normalized only once.
+TEXT ·divWVW(SB),NOSPLIT,$0
+	MOV	z+0(FP), A0
+	MOV	z_len+8(FP), A1
+	MOV	xn+24(FP), A7	// r
+	MOV	x+32(FP), A2
+	MOV	y+56(FP), A4
+	BGEU	A7, A4, slow
+
+	MOV	A4, T0
+	BITLEN(T0, T1, T2)
+	MOV	$64, A3
+	SUB	T1, A3	// s
+	MOV	$63, S2
+	SUB	A3, S2	// 63-s, so that u0>>1>>(63-s) is u0>>(64-s), even for s == 0
+	SLL	A3, A4	// v
+	SRL	$32, A4, A5	// vn1
+	SLL	$32, A4, A6
+	SRL	$32, A6	// vn0
+
+	SLL	$3, A1
+	ADD	A1, A2	// end of x
+	ADD	A0, A1	// end of z
+loop:
+	BEQ	A1, A0, done
+	ADD	$-8, A1
+	ADD	$-8, A2
+	MOV	(A2), T0	// u0
+
+	SLL	A3, A7, T1
+	SRL	$1, T0, T2
+	SRL	S2, T2
+	OR	T2, T1	// un32
+	SLL	A3, T0, T2	// un10
+	SRL	$32, T2, T3	// un1
+	SLL	$32, T2
+	SRL	$32, T2	// un0
+
+	DIVU	A5, T1, T4	// q1
+	REMU	A5, T1, T5	// rhat
+loop1:
+	SRL	$32, T4, T0
+	BNE	T0, ZERO, adjust1
+	MUL	A6, T4, T0
+	SLL	$32, T5, S3
+	ADD	T3, S3
+	BGEU	S3, T0, done1
+adjust1:
+	ADD	$-1, T4
+	ADD	A5, T5
+	SRL	$32, T5, T0
+	BEQ	T0, ZERO, loop1
+done1:
+
+	SLL	$32, T1
+	ADD	T3, T1
+	MUL	A4, T4, T0
+	SUB	T0, T1	// un21
+
+	DIVU	A5, T1, T3	// q0
+	REMU	A5, T1, T5	// rhat
+loop2:
+	SRL	$32, T3, T0
+	BNE	T0, ZERO, adjust2
+	MUL	A6, T3, T0
+	SLL	$32, T5, S3
+	ADD	T2, S3
+	BGEU	S3, T0, done2
+adjust2:
+	ADD	$-1, T3
+	ADD	A5, T5
+	SRL	$32, T5, T0
+	BEQ	T0, ZERO, loop2
+done2:
+
+	SLL	$32, T4
+	ADD	T3, T4
+	MOV	T4, (A1)	// q
+	SLL	$32, T1
+	ADD	T2, T1
+	MUL	A4, T3, T0
+	SUB	T0, T1
+	SRL	A3, T1, A7	// r
+	JMP	loop
+done:
+	MOV	A7, r+64(FP)
+	RET
+slow:
+	JMP	·divWVW_g(SB)
+
+
+// func bitLen(x Word) (n int)
+TEXT ·bitLen(SB),NOSPLIT,$0
+	MOV	x+0(FP), A0
+	BITLEN(A0, A1, T0)
+	MOV	A1, n+8(FP)
+	RET
@@ -0,0 +1,267 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package bits
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestUintSize(t *testing.T) {
+	var x uint
+	if want := unsafe.Sizeof(x) * 8; UintSize != want {
+		t.Fatalf("UintSize = %d; want %d", UintSize, want)
+	}
+}
+
+// Reference implementations, bit by bit.
+
+func refLen(x uint64) (n int) {
+	for ; x != 0; x >>= 1 {
+		n++
+	}
+	return n
+}
+
+func refTrailingZeros(x uint64, size int) (n int) {
+	for n < size && x&1 == 0 {
+		x >>= 1
+		n++
+	}
+	return n
+}
+
+func refOnesCount(x uint64) (n int) {
+	for ; x != 0; x >>= 1 {
+		n += int(x & 1)
+	}
+	return n
+}
+
+func refReverse(x uint64, size int) (r uint64) {
+	for i := 0; i < size; i++ {
+		r = r<<1 | x&1
+		x >>= 1
+	}
+	return r
+}
+
+func refReverseBytes(x uint64, size int) (r uint64) {
+	for i := 0; i < size/8; i++ {
+		r = r<<8 | x&0xff
+		x >>= 8
+	}
+	return r
+}
+
+func refRotateLeft(x uint64, k int, size int) uint64 {
+	mask := uint64(1)<<uint(size) - 1
+	if size == 64 {
+		mask = ^uint64(0)
+	}
+	k %= size
+	if k < 0 {
+		k += size
+	}
+	for ; k > 0; k-- {
+		x = (x<<1 | x>>uint(size-1)) & mask
+	}
+	return x
+}
+
+// testValues returns a set of interesting inputs: all single bits, all
+// runs of low and high ones, and a few pseudo-random values.
+func testValues() []uint64 {
+	vals := []uint64{0, ^uint64(0)}
+	for i := uint(0); i < 64; i++ {
+		vals = append(vals, 1<<i, 1<<i-1, ^(1<<i - 1))
+	}
+	x := uint64(0x9e3779b97f4a7c15)
+	for i := 0; i < 64; i++ {
+		x ^= x << 13
+		x ^= x >> 7
+		x ^= x << 17
+		vals = append(vals, x)
+	}
+	return vals
+}
+
+func TestLeadingZeros(t *testing.T) {
+	for _, x := range testValues() {
+		if got, want := LeadingZeros8(uint8(x)), 8-refLen(uint64(uint8(x))); got != want {
+			t.Fatalf("LeadingZeros8(%#02x) = %d; want %d", uint8(x), got, want)
+		}
+		if got, want := LeadingZeros16(uint16(x)), 16-refLen(uint64(uint16(x))); got != want {
+			t.Fatalf("LeadingZeros16(%#04x) = %d; want %d", uint16(x), got, want)
+		}
+		if got, want := LeadingZeros32(uint32(x)), 32-refLen(uint64(uint32(x))); got != want {
+			t.Fatalf("LeadingZeros32(%#08x) = %d; want %d", uint32(x), got, want)
+		}
+		if got, want := LeadingZeros64(x), 64-refLen(x); got != want {
+			t.Fatalf("LeadingZeros64(%#016x) = %d; want %d", x, got, want)
+		}
+		if got, want := LeadingZeros(uint(x)), UintSize-refLen(uint64(uint(x))); got != want {
+			t.Fatalf("LeadingZeros(%#x) = %d; want %d", uint(x), got, want)
+		}
+	}
+}
+
+func TestTrailingZeros(t *testing.T) {
+	for _, x := range testValues() {
+		if got, want := TrailingZeros8(uint8(x)), refTrailingZeros(x, 8); got != want {
+			t.Fatalf("TrailingZeros8(%#02x) = %d; want %d", uint8(x), got, want)
+		}
+		if got, want := TrailingZeros16(uint16(x)), refTrailingZeros(x, 16); got != want {
+			t.Fatalf("TrailingZeros16(%#04x) = %d; want %d", uint16(x), got, want)
+		}
+		if got, want := TrailingZeros32(uint32(x)), refTrailingZeros(x, 32); got != want {
+			t.Fatalf("TrailingZeros32(%#08x) = %d; want %d", uint32(x), got, want)
+		}
+		if got, want := TrailingZeros64(x), refTrailingZeros(x, 64); got != want {
+			t.Fatalf("TrailingZeros64(%#016x) = %d; want %d", x, got, want)
+		}
+		if got, want := TrailingZeros(uint(x)), refTrailingZeros(x, UintSize); got != want {
+			t.Fatalf("TrailingZeros(%#x) = %d; want %d", uint(x), got, want)
+		}
+	}
+}
+
+func TestOnesCount(t *testing.T) {
+	for _, x := range testValues() {
+		if got, want := OnesCount8(uint8(x)), refOnesCount(uint64(uint8(x))); got != want {
+			t.Fatalf("OnesCount8(%#02x) = %d; want %d", uint8(x), got, want)
+		}
+		if got, want := OnesCount16(uint16(x)), refOnesCount(uint64(uint16(x))); got != want {
+			t.Fatalf("OnesCount16(%#04x) = %d; want %d", uint16(x), got, want)
+		}
+		if got, want := OnesCount32(uint32(x)), refOnesCount(uint64(uint32(x))); got != want {
+			t.Fatalf("OnesCount32(%#08x) = %d; want %d", uint32(x), got, want)
+		}
+		if got, want := OnesCount64(x), refOnesCount(x); got != want {
+			t.Fatalf("OnesCount64(%#016x) = %d; want %d", x, got, want)
+		}
+		if got, want := OnesCount(uint(x)), refOnesCount(uint64(uint(x))); got != want {
+			t.Fatalf("OnesCount(%#x) = %d; want %d", uint(x), got, want)
+		}
+	}
+}
+
+func TestRotateLeft(t *testing.T) {
+	for _, x := range testValues() {
+		for k := -130; k <= 130; k += 7 {
+			if got, want := RotateLeft8(uint8(x), k), refRotateLeft(uint64(uint8(x)), k, 8); uint64(got) != want {
+				t.Fatalf("RotateLeft8(%#02x, %d) = %#02x; want %#02x", uint8(x), k, got, want)
+			}
+			if got, want := RotateLeft16(uint16(x), k), refRotateLeft(uint64(uint16(x)), k, 16); uint64(got) != want {
+				t.Fatalf("RotateLeft16(%#04x, %d) = %#04x; want %#04x", uint16(x), k, got, want)
+			}
+			if got, want := RotateLeft32(uint32(x), k), refRotateLeft(uint64(uint32(x)), k, 32); uint64(got) != want {
+				t.Fatalf("RotateLeft32(%#08x, %d) = %#08x; want %#08x", uint32(x), k, got, want)
+			}
+			if got, want := RotateLeft64(x, k), refRotateLeft(x, k, 64); got != want {
+				t.Fatalf("RotateLeft64(%#016x, %d) = %#016x; want %#016x", x, k, got, want)
+			}
+			if got, want := RotateLeft(uint(x), k), refRotateLeft(uint64(uint(x)), k, UintSize); uint64(got) != want {
+				t.Fatalf("RotateLeft(%#x, %d) = %#x; want %#x", uint(x), k, got, want)
+			}
+		}
+	}
+}
+
+func TestReverse(t *testing.T) {
+	for _, x := range testValues() {
+		if got, want := Reverse8(uint8(x)), refReverse(x, 8); uint64(got) != want {
+			t.Fatalf("Reverse8(%#02x) = %#02x; want %#02x", uint8(x), got, want)
+		}
+		if got, want := Reverse16(uint16(x)), refReverse(x, 16); uint64(got) != want {
+			t.Fatalf("Reverse16(%#04x) = %#04x; want %#04x", uint16(x), got, want)
+		}
+		if got, want := Reverse32(uint32(x)), refReverse(x, 32); uint64(got) != want {
+			t.Fatalf("Reverse32(%#08x) = %#08x; want %#08x", uint32(x), got, want)
+		}
+		if got, want := Reverse64(x), refReverse(x, 64); got != want {
+			t.Fatalf("Reverse64(%#016x) = %#016x; want %#016x", x, got, want)
+		}
+		if got, want := Reverse(uint(x)), refReverse(x, UintSize); uint64(got) != want {
+			t.Fatalf("Reverse(%#x) = %#x; want %#x", uint(x), got, want)
+		}
+	}
+}
+
+func TestReverseBytes(t *testing.T) {
+	for _, x := range testValues() {
+		if got, want := ReverseBytes16(uint16(x)), refReverseBytes(x, 16); uint64(got) != want {
+			t.Fatalf("ReverseBytes16(%#04x) = %#04x; want %#04x", uint16(x), got, want)
+		}
+		if got, want := ReverseBytes32(uint32(x)), refReverseBytes(x, 32); uint64(got) != want {
+			t.Fatalf("ReverseBytes32(%#08x) = %#08x; want %#08x", uint32(x), got, want)
+		}
+		if got, want := ReverseBytes64(x), refReverseBytes(x, 64); got != want {
+			t.Fatalf("ReverseBytes64(%#016x) = %#016x; want %#016x", x, got, want)
+		}
+		if got, want := ReverseBytes(uint(x)), refReverseBytes(x, UintSize); uint64(got) != want {
+			t.Fatalf("ReverseBytes(%#x) = %#x; want %#x", uint(x), got, want)
+		}
+	}
+}
+
+func TestLen(t *testing.T) {
+	for _, x := range testValues() {
+		if got, want := Len8(uint8(x)), refLen(uint64(uint8(x))); got != want {
+			t.Fatalf("Len8(%#02x) = %d; want %d", uint8(x), got, want)
+		}
+		if got, want := Len16(uint16(x)), refLen(uint64(uint16(x))); got != want {
+			t.Fatalf("Len16(%#04x) = %d; want %d", uint16(x), got, want)
+		}
+		if got, want := Len32(uint32(x)), refLen(uint64(uint32(x))); got != want {
+			t.Fatalf("Len32(%#08x) = %d; want %d", uint32(x), got, want)
+		}
+		if got, want := Len64(x), refLen(x); got != want {
+			t.Fatalf("Len64(%#016x) = %d; want %d", x, got, want)
+		}
+		if got, want := Len(uint(x)), refLen(uint64(uint(x))); got != want {
+			t.Fatalf("Len(%#x) = %d; want %d", uint(x), got, want)
+		}
+	}
+}
+
+// Exported (global) variables to keep the compiler from optimizing
+// the benchmark loops away.
+var (
+	Input  uint64 = 0x0123456789abcdef
+	Output int
+)
+
+func BenchmarkLeadingZeros64(b *testing.B) {
+	var s int
+	for i := 0; i < b.N; i++ {
+		s += LeadingZeros64(Input >> (uint(i) % 64))
+	}
+	Output = s
+}
+
+func BenchmarkTrailingZeros64(b *testing.B) {
+	var s int
+	for i := 0; i < b.N; i++ {
+		s += TrailingZeros64(Input << (uint(i) % 64))
+	}
+	Output = s
+}
+
+func BenchmarkOnesCount64(b *testing.B) {
+	var s int
+	for i := 0; i < b.N; i++ {
+		s += OnesCount64(Input >> (uint(i) % 64))
+	}
+	Output = s
+}
+
+func BenchmarkReverseBytes64(b *testing.B) {
+	var s uint64
+	for i := 0; i < b.N; i++ {
+		s += ReverseBytes64(Input + uint64(i))
+	}
+	Output = int(s)
+}
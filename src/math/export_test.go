@@ -9,3 +9,7 @@ var ExpGo = exp
 var Exp2Go = exp2
 var HypotGo = hypot
 var SqrtGo = sqrt
+
+// FMA calls fma directly, so that the call is replaced with an
+// instruction where the compiler can.
+func FMA(x, y, z float64) float64 { return fma(x, y, z) }
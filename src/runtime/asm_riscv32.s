@@ -0,0 +1,239 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build riscv32
+
+#include "go_asm.h"
+#include "funcdata.h"
+#include "textflag.h"
+
+// The code shared with riscv is in asm_riscvx.s.
+
+// func cputicks() int64
+TEXT runtime·cputicks(SB),NOSPLIT,$0-8
+	// Read the high half twice to catch the low half wrapping.
+again:
+	WORD	$0xc81025f3	// rdtimeh a1
+	WORD	$0xc0102573	// rdtime a0
+	WORD	$0xc8102673	// rdtimeh a2
+	BNE	A1, A2, again
+	MOV	A0, ret_lo+0(FP)
+	MOV	A1, ret_hi+4(FP)
+	RET
+
+// func getcallerpc(argp unsafe.Pointer) uintptr
+TEXT runtime·getcallerpc(SB),NOSPLIT,$4-8
+	MOV	8(X2), T0		// LR saved by caller
+	MOV	runtime·stackBarrierPC(SB), T1
+	BNE	T0, T1, nobar
+	// Get original return PC.
+	CALL	runtime·nextBarrierPC(SB)
+	MOV	4(X2), T0
+nobar:
+	MOV	T0, ret+4(FP)
+	RET
+
+// func fastrand() uint32
+TEXT runtime·fastrand(SB),NOSPLIT,$0-4
+	MOV	g_m(g), A2
+	MOVW	m_fastrand(A2), A1
+	ADD	A1, A1
+	BGE	A1, ZERO, noxor
+	MOV	$0x88888eef, A0
+	XOR	A0, A1
+noxor:
+	MOVW	A1, m_fastrand(A2)
+	MOVW	A1, ret+0(FP)
+	RET
+
+// eqstring tests whether two strings are equal.
+// The compiler guarantees that strings passed
+// to eqstring have equal length.
+// See runtime_test.go:eqstring_generic for
+// equivalent Go code.
+
+// func eqstring(s1, s2 string) bool
+TEXT runtime·eqstring(SB),NOSPLIT,$0-17
+	MOV	s1_base+0(FP), A1
+	MOV	s2_base+8(FP), A2
+	MOV	$1, T0
+	MOVB	T0, ret+16(FP)
+	BNE	A1, A2, diff_base
+	RET
+diff_base:
+	MOV	s1_len+4(FP), A3
+	MOV	$ret+16(FP), A5
+	JMP	runtime·memeqbody(SB)
+
+// func memequal(a, b unsafe.Pointer, size uintptr) bool
+TEXT runtime·memequal(SB),NOSPLIT,$-4-13
+	MOV	a+0(FP), A1
+	MOV	b+4(FP), A2
+	BEQ	A1, A2, eq
+	MOV	size+8(FP), A3
+	MOV	$ret+12(FP), A5
+	JMP	runtime·memeqbody(SB)
+eq:
+	MOV	$1, A1
+	MOVB	A1, ret+12(FP)
+	RET
+
+// func memequal_varlen(a, b unsafe.Pointer) bool
+TEXT runtime·memequal_varlen(SB),NOSPLIT,$16-9
+	MOV	a+0(FP), A1
+	MOV	b+4(FP), A2
+	BEQ	A1, A2, eq
+	MOV	4(CTXT), A3    // compiler stores size at offset 4 in the closure
+	MOV	A1, 4(X2)
+	MOV	A2, 8(X2)
+	MOV	A3, 12(X2)
+	CALL	runtime·memequal(SB)
+	MOVBU	16(X2), A1
+	MOVB	A1, ret+8(FP)
+	RET
+eq:
+	MOV	$1, A1
+	MOVB	A1, ret+8(FP)
+	RET
+
+// Save state of caller into g->sched. Smashes T0.
+TEXT gosave<>(SB),NOSPLIT,$-4
+	MOV	RA, (g_sched+gobuf_pc)(g)
+	MOV	X2, (g_sched+gobuf_sp)(g)
+	MOV	ZERO, (g_sched+gobuf_lr)(g)
+	MOV	ZERO, (g_sched+gobuf_ret)(g)
+	// Assert ctxt is zero. See func save.
+	MOV	(g_sched+gobuf_ctxt)(g), T0
+	BEQ	T0, ZERO, 2(PC)
+	CALL	runtime·badctxt(SB)
+	RET
+
+// func asmcgocall(fn, arg unsafe.Pointer) int32
+// Call fn(arg) on the scheduler stack,
+// aligned appropriately for the gcc ABI.
+// See cgocall.go for more details.
+TEXT ·asmcgocall(SB),NOSPLIT,$0-12
+	MOV	fn+0(FP), T2
+	MOV	arg+4(FP), A0
+
+	MOV	X2, T3	// save original stack pointer
+	MOV	g, T4
+
+	// Figure out if we need to switch to m->g0 stack.
+	// We get called to create new OS threads too, and those
+	// come in on the m->g0 stack already.
+	MOV	g_m(g), T5
+	MOV	m_g0(T5), T1
+	BEQ	T1, g, g0
+
+	CALL	gosave<>(SB)
+	MOV	T1, g
+	CALL	runtime·save_g(SB)
+	MOV	(g_sched+gobuf_sp)(g), X2
+
+	// Now on a scheduling stack (a pthread-created stack).
+g0:
+	// Save room for two of our pointers, keeping the stack
+	// 16-byte aligned as the C ABI requires.
+	ADD	$-16, X2
+	AND	$~15, X2
+	MOV	T4, 0(X2)	// save old g on stack
+	MOV	(g_stack+stack_hi)(T4), T4
+	SUB	T3, T4
+	MOV	T4, 4(X2)	// save depth in old g stack (can't just save SP, as stack might be copied during a callback)
+	JALR	RA, T2
+
+	// Restore g, stack pointer. A0 is return value.
+	MOV	0(X2), g
+	CALL	runtime·save_g(SB)
+	MOV	(g_stack+stack_hi)(g), T5
+	MOV	4(X2), T1
+	SUB	T1, T5
+	MOV	T5, X2
+
+	MOVW	A0, ret+8(FP)
+	RET
+
+// func memhash_varlen(p unsafe.Pointer, h uintptr) uintptr
+TEXT runtime·memhash_varlen(SB),NOSPLIT,$16-12
+	GO_ARGS
+	NO_LOCAL_POINTERS
+	MOV	p+0(FP), A1
+	MOV	h+4(FP), A2
+	MOV	4(CTXT), A3
+	MOV	A1, 4(X2)
+	MOV	A2, 8(X2)
+	MOV	A3, 12(X2)
+	CALL	runtime·memhash(SB)
+	MOV	16(X2), A1
+	MOV	A1, ret+8(FP)
+	RET
+
+// func IndexByte(s []byte, c byte) int
+TEXT bytes·IndexByte(SB),NOSPLIT,$0-20
+	MOV	s+0(FP), A1
+	MOV	s_len+4(FP), A2
+	MOVBU	c+12(FP), A3	// byte to find
+	MOV	$ret+16(FP), A5
+	JMP	runtime·indexbytebody(SB)
+
+// func IndexByte(s string, c byte) int
+TEXT strings·IndexByte(SB),NOSPLIT,$0-16
+	MOV	p+0(FP), A1
+	MOV	b_len+4(FP), A2
+	MOVBU	c+8(FP), A3	// byte to find
+	MOV	$ret+12(FP), A5
+	JMP	runtime·indexbytebody(SB)
+
+// func countByte(s []byte, c byte) int
+TEXT bytes·countByte(SB),NOSPLIT,$0-20
+	MOV	s+0(FP), A1
+	MOV	s_len+4(FP), A2
+	MOVBU	c+12(FP), A3	// byte to count
+	MOV	$ret+16(FP), A5
+	JMP	runtime·countbytebody(SB)
+
+// func countByte(s string, c byte) int
+TEXT strings·countByte(SB),NOSPLIT,$0-16
+	MOV	s+0(FP), A1
+	MOV	s_len+4(FP), A2
+	MOVBU	c+8(FP), A3	// byte to count
+	MOV	$ret+12(FP), A5
+	JMP	runtime·countbytebody(SB)
+
+// func Equal(a, b []byte) bool
+TEXT bytes·Equal(SB),NOSPLIT,$0-25
+	MOV	a_len+4(FP), A3
+	MOV	b_len+16(FP), A4
+	BNE	A3, A4, noteq		// unequal lengths are not equal
+	MOV	a+0(FP), A1
+	MOV	b+12(FP), A2
+	MOV	$ret+24(FP), A5
+	JMP	runtime·memeqbody(SB)
+noteq:
+	MOVB	ZERO, ret+24(FP)
+	RET
+
+// func cmpstring(s1, s2 string) int
+TEXT runtime·cmpstring(SB),NOSPLIT,$0-20
+	MOV	s1_base+0(FP), A1
+	MOV	s1_len+4(FP), A2
+	MOV	s2_base+8(FP), A3
+	MOV	s2_len+12(FP), A4
+	MOV	$ret+16(FP), A5
+	JMP	runtime·cmpbody(SB)
+
+// func Compare(a, b []byte) int
+TEXT bytes·Compare(SB),NOSPLIT,$0-28
+	MOV	a_base+0(FP), A1
+	MOV	a_len+4(FP), A2
+	MOV	b_base+12(FP), A3
+	MOV	b_len+16(FP), A4
+	MOV	$ret+24(FP), A5
+	JMP	runtime·cmpbody(SB)
+
+TEXT ·checkASM(SB),NOSPLIT,$0-1
+	MOV	$1, T0
+	MOVB	T0, ret+0(FP)
+	RET
@@ -0,0 +1,20 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// PTRSIZE is the size of a pointer and of an integer register, for the
+// assembly shared between riscv and riscv32. Frame and argument sizes
+// in TEXT must be a single number, so the multiples of it they need
+// are spelled out too.
+
+#ifdef GOARCH_riscv32
+#define PTRSIZE		4
+#define PTRSIZE_2	8
+#define PTRSIZE_3	12
+#define PTRSIZE_4	16
+#else
+#define PTRSIZE		8
+#define PTRSIZE_2	16
+#define PTRSIZE_3	24
+#define PTRSIZE_4	32
+#endif
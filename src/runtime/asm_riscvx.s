@@ -0,0 +1,936 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build riscv riscv32
+
+#include "go_asm.h"
+#include "funcdata.h"
+#include "textflag.h"
+#include "asm_riscvx.h"
+
+// Functions that return results on the stack are in asm_riscv.s and
+// asm_riscv32.s, where vet can check their argument offsets.
+
+// func rt0_go()
+TEXT runtime·rt0_go(SB),NOSPLIT,$0
+	// X2 = stack; A0 = argc; A1 = argv
+
+	ADD	$-(3*PTRSIZE), X2
+	MOV	A0, PTRSIZE(X2) // argc
+	MOV	A1, (2*PTRSIZE)(X2) // argv
+
+	// create istack out of the given (operating system) stack.
+	// _cgo_init may update stackguard.
+	MOV	$runtime·g0(SB), g
+	MOV	$(-64*1024), T0
+	ADD	T0, X2, T1
+	MOV	T1, g_stackguard0(g)
+	MOV	T1, g_stackguard1(g)
+	MOV	T1, (g_stack+stack_lo)(g)
+	MOV	X2, (g_stack+stack_hi)(g)
+
+	// if there is a _cgo_init, call it using the gcc ABI.
+	MOV	_cgo_init(SB), T0
+	BEQ	T0, ZERO, nocgo
+
+	MOV	ZERO, A3	// arg 3: not used
+	MOV	$runtime·tls_g(SB), A2	// arg 2: &tls_g
+	MOV	$setg_gcc<>(SB), A1	// arg 1: setg
+	MOV	g, A0	// arg 0: G
+	JALR	RA, T0
+
+nocgo:
+	// update stackguard after _cgo_init
+	MOV	(g_stack+stack_lo)(g), T0
+	ADD	$const__StackGuard, T0
+	MOV	T0, g_stackguard0(g)
+	MOV	T0, g_stackguard1(g)
+
+	// set the per-goroutine and per-mach "registers"
+	MOV	$runtime·m0(SB), T0
+
+	// save m->g0 = g0
+	MOV	g, m_g0(T0)
+	// save m0 to g0->m
+	MOV	T0, g_m(g)
+
+	CALL	runtime·check(SB)
+
+	// args are already prepared
+	CALL	runtime·args(SB)
+	CALL	runtime·checkgoriscv(SB)
+	CALL	runtime·osinit(SB)
+	CALL	runtime·schedinit(SB)
+
+	// create a new goroutine to start program
+	MOV	$runtime·mainPC(SB), T0		// entry
+	ADD	$-(3*PTRSIZE), X2
+	MOV	T0, (2*PTRSIZE)(X2)
+	MOV	ZERO, PTRSIZE(X2)
+	MOV	ZERO, 0(X2)
+	CALL	runtime·newproc(SB)
+	ADD	$(3*PTRSIZE), X2
+
+	// start this M
+	CALL	runtime·mstart(SB)
+
+	WORD $0 // crash if reached
+	RET
+
+// void setg_gcc(G*); set g called from gcc with g in A0
+TEXT setg_gcc<>(SB),NOSPLIT,$0-0
+	MOV	A0, g
+	CALL	runtime·save_g(SB)
+	RET
+
+// systemstack_switch is a dummy routine that systemstack leaves at the bottom
+// of the G stack. We need to distinguish the routine that
+// lives at the bottom of the G stack from the one that lives
+// at the top of the system stack because the one at the top of
+// the system stack terminates the stack walk (see topofstack()).
+TEXT runtime·systemstack_switch(SB), NOSPLIT, $0-0
+	UNDEF
+	JALR	RA, ZERO	// make sure this function is not leaf
+	RET
+
+// func systemstack(fn func())
+TEXT runtime·systemstack(SB), NOSPLIT, $0-PTRSIZE
+	MOV	fn+0(FP), CTXT	// CTXT = fn
+	MOV	g_m(g), T0	// T0 = m
+
+	MOV	m_gsignal(T0), T1	// T1 = gsignal
+	BEQ	g, T1, noswitch
+
+	MOV	m_g0(T0), T1	// T1 = g0
+	BEQ	g, T1, noswitch
+
+	MOV	m_curg(T0), T2
+	BEQ	g, T2, switch
+
+	// Bad: g is not gsignal, not g0, not curg. What is it?
+	// Hide call from linker nosplit analysis.
+	MOV	$runtime·badsystemstack(SB), T1
+	JALR	RA, T1
+
+switch:
+	// save our state in g->sched. Pretend to
+	// be systemstack_switch if the G stack is scanned.
+	MOV	$runtime·systemstack_switch(SB), T2
+	ADD	$8, T2	// get past prologue
+	MOV	T2, (g_sched+gobuf_pc)(g)
+	MOV	X2, (g_sched+gobuf_sp)(g)
+	MOV	ZERO, (g_sched+gobuf_lr)(g)
+	MOV	g, (g_sched+gobuf_g)(g)
+
+	// switch to g0
+	MOV	T1, g
+	CALL	runtime·save_g(SB)
+	MOV	(g_sched+gobuf_sp)(g), T0
+	// make it look like mstart called systemstack on g0, to stop traceback
+	ADD	$-16, T0
+	AND	$~15, T0
+	MOV	$runtime·mstart(SB), T1
+	MOV	T1, 0(T0)
+	MOV	T0, X2
+
+	// call target function
+	MOV	0(CTXT), T1	// code pointer
+	JALR	RA, T1
+
+	// switch back to g
+	MOV	g_m(g), T0
+	MOV	m_curg(T0), g
+	CALL	runtime·save_g(SB)
+	MOV	(g_sched+gobuf_sp)(g), X2
+	MOV	ZERO, (g_sched+gobuf_sp)(g)
+	RET
+
+noswitch:
+	// already on m stack, just call directly
+	MOV	0(CTXT), T1	// code pointer
+	JALR	RA, T1
+	RET
+
+/*
+ * support for morestack
+ */
+
+// Called during function prolog when more stack is needed.
+// Caller has already loaded:
+// R1: framesize, R2: argsize, R3: LR
+//
+// The traceback routines see morestack on a g0 as being
+// the top of a stack (for example, morestack calling newstack
+// calling the scheduler calling newm calling gc), so we must
+// record an argument size. For that purpose, it has no arguments.
+
+// func morestack()
+TEXT runtime·morestack(SB),NOSPLIT,$-PTRSIZE-0
+	// Cannot grow scheduler stack (m->g0).
+	MOV	g_m(g), A0
+	MOV	m_g0(A0), A1
+	BNE	g, A1, 3(PC)
+	CALL	runtime·badmorestackg0(SB)
+	CALL	runtime·abort(SB)
+
+	// Cannot grow signal stack (m->gsignal).
+	MOV	m_gsignal(A0), A1
+	BNE	g, A1, 3(PC)
+	CALL	runtime·badmorestackgsignal(SB)
+	CALL	runtime·abort(SB)
+
+	// Called from f.
+	// Set g->sched to context in f.
+	MOV	X2, (g_sched+gobuf_sp)(g)
+	MOV	T0, (g_sched+gobuf_pc)(g)
+	MOV	RA, (g_sched+gobuf_lr)(g)
+	// newstack will fill gobuf.ctxt.
+
+	// Called from f.
+	// Set m->morebuf to f's caller.
+	MOV	RA, (m_morebuf+gobuf_pc)(A0)	// f's caller's PC
+	MOV	X2, (m_morebuf+gobuf_sp)(A0)	// f's caller's SP
+	MOV	g, (m_morebuf+gobuf_g)(A0)
+
+	// Call newstack on m->g0's stack.
+	MOV	m_g0(A0), g
+	CALL	runtime·save_g(SB)
+	MOV	(g_sched+gobuf_sp)(g), X2
+	// Create a stack frame on g0 to call newstack.
+	MOV	ZERO, -(2*PTRSIZE)(X2)	// Zero saved LR in frame
+	ADD	$-(2*PTRSIZE), X2
+	MOV	CTXT, PTRSIZE(X2)	// ctxt argument
+	CALL	runtime·newstack(SB)
+
+	// Not reached, but make sure the return PC from the call to newstack
+	// is still in this function, and not the beginning of the next.
+	UNDEF
+
+// func morestack_noctxt()
+TEXT runtime·morestack_noctxt(SB),NOSPLIT,$-PTRSIZE-0
+	MOV	ZERO, CTXT
+	JMP	runtime·morestack(SB)
+
+// func return0()
+TEXT runtime·return0(SB), NOSPLIT, $0
+	MOV	$0, A0
+	RET
+
+// memeqbody compares the A3 bytes at A1 and A2 and stores
+// whether they are equal to the bool at A5.
+TEXT runtime·memeqbody(SB),NOSPLIT,$0
+	ADD	A1, A3, A4	// end of a
+
+	// If less than sixteen bytes or differently aligned, do one byte
+	// at a time.
+	SLTU	$16, A3, T0
+	BNE	T0, ZERO, bytes
+	XOR	A1, A2, T0
+	AND	$(PTRSIZE-1), T0
+	BNE	T0, ZERO, bytes
+
+	// Do one byte at a time until word-aligned.
+align:
+	AND	$(PTRSIZE-1), A1, T0
+	BEQ	T0, ZERO, aligned
+	MOVBU	(A1), T1
+	MOVBU	(A2), T2
+	BNE	T1, T2, noteq
+	ADD	$1, A1
+	ADD	$1, A2
+	JMP	align
+
+aligned:
+	// Do four words at a time as long as there is room.
+	ADD	$(1-4*PTRSIZE), A4, T5
+loop4:
+	BGEU	A1, T5, words
+	MOV	0(A1), T0
+	MOV	0(A2), T1
+	MOV	PTRSIZE(A1), T2
+	MOV	PTRSIZE(A2), T3
+	BNE	T0, T1, noteq
+	BNE	T2, T3, noteq
+	MOV	(2*PTRSIZE)(A1), T0
+	MOV	(2*PTRSIZE)(A2), T1
+	MOV	(3*PTRSIZE)(A1), T2
+	MOV	(3*PTRSIZE)(A2), T3
+	BNE	T0, T1, noteq
+	BNE	T2, T3, noteq
+	ADD	$(4*PTRSIZE), A1
+	ADD	$(4*PTRSIZE), A2
+	JMP	loop4
+
+	// Then a word at a time.
+words:
+	ADD	$(1-PTRSIZE), A4, T5
+loop1:
+	BGEU	A1, T5, bytes
+	MOV	(A1), T0
+	MOV	(A2), T1
+	BNE	T0, T1, noteq
+	ADD	$PTRSIZE, A1
+	ADD	$PTRSIZE, A2
+	JMP	loop1
+
+	// Finish off the remaining bytes.
+bytes:
+	BEQ	A1, A4, eq
+byteloop:
+	MOVBU	(A1), T0
+	MOVBU	(A2), T1
+	BNE	T0, T1, noteq
+	ADD	$1, A1
+	ADD	$1, A2
+	BNE	A1, A4, byteloop
+
+eq:
+	MOV	$1, T0
+	MOVB	T0, (A5)
+	RET
+noteq:
+	MOVB	ZERO, (A5)
+	RET
+
+// restore state from Gobuf; longjmp
+
+// func gogo(buf *gobuf)
+TEXT runtime·gogo(SB), NOSPLIT, $PTRSIZE_2-PTRSIZE
+	MOV	buf+0(FP), T0
+
+	// If ctxt is not nil, invoke deletion barrier before overwriting.
+	MOV	gobuf_ctxt(T0), T1
+	BEQ	T1, ZERO, nilctxt
+	ADD	$gobuf_ctxt, T0, T1
+	MOV	T1, PTRSIZE(X2)
+	MOV	ZERO, (2*PTRSIZE)(X2)
+	CALL	runtime·writebarrierptr_prewrite(SB)
+	MOV	buf+0(FP), T0
+
+nilctxt:
+	MOV	gobuf_g(T0), g	// make sure g is not nil
+	CALL	runtime·save_g(SB)
+
+	MOV	(g), ZERO // make sure g is not nil
+	MOV	gobuf_sp(T0), X2
+	MOV	gobuf_lr(T0), RA
+	MOV	gobuf_ret(T0), A0
+	MOV	gobuf_ctxt(T0), CTXT
+	MOV	ZERO, gobuf_sp(T0)
+	MOV	ZERO, gobuf_ret(T0)
+	MOV	ZERO, gobuf_lr(T0)
+	MOV	ZERO, gobuf_ctxt(T0)
+	MOV	gobuf_pc(T0), T0
+	JALR	ZERO, T0
+
+// func jmpdefer(fv *funcval, argp uintptr)
+// called from deferreturn
+// 1. grab stored return address from the caller's frame
+// 2. sub 12 bytes to get back to JAL deferreturn
+// 3. JMP to fn
+// TODO(sorear): There are shorter jump sequences.  This function will need to be updated when we use them.
+TEXT runtime·jmpdefer(SB), NOSPLIT, $-PTRSIZE-PTRSIZE_2
+	MOV	0(X2), RA
+	ADD	$-12, RA
+
+	MOV	fv+0(FP), CTXT
+	MOV	argp+PTRSIZE(FP), X2
+	ADD	$-PTRSIZE, X2
+	MOV	0(CTXT), T0
+	JALR	ZERO, T0
+
+// func procyield(cycles uint32)
+TEXT runtime·procyield(SB),NOSPLIT,$0-0
+	RET
+
+// Switch to m->g0's stack, call fn(g).
+// Fn must never return. It should gogo(&g->sched)
+// to keep running g.
+
+// func mcall(fn func(*g))
+TEXT runtime·mcall(SB), NOSPLIT, $-PTRSIZE-PTRSIZE
+	// Save caller state in g->sched
+	MOV	X2, (g_sched+gobuf_sp)(g)
+	MOV	RA, (g_sched+gobuf_pc)(g)
+	MOV	ZERO, (g_sched+gobuf_lr)(g)
+	MOV	g, (g_sched+gobuf_g)(g)
+
+	// Switch to m->g0 & its stack, call fn.
+	MOV	g, T0
+	MOV	g_m(g), T1
+	MOV	m_g0(T1), g
+	CALL	runtime·save_g(SB)
+	BNE	g, T0, 2(PC)
+	JMP	runtime·badmcall(SB)
+	MOV	fn+0(FP), CTXT			// context
+	MOV	0(CTXT), T1			// code pointer
+	MOV	(g_sched+gobuf_sp)(g), X2	// sp = m->g0->sched.sp
+	ADD	$-(2*PTRSIZE), X2
+	MOV	T0, PTRSIZE(X2)
+	MOV	ZERO, 0(X2)
+	JALR	RA, T1
+	JMP	runtime·badmcall2(SB)
+
+// func gosave(buf *gobuf)
+// save state in Gobuf; setjmp
+TEXT runtime·gosave(SB), NOSPLIT, $-PTRSIZE-PTRSIZE
+	MOV	buf+0(FP), T1
+	MOV	X2, gobuf_sp(T1)
+	MOV	RA, gobuf_pc(T1)
+	MOV	g, gobuf_g(T1)
+	MOV	ZERO, gobuf_lr(T1)
+	MOV	ZERO, gobuf_ret(T1)
+	// Assert ctxt is zero. See func save.
+	MOV	gobuf_ctxt(T1), T1
+	BEQ	T1, ZERO, 2(PC)
+	CALL	runtime·badctxt(SB)
+	RET
+
+// func asminit()
+TEXT runtime·asminit(SB),NOSPLIT,$-PTRSIZE-0
+	RET
+
+// reflectcall: call a function with the given argument list
+// func call(argtype *_type, f *FuncVal, arg *byte, argsize, retoffset uint32).
+// we don't have variable-sized frames, so we use a small number
+// of constant-sized-frame functions to encode a few bits of size in the pc.
+// Caution: ugly multiline assembly macros in your future!
+
+#define DISPATCH(NAME,MAXSIZE)	\
+	MOV	$MAXSIZE, T1	\
+	BLTU	T1, T0, 3(PC)	\
+	MOV	$NAME(SB), T2;	\
+	JALR	ZERO, T2
+// Note: can't just "BR NAME(SB)" - bad inlining results.
+
+// reflectcall and the call* functions take three pointers followed by
+// the uint32s argsize and retoffset.
+#ifdef GOARCH_riscv32
+#define CALLARGSIZE	20
+#else
+#define CALLARGSIZE	32
+#endif
+#define RETOFFSET	(3*PTRSIZE+4)
+
+// func call(argtype *rtype, fn, arg unsafe.Pointer, n uint32, retoffset uint32)
+TEXT reflect·call(SB), NOSPLIT, $0-0
+	JMP	·reflectcall(SB)
+
+// func reflectcall(argtype *_type, fn, arg unsafe.Pointer, argsize uint32, retoffset uint32)
+TEXT ·reflectcall(SB), NOSPLIT, $-PTRSIZE-CALLARGSIZE
+	MOVWU argsize+(3*PTRSIZE)(FP), T0
+#ifdef GOARCH_riscv32
+	DISPATCH(runtime·call16, 16)
+#endif
+	DISPATCH(runtime·call32, 32)
+	DISPATCH(runtime·call64, 64)
+	DISPATCH(runtime·call128, 128)
+	DISPATCH(runtime·call256, 256)
+	DISPATCH(runtime·call512, 512)
+	DISPATCH(runtime·call1024, 1024)
+	DISPATCH(runtime·call2048, 2048)
+	DISPATCH(runtime·call4096, 4096)
+	DISPATCH(runtime·call8192, 8192)
+	DISPATCH(runtime·call16384, 16384)
+	DISPATCH(runtime·call32768, 32768)
+	DISPATCH(runtime·call65536, 65536)
+	DISPATCH(runtime·call131072, 131072)
+	DISPATCH(runtime·call262144, 262144)
+	DISPATCH(runtime·call524288, 524288)
+	DISPATCH(runtime·call1048576, 1048576)
+	DISPATCH(runtime·call2097152, 2097152)
+	DISPATCH(runtime·call4194304, 4194304)
+	DISPATCH(runtime·call8388608, 8388608)
+	DISPATCH(runtime·call16777216, 16777216)
+	DISPATCH(runtime·call33554432, 33554432)
+	DISPATCH(runtime·call67108864, 67108864)
+	DISPATCH(runtime·call134217728, 134217728)
+	DISPATCH(runtime·call268435456, 268435456)
+	DISPATCH(runtime·call536870912, 536870912)
+	DISPATCH(runtime·call1073741824, 1073741824)
+	MOV	$runtime·badreflectcall(SB), T2
+	JALR	ZERO, T2
+
+#define CALLFN(NAME,MAXSIZE)			\
+TEXT NAME(SB), WRAPPER, $MAXSIZE-CALLARGSIZE;	\
+	NO_LOCAL_POINTERS;			\
+	/* copy arguments to stack */		\
+	MOV	arg+(2*PTRSIZE)(FP), A1;	\
+	MOVWU	argsize+(3*PTRSIZE)(FP), A2;	\
+	MOV	X2, A3;				\
+	ADD	$PTRSIZE, A3;			\
+	ADD	A3, A2;				\
+	BEQ	A3, A2, 6(PC);			\
+	MOVBU	(A1), A4;			\
+	ADD	$1, A1;				\
+	MOVB	A4, (A3);			\
+	ADD	$1, A3;				\
+	JMP	-5(PC);				\
+	/* call function */			\
+	MOV	f+PTRSIZE(FP), CTXT;		\
+	MOV	(CTXT), A4;			\
+	PCDATA  $PCDATA_StackMapIndex, $0;	\
+	JALR	RA, A4;				\
+	/* copy return values back */		\
+	MOV	argtype+0(FP), A5;		\
+	MOV	arg+(2*PTRSIZE)(FP), A1;	\
+	MOVWU	n+(3*PTRSIZE)(FP), A2;		\
+	MOVWU	retoffset+RETOFFSET(FP), A4;	\
+	ADD	$PTRSIZE, X2, A3;		\
+	ADD	A4, A3;				\
+	ADD	A4, A1;				\
+	SUB	A4, A2;				\
+	CALL	callRet<>(SB);			\
+	RET
+
+// callRet copies return values back at the end of call*. This is a
+// separate function so it can allocate stack space for the arguments
+// to reflectcallmove. It does not follow the Go ABI; it expects its
+// arguments in registers.
+TEXT callRet<>(SB), NOSPLIT, $PTRSIZE_4-0
+	MOV	A5, PTRSIZE(X2)
+	MOV	A1, (2*PTRSIZE)(X2)
+	MOV	A3, (3*PTRSIZE)(X2)
+	MOV	A2, (4*PTRSIZE)(X2)
+	CALL	runtime·reflectcallmove(SB)
+	RET
+
+CALLFN(·call16, 16)
+CALLFN(·call32, 32)
+CALLFN(·call64, 64)
+CALLFN(·call128, 128)
+CALLFN(·call256, 256)
+CALLFN(·call512, 512)
+CALLFN(·call1024, 1024)
+CALLFN(·call2048, 2048)
+CALLFN(·call4096, 4096)
+CALLFN(·call8192, 8192)
+CALLFN(·call16384, 16384)
+CALLFN(·call32768, 32768)
+CALLFN(·call65536, 65536)
+CALLFN(·call131072, 131072)
+CALLFN(·call262144, 262144)
+CALLFN(·call524288, 524288)
+CALLFN(·call1048576, 1048576)
+CALLFN(·call2097152, 2097152)
+CALLFN(·call4194304, 4194304)
+CALLFN(·call8388608, 8388608)
+CALLFN(·call16777216, 16777216)
+CALLFN(·call33554432, 33554432)
+CALLFN(·call67108864, 67108864)
+CALLFN(·call134217728, 134217728)
+CALLFN(·call268435456, 268435456)
+CALLFN(·call536870912, 536870912)
+CALLFN(·call1073741824, 1073741824)
+
+// func goexit(neverCallThisFunction)
+// The top-most function running on a goroutine
+// returns to goexit+PCQuantum.
+TEXT runtime·goexit(SB),NOSPLIT,$-PTRSIZE-0
+	MOV	ZERO, ZERO	// NOP, never compressed; see gostartcall
+	CALL	runtime·goexit1(SB)	// does not return
+	// traceback from goexit1 must hit code range of goexit
+	MOV	ZERO, ZERO	// NOP
+
+// func setcallerpc(argp unsafe.Pointer, pc uintptr)
+TEXT runtime·setcallerpc(SB),NOSPLIT,$PTRSIZE-PTRSIZE_2
+	MOV	pc+PTRSIZE(FP), A1
+	MOV	(2*PTRSIZE)(X2), A2
+	MOV	runtime·stackBarrierPC(SB), A3
+	BEQ	A2, A3, setbar
+	MOV	A1, (2*PTRSIZE)(X2)		// set LR in caller
+	RET
+setbar:
+	// Set the stack barrier return PC.
+	MOV	A1, PTRSIZE(X2)
+	CALL	runtime·setNextBarrierPC(SB)
+	RET
+
+#ifdef GOARCH_riscv32
+#define BYTES_01	0x01010101
+#else
+#define BYTES_01	0x0101010101010101
+#endif
+
+// indexbytebody looks for the byte A3 in the A2 bytes at A1 and
+// stores its index, or -1, to the int at A5.
+TEXT runtime·indexbytebody(SB),NOSPLIT,$0
+	MOV	A1, A4		// store base for later
+	ADD	A1, A2, A6	// end
+
+	// If less than sixteen bytes, do one byte at a time.
+	SLTU	$16, A2, T0
+	BNE	T0, ZERO, bytes
+
+	// Do one byte at a time until word-aligned.
+align:
+	AND	$(PTRSIZE-1), A1, T0
+	BEQ	T0, ZERO, aligned
+	MOVBU	(A1), T1
+	BEQ	T1, A3, found
+	ADD	$1, A1
+	JMP	align
+
+aligned:
+	// Do a word at a time as long as there is room. With x the
+	// word xor c in every byte, (x - 0x01...01) &^ x & 0x80...80 is
+	// nonzero if and only if x has a zero byte, and its lowest set
+	// bit is in the first one.
+	MOV	$BYTES_01, T2
+	MUL	A3, T2, T3	// c in every byte
+	SLL	$7, T2, T4	// 0x80...80
+	ADD	$(1-PTRSIZE), A6, A7
+loop:
+	BGEU	A1, A7, bytes
+	MOV	(A1), T0
+	XOR	T3, T0
+	SUB	T2, T0, T1
+	XOR	$-1, T0
+	AND	T0, T1
+	AND	T4, T1
+	BNE	T1, ZERO, foundword
+	ADD	$PTRSIZE, A1
+	JMP	loop
+
+foundword:
+	// With Zbb, count the trailing zeros to find the byte.
+	// Otherwise fall back to looking at this word a byte at a time.
+#ifndef GORISCV_b
+	MOVBU	runtime·cpu+facilities_hasZbb(SB), T0
+	BEQ	T0, ZERO, bytes
+#endif
+	CTZ	T1, T1
+	SRL	$3, T1
+	ADD	T1, A1
+	JMP	found
+
+	// Finish off the remaining bytes.
+bytes:
+	BEQ	A1, A6, notfound
+byteloop:
+	MOVBU	(A1), T1
+	BEQ	T1, A3, found
+	ADD	$1, A1
+	BNE	A1, A6, byteloop
+	JMP	notfound
+
+found:
+	SUB	A4, A1		// remove base
+	MOV	A1, (A5)
+	RET
+
+notfound:
+	MOV	$-1, A1
+	MOV	A1, (A5)
+	RET
+
+// countbytebody counts the bytes A3 in the A2 bytes at A1 and
+// stores the count to the int at A5.
+TEXT runtime·countbytebody(SB),NOSPLIT,$0
+	MOV	ZERO, A4	// count
+	ADD	A1, A2, A6	// end
+
+	// If less than sixteen bytes, do one byte at a time.
+	SLTU	$16, A2, T0
+	BNE	T0, ZERO, bytes
+
+	// Do one byte at a time until word-aligned.
+	ADD	$(PTRSIZE-1), A1, A7
+	AND	$-PTRSIZE, A7
+	BEQ	A1, A7, aligned
+align:
+	MOVBU	(A1), T1
+	BNE	T1, A3, 2(PC)
+	ADD	$1, A4
+	ADD	$1, A1
+	BNE	A1, A7, align
+
+aligned:
+	// Do a word at a time as long as there is room. With x the
+	// word xor c in every byte, ((x & 0x7f...7f) + 0x7f...7f) | x
+	// has the top bit of a byte set if and only if that byte of x
+	// is nonzero. Moved to the bottom of each byte, these are added
+	// up byte by byte in A0, for at most 31 words at a time so that
+	// the bytes of A0 add up to less than 256, and then multiplying
+	// by 0x01...01 adds them up in the top byte. The count is the
+	// number of bytes looked at less the nonzero ones.
+	MOV	$BYTES_01, T2
+	MUL	A3, T2, T3	// c in every byte
+	SLL	$7, T2, T4
+	XOR	$-1, T4		// 0x7f...7f
+	ADD	$(1-PTRSIZE), A6, A7
+	BGEU	A1, A7, bytes
+	MOV	A1, A2		// start of the words
+block:
+	MOV	ZERO, A0
+	ADD	$(31*PTRSIZE), A1, T5
+	BLTU	T5, A7, 2(PC)
+	MOV	A7, T5
+loop:
+	MOV	(A1), T0
+	XOR	T3, T0
+	AND	T4, T0, T1
+	ADD	T4, T1
+	OR	T0, T1
+	SRL	$7, T1
+	AND	T2, T1
+	ADD	T1, A0
+	ADD	$PTRSIZE, A1
+	BLTU	A1, T5, loop
+	MUL	T2, A0
+	SRL	$(8*PTRSIZE-8), A0
+	SUB	A0, A4
+	BLTU	A1, A7, block
+	SUB	A2, A1, T0
+	ADD	T0, A4
+
+	// Finish off the remaining bytes.
+bytes:
+	BEQ	A1, A6, done
+byteloop:
+	MOVBU	(A1), T1
+	BNE	T1, A3, 2(PC)
+	ADD	$1, A4
+	ADD	$1, A1
+	BNE	A1, A6, byteloop
+
+done:
+	MOV	A4, (A5)
+	RET
+
+// cmpbody compares the A2 bytes at A1 with the A4 bytes at A3
+// and stores -1, 0 or +1 to the int at A5.
+TEXT runtime·cmpbody(SB),NOSPLIT,$0
+	// Compare the common prefix, of length min(A2, A4).
+	MOV	A2, T0
+	BLTU	A2, A4, 2(PC)
+	MOV	A4, T0
+	BEQ	A1, A3, samebytes
+	ADD	A1, T0, A6	// end of the prefix in a
+
+	// If less than sixteen bytes or differently aligned, do one byte
+	// at a time.
+	SLTU	$16, T0, T1
+	BNE	T1, ZERO, bytes
+	XOR	A1, A3, T1
+	AND	$(PTRSIZE-1), T1
+	BNE	T1, ZERO, bytes
+
+	// Do one byte at a time until word-aligned.
+align:
+	AND	$(PTRSIZE-1), A1, T0
+	BEQ	T0, ZERO, aligned
+	MOVBU	(A1), T1
+	MOVBU	(A3), T2
+	BNE	T1, T2, cmp
+	ADD	$1, A1
+	ADD	$1, A3
+	JMP	align
+
+aligned:
+	// Do a word at a time as long as there is room.
+	ADD	$(1-PTRSIZE), A6, T5
+loop:
+	BGEU	A1, T5, bytes
+	MOV	(A1), T1
+	MOV	(A3), T2
+	BNE	T1, T2, foundword
+	ADD	$PTRSIZE, A1
+	ADD	$PTRSIZE, A3
+	JMP	loop
+
+foundword:
+	// With Zbb, reverse the bytes so that the words compare in memory
+	// order. Otherwise fall back to looking at this word a byte at a time.
+#ifndef GORISCV_b
+	MOVBU	runtime·cpu+facilities_hasZbb(SB), T0
+	BEQ	T0, ZERO, bytes
+#endif
+	REV8	T1, T1
+	REV8	T2, T2
+	JMP	cmp
+
+	// Finish off the remaining bytes.
+bytes:
+	BEQ	A1, A6, samebytes
+byteloop:
+	MOVBU	(A1), T1
+	MOVBU	(A3), T2
+	BNE	T1, T2, cmp
+	ADD	$1, A1
+	ADD	$1, A3
+	BNE	A1, A6, byteloop
+	JMP	samebytes
+
+cmp:
+	// T1 != T2; store -1 if T1 < T2 and +1 otherwise.
+	SLTU	T2, T1, T0
+	SLL	$1, T0
+	MOV	$1, T1
+	SUB	T0, T1
+	MOV	T1, (A5)
+	RET
+
+samebytes:
+	// The prefixes are the same, so compare the lengths.
+	SLTU	A4, A2, T0	// len(a) < len(b)
+	SLTU	A2, A4, T1	// len(a) > len(b)
+	SUB	T0, T1
+	MOV	T1, (A5)
+	RET
+
+TEXT runtime·stackBarrier(SB),NOSPLIT,$0
+	WORD $0
+
+// cgocallback(void (*fn)(void*), void *frame, uintptr framesize, uintptr ctxt)
+// Turn the fn into a Go func (by taking its address) and call
+// cgocallback_gofunc.
+TEXT runtime·cgocallback(SB),NOSPLIT,$PTRSIZE_4-PTRSIZE_4
+	MOV	$fn+0(FP), T0
+	MOV	T0, PTRSIZE(X2)
+	MOV	frame+PTRSIZE(FP), T0
+	MOV	T0, (2*PTRSIZE)(X2)
+	MOV	framesize+(2*PTRSIZE)(FP), T0
+	MOV	T0, (3*PTRSIZE)(X2)
+	MOV	ctxt+(3*PTRSIZE)(FP), T0
+	MOV	T0, (4*PTRSIZE)(X2)
+	MOV	$runtime·cgocallback_gofunc(SB), T0
+	JALR	RA, T0
+	RET
+
+// cgocallback_gofunc(FuncVal*, void *frame, uintptr framesize, uintptr ctxt)
+// See cgocall.go for more details.
+TEXT ·cgocallback_gofunc(SB),NOSPLIT,$PTRSIZE_2-PTRSIZE_4
+	NO_LOCAL_POINTERS
+
+	// Load m and g from thread-local storage.
+	MOVBU	runtime·iscgo(SB), T0
+	BEQ	T0, ZERO, nocgo
+	CALL	runtime·load_g(SB)
+nocgo:
+
+	// If g is nil, Go did not create the current thread.
+	// Call needm to obtain one for temporary use.
+	// In this case, we're running on the thread stack, so there's
+	// lots of space, but the linker doesn't know. Hide the call from
+	// the linker analysis by using an indirect call.
+	BEQ	g, ZERO, needm
+
+	MOV	g_m(g), T1
+	MOV	T1, (2*PTRSIZE)(X2)	// savedm
+	JMP	havem
+
+needm:
+	MOV	g, (2*PTRSIZE)(X2)	// savedm; g is zero, so is m.
+	MOV	$runtime·needm(SB), T0
+	JALR	RA, T0
+
+	// Set m->sched.sp = SP, so that if a panic happens
+	// during the function we are about to execute, it will
+	// have a valid SP to run on the g0 stack.
+	// The next few lines (after the havem label)
+	// will save this SP onto the stack and then write
+	// the same SP back to m->sched.sp. That seems redundant,
+	// but if an unrecovered panic happens, unwindm will
+	// restore the g->sched.sp from the stack location
+	// and then systemstack will try to use it. If we don't set it here,
+	// that restored SP will be uninitialized (typically 0) and
+	// will not be usable.
+	MOV	g_m(g), T1
+	MOV	m_g0(T1), T0
+	MOV	X2, (g_sched+gobuf_sp)(T0)
+
+havem:
+	// Now there's a valid m, and we're running on its m->g0.
+	// Save current m->g0->sched.sp on stack and then set it to SP.
+	// Save current sp in m->g0->sched.sp in preparation for
+	// switch back to m->curg stack.
+	// NOTE: unwindm knows that the saved g->sched.sp is at PTRSIZE(X2).
+	MOV	m_g0(T1), T0
+	MOV	(g_sched+gobuf_sp)(T0), T2
+	MOV	T2, PTRSIZE(X2)	// savedsp
+	MOV	X2, (g_sched+gobuf_sp)(T0)
+
+	// Switch to m->curg stack and call runtime.cgocallbackg.
+	// Because we are taking over the execution of m->curg
+	// but *not* resuming what had been running, we need to
+	// save that information (m->curg->sched) so we can restore it.
+	// We can restore m->curg->sched.sp easily, because calling
+	// runtime.cgocallbackg leaves SP unchanged upon return.
+	// To save m->curg->sched.pc, we push it onto the stack.
+	// This has the added benefit that it looks to the traceback
+	// routine like cgocallbackg is going to return to that
+	// PC (because the frame we allocate below has the same
+	// size as cgocallback_gofunc's frame declared above)
+	// so that the traceback will seamlessly trace back into
+	// the earlier calls.
+	//
+	// In the new goroutine, -PTRSIZE(SP) is unused (where SP refers to
+	// m->curg's SP while we're setting it up, before we've adjusted it).
+	MOV	m_curg(T1), g
+	CALL	runtime·save_g(SB)
+	MOV	(g_sched+gobuf_sp)(g), T2 // prepare stack as T2
+	MOV	(g_sched+gobuf_pc)(g), T3
+	MOV	T3, -(3*PTRSIZE)(T2)
+	MOV	ctxt+(3*PTRSIZE)(FP), T0
+	MOV	T0, -(2*PTRSIZE)(T2)
+	MOV	$-(3*PTRSIZE)(T2), X2
+	CALL	runtime·cgocallbackg(SB)
+
+	// Restore g->sched (== m->curg->sched) from saved values.
+	MOV	0(X2), T3
+	MOV	T3, (g_sched+gobuf_pc)(g)
+	MOV	$(3*PTRSIZE)(X2), T2
+	MOV	T2, (g_sched+gobuf_sp)(g)
+
+	// Switch back to m->g0's stack and restore m->g0->sched.sp.
+	// (Unlike m->curg, the g0 goroutine never uses sched.pc,
+	// so we do not have to restore it.)
+	MOV	g_m(g), T1
+	MOV	m_g0(T1), g
+	CALL	runtime·save_g(SB)
+	MOV	(g_sched+gobuf_sp)(g), X2
+	MOV	PTRSIZE(X2), T2	// savedsp
+	MOV	T2, (g_sched+gobuf_sp)(g)
+
+	// If the m on entry was nil, we called needm above to borrow an m
+	// for the duration of the call. Since the call is over, return it with dropm.
+	MOV	(2*PTRSIZE)(X2), T1	// savedm
+	BNE	T1, ZERO, droppedm
+	MOV	$runtime·dropm(SB), T0
+	JALR	RA, T0
+droppedm:
+
+	// Done!
+	RET
+
+TEXT runtime·prefetcht0(SB),NOSPLIT,$0-PTRSIZE
+	RET
+
+TEXT runtime·prefetcht1(SB),NOSPLIT,$0-PTRSIZE
+	RET
+
+TEXT runtime·prefetcht2(SB),NOSPLIT,$0-PTRSIZE
+	RET
+
+TEXT runtime·prefetchnta(SB),NOSPLIT,$0-PTRSIZE
+	RET
+
+TEXT runtime·breakpoint(SB),NOSPLIT,$-PTRSIZE-0
+	EBREAK
+	RET
+
+TEXT runtime·abort(SB),NOSPLIT,$-PTRSIZE-0
+	EBREAK
+	RET
+
+// void setg(G*); set g. for use by needm.
+TEXT runtime·setg(SB), NOSPLIT, $0-PTRSIZE
+	MOV	gg+0(FP), g
+	// This only happens if iscgo, so jump straight to save_g
+	CALL	runtime·save_g(SB)
+	RET
+
+DATA	runtime·mainPC+0(SB)/PTRSIZE,$runtime·main(SB)
+GLOBL	runtime·mainPC(SB),RODATA,$PTRSIZE
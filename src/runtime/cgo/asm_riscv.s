@@ -0,0 +1,78 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+/*
+ * void crosscall2(void (*fn)(void*, int32, uintptr), void*, int32, uintptr)
+ * Save registers and call fn with three arguments.
+ */
+TEXT crosscall2(SB),NOSPLIT,$-8
+	/*
+	 * We still need to save all callee save registers as before, and then
+	 *  push 3 args for fn (A1, A2, A3).
+	 * Also note that at procedure entry in gc world, 8(X2) will be the
+	 *  first arg.
+	 */
+	ADD	$(-8*30), X2
+	MOV	A1, (8*1)(X2) // void*
+	MOVW	A2, (8*2)(X2) // int32
+	MOV	A3, (8*3)(X2) // uintptr
+	MOV	RA, (8*4)(X2)
+	MOV	S0, (8*5)(X2)
+	MOV	S1, (8*6)(X2)
+	MOV	S2, (8*7)(X2)
+	MOV	S3, (8*8)(X2)
+	MOV	S4, (8*9)(X2)
+	MOV	S5, (8*10)(X2)
+	MOV	S6, (8*11)(X2)
+	MOV	S7, (8*12)(X2)
+	MOV	S8, (8*13)(X2)
+	MOV	S9, (8*14)(X2)
+	MOV	S10, (8*15)(X2)
+	MOV	g, (8*16)(X2)
+	MOVD	FS0, (8*17)(X2)
+	MOVD	FS1, (8*18)(X2)
+	MOVD	FS2, (8*19)(X2)
+	MOVD	FS3, (8*20)(X2)
+	MOVD	FS4, (8*21)(X2)
+	MOVD	FS5, (8*22)(X2)
+	MOVD	FS6, (8*23)(X2)
+	MOVD	FS7, (8*24)(X2)
+	MOVD	FS8, (8*25)(X2)
+	MOVD	FS9, (8*26)(X2)
+	MOVD	FS10, (8*27)(X2)
+	MOVD	FS11, (8*28)(X2)
+
+	// Initialize Go ABI environment
+	CALL	runtime·load_g(SB)
+	JALR	RA, A0
+
+	MOV	(8*4)(X2), RA
+	MOV	(8*5)(X2), S0
+	MOV	(8*6)(X2), S1
+	MOV	(8*7)(X2), S2
+	MOV	(8*8)(X2), S3
+	MOV	(8*9)(X2), S4
+	MOV	(8*10)(X2), S5
+	MOV	(8*11)(X2), S6
+	MOV	(8*12)(X2), S7
+	MOV	(8*13)(X2), S8
+	MOV	(8*14)(X2), S9
+	MOV	(8*15)(X2), S10
+	MOV	(8*16)(X2), g
+	MOVD	(8*17)(X2), FS0
+	MOVD	(8*18)(X2), FS1
+	MOVD	(8*19)(X2), FS2
+	MOVD	(8*20)(X2), FS3
+	MOVD	(8*21)(X2), FS4
+	MOVD	(8*22)(X2), FS5
+	MOVD	(8*23)(X2), FS6
+	MOVD	(8*24)(X2), FS7
+	MOVD	(8*25)(X2), FS8
+	MOVD	(8*26)(X2), FS9
+	MOVD	(8*27)(X2), FS10
+	MOVD	(8*28)(X2), FS11
+	ADD	$(8*30), X2
+	RET
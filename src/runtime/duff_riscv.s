@@ -0,0 +1,525 @@
+// AUTO-GENERATED by mkduff.go
+// Run go generate from src/runtime to update.
+// See mkduff.go for comments.
+
+// +build riscv
+
+#include "textflag.h"
+
+TEXT runtime·duffzero(SB), NOSPLIT, $0-0
+	MOV	ZERO, 0(T0)
+	MOV	ZERO, 8(T0)
+	MOV	ZERO, 16(T0)
+	MOV	ZERO, 24(T0)
+	MOV	ZERO, 32(T0)
+	MOV	ZERO, 40(T0)
+	MOV	ZERO, 48(T0)
+	MOV	ZERO, 56(T0)
+	MOV	ZERO, 64(T0)
+	MOV	ZERO, 72(T0)
+	MOV	ZERO, 80(T0)
+	MOV	ZERO, 88(T0)
+	MOV	ZERO, 96(T0)
+	MOV	ZERO, 104(T0)
+	MOV	ZERO, 112(T0)
+	MOV	ZERO, 120(T0)
+	MOV	ZERO, 128(T0)
+	MOV	ZERO, 136(T0)
+	MOV	ZERO, 144(T0)
+	MOV	ZERO, 152(T0)
+	MOV	ZERO, 160(T0)
+	MOV	ZERO, 168(T0)
+	MOV	ZERO, 176(T0)
+	MOV	ZERO, 184(T0)
+	MOV	ZERO, 192(T0)
+	MOV	ZERO, 200(T0)
+	MOV	ZERO, 208(T0)
+	MOV	ZERO, 216(T0)
+	MOV	ZERO, 224(T0)
+	MOV	ZERO, 232(T0)
+	MOV	ZERO, 240(T0)
+	MOV	ZERO, 248(T0)
+	MOV	ZERO, 256(T0)
+	MOV	ZERO, 264(T0)
+	MOV	ZERO, 272(T0)
+	MOV	ZERO, 280(T0)
+	MOV	ZERO, 288(T0)
+	MOV	ZERO, 296(T0)
+	MOV	ZERO, 304(T0)
+	MOV	ZERO, 312(T0)
+	MOV	ZERO, 320(T0)
+	MOV	ZERO, 328(T0)
+	MOV	ZERO, 336(T0)
+	MOV	ZERO, 344(T0)
+	MOV	ZERO, 352(T0)
+	MOV	ZERO, 360(T0)
+	MOV	ZERO, 368(T0)
+	MOV	ZERO, 376(T0)
+	MOV	ZERO, 384(T0)
+	MOV	ZERO, 392(T0)
+	MOV	ZERO, 400(T0)
+	MOV	ZERO, 408(T0)
+	MOV	ZERO, 416(T0)
+	MOV	ZERO, 424(T0)
+	MOV	ZERO, 432(T0)
+	MOV	ZERO, 440(T0)
+	MOV	ZERO, 448(T0)
+	MOV	ZERO, 456(T0)
+	MOV	ZERO, 464(T0)
+	MOV	ZERO, 472(T0)
+	MOV	ZERO, 480(T0)
+	MOV	ZERO, 488(T0)
+	MOV	ZERO, 496(T0)
+	MOV	ZERO, 504(T0)
+	MOV	ZERO, 512(T0)
+	MOV	ZERO, 520(T0)
+	MOV	ZERO, 528(T0)
+	MOV	ZERO, 536(T0)
+	MOV	ZERO, 544(T0)
+	MOV	ZERO, 552(T0)
+	MOV	ZERO, 560(T0)
+	MOV	ZERO, 568(T0)
+	MOV	ZERO, 576(T0)
+	MOV	ZERO, 584(T0)
+	MOV	ZERO, 592(T0)
+	MOV	ZERO, 600(T0)
+	MOV	ZERO, 608(T0)
+	MOV	ZERO, 616(T0)
+	MOV	ZERO, 624(T0)
+	MOV	ZERO, 632(T0)
+	MOV	ZERO, 640(T0)
+	MOV	ZERO, 648(T0)
+	MOV	ZERO, 656(T0)
+	MOV	ZERO, 664(T0)
+	MOV	ZERO, 672(T0)
+	MOV	ZERO, 680(T0)
+	MOV	ZERO, 688(T0)
+	MOV	ZERO, 696(T0)
+	MOV	ZERO, 704(T0)
+	MOV	ZERO, 712(T0)
+	MOV	ZERO, 720(T0)
+	MOV	ZERO, 728(T0)
+	MOV	ZERO, 736(T0)
+	MOV	ZERO, 744(T0)
+	MOV	ZERO, 752(T0)
+	MOV	ZERO, 760(T0)
+	MOV	ZERO, 768(T0)
+	MOV	ZERO, 776(T0)
+	MOV	ZERO, 784(T0)
+	MOV	ZERO, 792(T0)
+	MOV	ZERO, 800(T0)
+	MOV	ZERO, 808(T0)
+	MOV	ZERO, 816(T0)
+	MOV	ZERO, 824(T0)
+	MOV	ZERO, 832(T0)
+	MOV	ZERO, 840(T0)
+	MOV	ZERO, 848(T0)
+	MOV	ZERO, 856(T0)
+	MOV	ZERO, 864(T0)
+	MOV	ZERO, 872(T0)
+	MOV	ZERO, 880(T0)
+	MOV	ZERO, 888(T0)
+	MOV	ZERO, 896(T0)
+	MOV	ZERO, 904(T0)
+	MOV	ZERO, 912(T0)
+	MOV	ZERO, 920(T0)
+	MOV	ZERO, 928(T0)
+	MOV	ZERO, 936(T0)
+	MOV	ZERO, 944(T0)
+	MOV	ZERO, 952(T0)
+	MOV	ZERO, 960(T0)
+	MOV	ZERO, 968(T0)
+	MOV	ZERO, 976(T0)
+	MOV	ZERO, 984(T0)
+	MOV	ZERO, 992(T0)
+	MOV	ZERO, 1000(T0)
+	MOV	ZERO, 1008(T0)
+	MOV	ZERO, 1016(T0)
+	RET
+
+TEXT runtime·duffcopy(SB), NOSPLIT, $0-0
+	MOV	0(T1), T2
+	MOV	T2, 0(T0)
+
+	MOV	8(T1), T2
+	MOV	T2, 8(T0)
+
+	MOV	16(T1), T2
+	MOV	T2, 16(T0)
+
+	MOV	24(T1), T2
+	MOV	T2, 24(T0)
+
+	MOV	32(T1), T2
+	MOV	T2, 32(T0)
+
+	MOV	40(T1), T2
+	MOV	T2, 40(T0)
+
+	MOV	48(T1), T2
+	MOV	T2, 48(T0)
+
+	MOV	56(T1), T2
+	MOV	T2, 56(T0)
+
+	MOV	64(T1), T2
+	MOV	T2, 64(T0)
+
+	MOV	72(T1), T2
+	MOV	T2, 72(T0)
+
+	MOV	80(T1), T2
+	MOV	T2, 80(T0)
+
+	MOV	88(T1), T2
+	MOV	T2, 88(T0)
+
+	MOV	96(T1), T2
+	MOV	T2, 96(T0)
+
+	MOV	104(T1), T2
+	MOV	T2, 104(T0)
+
+	MOV	112(T1), T2
+	MOV	T2, 112(T0)
+
+	MOV	120(T1), T2
+	MOV	T2, 120(T0)
+
+	MOV	128(T1), T2
+	MOV	T2, 128(T0)
+
+	MOV	136(T1), T2
+	MOV	T2, 136(T0)
+
+	MOV	144(T1), T2
+	MOV	T2, 144(T0)
+
+	MOV	152(T1), T2
+	MOV	T2, 152(T0)
+
+	MOV	160(T1), T2
+	MOV	T2, 160(T0)
+
+	MOV	168(T1), T2
+	MOV	T2, 168(T0)
+
+	MOV	176(T1), T2
+	MOV	T2, 176(T0)
+
+	MOV	184(T1), T2
+	MOV	T2, 184(T0)
+
+	MOV	192(T1), T2
+	MOV	T2, 192(T0)
+
+	MOV	200(T1), T2
+	MOV	T2, 200(T0)
+
+	MOV	208(T1), T2
+	MOV	T2, 208(T0)
+
+	MOV	216(T1), T2
+	MOV	T2, 216(T0)
+
+	MOV	224(T1), T2
+	MOV	T2, 224(T0)
+
+	MOV	232(T1), T2
+	MOV	T2, 232(T0)
+
+	MOV	240(T1), T2
+	MOV	T2, 240(T0)
+
+	MOV	248(T1), T2
+	MOV	T2, 248(T0)
+
+	MOV	256(T1), T2
+	MOV	T2, 256(T0)
+
+	MOV	264(T1), T2
+	MOV	T2, 264(T0)
+
+	MOV	272(T1), T2
+	MOV	T2, 272(T0)
+
+	MOV	280(T1), T2
+	MOV	T2, 280(T0)
+
+	MOV	288(T1), T2
+	MOV	T2, 288(T0)
+
+	MOV	296(T1), T2
+	MOV	T2, 296(T0)
+
+	MOV	304(T1), T2
+	MOV	T2, 304(T0)
+
+	MOV	312(T1), T2
+	MOV	T2, 312(T0)
+
+	MOV	320(T1), T2
+	MOV	T2, 320(T0)
+
+	MOV	328(T1), T2
+	MOV	T2, 328(T0)
+
+	MOV	336(T1), T2
+	MOV	T2, 336(T0)
+
+	MOV	344(T1), T2
+	MOV	T2, 344(T0)
+
+	MOV	352(T1), T2
+	MOV	T2, 352(T0)
+
+	MOV	360(T1), T2
+	MOV	T2, 360(T0)
+
+	MOV	368(T1), T2
+	MOV	T2, 368(T0)
+
+	MOV	376(T1), T2
+	MOV	T2, 376(T0)
+
+	MOV	384(T1), T2
+	MOV	T2, 384(T0)
+
+	MOV	392(T1), T2
+	MOV	T2, 392(T0)
+
+	MOV	400(T1), T2
+	MOV	T2, 400(T0)
+
+	MOV	408(T1), T2
+	MOV	T2, 408(T0)
+
+	MOV	416(T1), T2
+	MOV	T2, 416(T0)
+
+	MOV	424(T1), T2
+	MOV	T2, 424(T0)
+
+	MOV	432(T1), T2
+	MOV	T2, 432(T0)
+
+	MOV	440(T1), T2
+	MOV	T2, 440(T0)
+
+	MOV	448(T1), T2
+	MOV	T2, 448(T0)
+
+	MOV	456(T1), T2
+	MOV	T2, 456(T0)
+
+	MOV	464(T1), T2
+	MOV	T2, 464(T0)
+
+	MOV	472(T1), T2
+	MOV	T2, 472(T0)
+
+	MOV	480(T1), T2
+	MOV	T2, 480(T0)
+
+	MOV	488(T1), T2
+	MOV	T2, 488(T0)
+
+	MOV	496(T1), T2
+	MOV	T2, 496(T0)
+
+	MOV	504(T1), T2
+	MOV	T2, 504(T0)
+
+	MOV	512(T1), T2
+	MOV	T2, 512(T0)
+
+	MOV	520(T1), T2
+	MOV	T2, 520(T0)
+
+	MOV	528(T1), T2
+	MOV	T2, 528(T0)
+
+	MOV	536(T1), T2
+	MOV	T2, 536(T0)
+
+	MOV	544(T1), T2
+	MOV	T2, 544(T0)
+
+	MOV	552(T1), T2
+	MOV	T2, 552(T0)
+
+	MOV	560(T1), T2
+	MOV	T2, 560(T0)
+
+	MOV	568(T1), T2
+	MOV	T2, 568(T0)
+
+	MOV	576(T1), T2
+	MOV	T2, 576(T0)
+
+	MOV	584(T1), T2
+	MOV	T2, 584(T0)
+
+	MOV	592(T1), T2
+	MOV	T2, 592(T0)
+
+	MOV	600(T1), T2
+	MOV	T2, 600(T0)
+
+	MOV	608(T1), T2
+	MOV	T2, 608(T0)
+
+	MOV	616(T1), T2
+	MOV	T2, 616(T0)
+
+	MOV	624(T1), T2
+	MOV	T2, 624(T0)
+
+	MOV	632(T1), T2
+	MOV	T2, 632(T0)
+
+	MOV	640(T1), T2
+	MOV	T2, 640(T0)
+
+	MOV	648(T1), T2
+	MOV	T2, 648(T0)
+
+	MOV	656(T1), T2
+	MOV	T2, 656(T0)
+
+	MOV	664(T1), T2
+	MOV	T2, 664(T0)
+
+	MOV	672(T1), T2
+	MOV	T2, 672(T0)
+
+	MOV	680(T1), T2
+	MOV	T2, 680(T0)
+
+	MOV	688(T1), T2
+	MOV	T2, 688(T0)
+
+	MOV	696(T1), T2
+	MOV	T2, 696(T0)
+
+	MOV	704(T1), T2
+	MOV	T2, 704(T0)
+
+	MOV	712(T1), T2
+	MOV	T2, 712(T0)
+
+	MOV	720(T1), T2
+	MOV	T2, 720(T0)
+
+	MOV	728(T1), T2
+	MOV	T2, 728(T0)
+
+	MOV	736(T1), T2
+	MOV	T2, 736(T0)
+
+	MOV	744(T1), T2
+	MOV	T2, 744(T0)
+
+	MOV	752(T1), T2
+	MOV	T2, 752(T0)
+
+	MOV	760(T1), T2
+	MOV	T2, 760(T0)
+
+	MOV	768(T1), T2
+	MOV	T2, 768(T0)
+
+	MOV	776(T1), T2
+	MOV	T2, 776(T0)
+
+	MOV	784(T1), T2
+	MOV	T2, 784(T0)
+
+	MOV	792(T1), T2
+	MOV	T2, 792(T0)
+
+	MOV	800(T1), T2
+	MOV	T2, 800(T0)
+
+	MOV	808(T1), T2
+	MOV	T2, 808(T0)
+
+	MOV	816(T1), T2
+	MOV	T2, 816(T0)
+
+	MOV	824(T1), T2
+	MOV	T2, 824(T0)
+
+	MOV	832(T1), T2
+	MOV	T2, 832(T0)
+
+	MOV	840(T1), T2
+	MOV	T2, 840(T0)
+
+	MOV	848(T1), T2
+	MOV	T2, 848(T0)
+
+	MOV	856(T1), T2
+	MOV	T2, 856(T0)
+
+	MOV	864(T1), T2
+	MOV	T2, 864(T0)
+
+	MOV	872(T1), T2
+	MOV	T2, 872(T0)
+
+	MOV	880(T1), T2
+	MOV	T2, 880(T0)
+
+	MOV	888(T1), T2
+	MOV	T2, 888(T0)
+
+	MOV	896(T1), T2
+	MOV	T2, 896(T0)
+
+	MOV	904(T1), T2
+	MOV	T2, 904(T0)
+
+	MOV	912(T1), T2
+	MOV	T2, 912(T0)
+
+	MOV	920(T1), T2
+	MOV	T2, 920(T0)
+
+	MOV	928(T1), T2
+	MOV	T2, 928(T0)
+
+	MOV	936(T1), T2
+	MOV	T2, 936(T0)
+
+	MOV	944(T1), T2
+	MOV	T2, 944(T0)
+
+	MOV	952(T1), T2
+	MOV	T2, 952(T0)
+
+	MOV	960(T1), T2
+	MOV	T2, 960(T0)
+
+	MOV	968(T1), T2
+	MOV	T2, 968(T0)
+
+	MOV	976(T1), T2
+	MOV	T2, 976(T0)
+
+	MOV	984(T1), T2
+	MOV	T2, 984(T0)
+
+	MOV	992(T1), T2
+	MOV	T2, 992(T0)
+
+	MOV	1000(T1), T2
+	MOV	T2, 1000(T0)
+
+	MOV	1008(T1), T2
+	MOV	T2, 1008(T0)
+
+	MOV	1016(T1), T2
+	MOV	T2, 1016(T0)
+
+	RET
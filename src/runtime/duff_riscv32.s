@@ -0,0 +1,525 @@
+// AUTO-GENERATED by mkduff.go
+// Run go generate from src/runtime to update.
+// See mkduff.go for comments.
+
+// +build riscv32
+
+#include "textflag.h"
+
+TEXT runtime·duffzero(SB), NOSPLIT, $0-0
+	MOVW	ZERO, 0(T0)
+	MOVW	ZERO, 4(T0)
+	MOVW	ZERO, 8(T0)
+	MOVW	ZERO, 12(T0)
+	MOVW	ZERO, 16(T0)
+	MOVW	ZERO, 20(T0)
+	MOVW	ZERO, 24(T0)
+	MOVW	ZERO, 28(T0)
+	MOVW	ZERO, 32(T0)
+	MOVW	ZERO, 36(T0)
+	MOVW	ZERO, 40(T0)
+	MOVW	ZERO, 44(T0)
+	MOVW	ZERO, 48(T0)
+	MOVW	ZERO, 52(T0)
+	MOVW	ZERO, 56(T0)
+	MOVW	ZERO, 60(T0)
+	MOVW	ZERO, 64(T0)
+	MOVW	ZERO, 68(T0)
+	MOVW	ZERO, 72(T0)
+	MOVW	ZERO, 76(T0)
+	MOVW	ZERO, 80(T0)
+	MOVW	ZERO, 84(T0)
+	MOVW	ZERO, 88(T0)
+	MOVW	ZERO, 92(T0)
+	MOVW	ZERO, 96(T0)
+	MOVW	ZERO, 100(T0)
+	MOVW	ZERO, 104(T0)
+	MOVW	ZERO, 108(T0)
+	MOVW	ZERO, 112(T0)
+	MOVW	ZERO, 116(T0)
+	MOVW	ZERO, 120(T0)
+	MOVW	ZERO, 124(T0)
+	MOVW	ZERO, 128(T0)
+	MOVW	ZERO, 132(T0)
+	MOVW	ZERO, 136(T0)
+	MOVW	ZERO, 140(T0)
+	MOVW	ZERO, 144(T0)
+	MOVW	ZERO, 148(T0)
+	MOVW	ZERO, 152(T0)
+	MOVW	ZERO, 156(T0)
+	MOVW	ZERO, 160(T0)
+	MOVW	ZERO, 164(T0)
+	MOVW	ZERO, 168(T0)
+	MOVW	ZERO, 172(T0)
+	MOVW	ZERO, 176(T0)
+	MOVW	ZERO, 180(T0)
+	MOVW	ZERO, 184(T0)
+	MOVW	ZERO, 188(T0)
+	MOVW	ZERO, 192(T0)
+	MOVW	ZERO, 196(T0)
+	MOVW	ZERO, 200(T0)
+	MOVW	ZERO, 204(T0)
+	MOVW	ZERO, 208(T0)
+	MOVW	ZERO, 212(T0)
+	MOVW	ZERO, 216(T0)
+	MOVW	ZERO, 220(T0)
+	MOVW	ZERO, 224(T0)
+	MOVW	ZERO, 228(T0)
+	MOVW	ZERO, 232(T0)
+	MOVW	ZERO, 236(T0)
+	MOVW	ZERO, 240(T0)
+	MOVW	ZERO, 244(T0)
+	MOVW	ZERO, 248(T0)
+	MOVW	ZERO, 252(T0)
+	MOVW	ZERO, 256(T0)
+	MOVW	ZERO, 260(T0)
+	MOVW	ZERO, 264(T0)
+	MOVW	ZERO, 268(T0)
+	MOVW	ZERO, 272(T0)
+	MOVW	ZERO, 276(T0)
+	MOVW	ZERO, 280(T0)
+	MOVW	ZERO, 284(T0)
+	MOVW	ZERO, 288(T0)
+	MOVW	ZERO, 292(T0)
+	MOVW	ZERO, 296(T0)
+	MOVW	ZERO, 300(T0)
+	MOVW	ZERO, 304(T0)
+	MOVW	ZERO, 308(T0)
+	MOVW	ZERO, 312(T0)
+	MOVW	ZERO, 316(T0)
+	MOVW	ZERO, 320(T0)
+	MOVW	ZERO, 324(T0)
+	MOVW	ZERO, 328(T0)
+	MOVW	ZERO, 332(T0)
+	MOVW	ZERO, 336(T0)
+	MOVW	ZERO, 340(T0)
+	MOVW	ZERO, 344(T0)
+	MOVW	ZERO, 348(T0)
+	MOVW	ZERO, 352(T0)
+	MOVW	ZERO, 356(T0)
+	MOVW	ZERO, 360(T0)
+	MOVW	ZERO, 364(T0)
+	MOVW	ZERO, 368(T0)
+	MOVW	ZERO, 372(T0)
+	MOVW	ZERO, 376(T0)
+	MOVW	ZERO, 380(T0)
+	MOVW	ZERO, 384(T0)
+	MOVW	ZERO, 388(T0)
+	MOVW	ZERO, 392(T0)
+	MOVW	ZERO, 396(T0)
+	MOVW	ZERO, 400(T0)
+	MOVW	ZERO, 404(T0)
+	MOVW	ZERO, 408(T0)
+	MOVW	ZERO, 412(T0)
+	MOVW	ZERO, 416(T0)
+	MOVW	ZERO, 420(T0)
+	MOVW	ZERO, 424(T0)
+	MOVW	ZERO, 428(T0)
+	MOVW	ZERO, 432(T0)
+	MOVW	ZERO, 436(T0)
+	MOVW	ZERO, 440(T0)
+	MOVW	ZERO, 444(T0)
+	MOVW	ZERO, 448(T0)
+	MOVW	ZERO, 452(T0)
+	MOVW	ZERO, 456(T0)
+	MOVW	ZERO, 460(T0)
+	MOVW	ZERO, 464(T0)
+	MOVW	ZERO, 468(T0)
+	MOVW	ZERO, 472(T0)
+	MOVW	ZERO, 476(T0)
+	MOVW	ZERO, 480(T0)
+	MOVW	ZERO, 484(T0)
+	MOVW	ZERO, 488(T0)
+	MOVW	ZERO, 492(T0)
+	MOVW	ZERO, 496(T0)
+	MOVW	ZERO, 500(T0)
+	MOVW	ZERO, 504(T0)
+	MOVW	ZERO, 508(T0)
+	RET
+
+TEXT runtime·duffcopy(SB), NOSPLIT, $0-0
+	MOVW	0(T1), T2
+	MOVW	T2, 0(T0)
+
+	MOVW	4(T1), T2
+	MOVW	T2, 4(T0)
+
+	MOVW	8(T1), T2
+	MOVW	T2, 8(T0)
+
+	MOVW	12(T1), T2
+	MOVW	T2, 12(T0)
+
+	MOVW	16(T1), T2
+	MOVW	T2, 16(T0)
+
+	MOVW	20(T1), T2
+	MOVW	T2, 20(T0)
+
+	MOVW	24(T1), T2
+	MOVW	T2, 24(T0)
+
+	MOVW	28(T1), T2
+	MOVW	T2, 28(T0)
+
+	MOVW	32(T1), T2
+	MOVW	T2, 32(T0)
+
+	MOVW	36(T1), T2
+	MOVW	T2, 36(T0)
+
+	MOVW	40(T1), T2
+	MOVW	T2, 40(T0)
+
+	MOVW	44(T1), T2
+	MOVW	T2, 44(T0)
+
+	MOVW	48(T1), T2
+	MOVW	T2, 48(T0)
+
+	MOVW	52(T1), T2
+	MOVW	T2, 52(T0)
+
+	MOVW	56(T1), T2
+	MOVW	T2, 56(T0)
+
+	MOVW	60(T1), T2
+	MOVW	T2, 60(T0)
+
+	MOVW	64(T1), T2
+	MOVW	T2, 64(T0)
+
+	MOVW	68(T1), T2
+	MOVW	T2, 68(T0)
+
+	MOVW	72(T1), T2
+	MOVW	T2, 72(T0)
+
+	MOVW	76(T1), T2
+	MOVW	T2, 76(T0)
+
+	MOVW	80(T1), T2
+	MOVW	T2, 80(T0)
+
+	MOVW	84(T1), T2
+	MOVW	T2, 84(T0)
+
+	MOVW	88(T1), T2
+	MOVW	T2, 88(T0)
+
+	MOVW	92(T1), T2
+	MOVW	T2, 92(T0)
+
+	MOVW	96(T1), T2
+	MOVW	T2, 96(T0)
+
+	MOVW	100(T1), T2
+	MOVW	T2, 100(T0)
+
+	MOVW	104(T1), T2
+	MOVW	T2, 104(T0)
+
+	MOVW	108(T1), T2
+	MOVW	T2, 108(T0)
+
+	MOVW	112(T1), T2
+	MOVW	T2, 112(T0)
+
+	MOVW	116(T1), T2
+	MOVW	T2, 116(T0)
+
+	MOVW	120(T1), T2
+	MOVW	T2, 120(T0)
+
+	MOVW	124(T1), T2
+	MOVW	T2, 124(T0)
+
+	MOVW	128(T1), T2
+	MOVW	T2, 128(T0)
+
+	MOVW	132(T1), T2
+	MOVW	T2, 132(T0)
+
+	MOVW	136(T1), T2
+	MOVW	T2, 136(T0)
+
+	MOVW	140(T1), T2
+	MOVW	T2, 140(T0)
+
+	MOVW	144(T1), T2
+	MOVW	T2, 144(T0)
+
+	MOVW	148(T1), T2
+	MOVW	T2, 148(T0)
+
+	MOVW	152(T1), T2
+	MOVW	T2, 152(T0)
+
+	MOVW	156(T1), T2
+	MOVW	T2, 156(T0)
+
+	MOVW	160(T1), T2
+	MOVW	T2, 160(T0)
+
+	MOVW	164(T1), T2
+	MOVW	T2, 164(T0)
+
+	MOVW	168(T1), T2
+	MOVW	T2, 168(T0)
+
+	MOVW	172(T1), T2
+	MOVW	T2, 172(T0)
+
+	MOVW	176(T1), T2
+	MOVW	T2, 176(T0)
+
+	MOVW	180(T1), T2
+	MOVW	T2, 180(T0)
+
+	MOVW	184(T1), T2
+	MOVW	T2, 184(T0)
+
+	MOVW	188(T1), T2
+	MOVW	T2, 188(T0)
+
+	MOVW	192(T1), T2
+	MOVW	T2, 192(T0)
+
+	MOVW	196(T1), T2
+	MOVW	T2, 196(T0)
+
+	MOVW	200(T1), T2
+	MOVW	T2, 200(T0)
+
+	MOVW	204(T1), T2
+	MOVW	T2, 204(T0)
+
+	MOVW	208(T1), T2
+	MOVW	T2, 208(T0)
+
+	MOVW	212(T1), T2
+	MOVW	T2, 212(T0)
+
+	MOVW	216(T1), T2
+	MOVW	T2, 216(T0)
+
+	MOVW	220(T1), T2
+	MOVW	T2, 220(T0)
+
+	MOVW	224(T1), T2
+	MOVW	T2, 224(T0)
+
+	MOVW	228(T1), T2
+	MOVW	T2, 228(T0)
+
+	MOVW	232(T1), T2
+	MOVW	T2, 232(T0)
+
+	MOVW	236(T1), T2
+	MOVW	T2, 236(T0)
+
+	MOVW	240(T1), T2
+	MOVW	T2, 240(T0)
+
+	MOVW	244(T1), T2
+	MOVW	T2, 244(T0)
+
+	MOVW	248(T1), T2
+	MOVW	T2, 248(T0)
+
+	MOVW	252(T1), T2
+	MOVW	T2, 252(T0)
+
+	MOVW	256(T1), T2
+	MOVW	T2, 256(T0)
+
+	MOVW	260(T1), T2
+	MOVW	T2, 260(T0)
+
+	MOVW	264(T1), T2
+	MOVW	T2, 264(T0)
+
+	MOVW	268(T1), T2
+	MOVW	T2, 268(T0)
+
+	MOVW	272(T1), T2
+	MOVW	T2, 272(T0)
+
+	MOVW	276(T1), T2
+	MOVW	T2, 276(T0)
+
+	MOVW	280(T1), T2
+	MOVW	T2, 280(T0)
+
+	MOVW	284(T1), T2
+	MOVW	T2, 284(T0)
+
+	MOVW	288(T1), T2
+	MOVW	T2, 288(T0)
+
+	MOVW	292(T1), T2
+	MOVW	T2, 292(T0)
+
+	MOVW	296(T1), T2
+	MOVW	T2, 296(T0)
+
+	MOVW	300(T1), T2
+	MOVW	T2, 300(T0)
+
+	MOVW	304(T1), T2
+	MOVW	T2, 304(T0)
+
+	MOVW	308(T1), T2
+	MOVW	T2, 308(T0)
+
+	MOVW	312(T1), T2
+	MOVW	T2, 312(T0)
+
+	MOVW	316(T1), T2
+	MOVW	T2, 316(T0)
+
+	MOVW	320(T1), T2
+	MOVW	T2, 320(T0)
+
+	MOVW	324(T1), T2
+	MOVW	T2, 324(T0)
+
+	MOVW	328(T1), T2
+	MOVW	T2, 328(T0)
+
+	MOVW	332(T1), T2
+	MOVW	T2, 332(T0)
+
+	MOVW	336(T1), T2
+	MOVW	T2, 336(T0)
+
+	MOVW	340(T1), T2
+	MOVW	T2, 340(T0)
+
+	MOVW	344(T1), T2
+	MOVW	T2, 344(T0)
+
+	MOVW	348(T1), T2
+	MOVW	T2, 348(T0)
+
+	MOVW	352(T1), T2
+	MOVW	T2, 352(T0)
+
+	MOVW	356(T1), T2
+	MOVW	T2, 356(T0)
+
+	MOVW	360(T1), T2
+	MOVW	T2, 360(T0)
+
+	MOVW	364(T1), T2
+	MOVW	T2, 364(T0)
+
+	MOVW	368(T1), T2
+	MOVW	T2, 368(T0)
+
+	MOVW	372(T1), T2
+	MOVW	T2, 372(T0)
+
+	MOVW	376(T1), T2
+	MOVW	T2, 376(T0)
+
+	MOVW	380(T1), T2
+	MOVW	T2, 380(T0)
+
+	MOVW	384(T1), T2
+	MOVW	T2, 384(T0)
+
+	MOVW	388(T1), T2
+	MOVW	T2, 388(T0)
+
+	MOVW	392(T1), T2
+	MOVW	T2, 392(T0)
+
+	MOVW	396(T1), T2
+	MOVW	T2, 396(T0)
+
+	MOVW	400(T1), T2
+	MOVW	T2, 400(T0)
+
+	MOVW	404(T1), T2
+	MOVW	T2, 404(T0)
+
+	MOVW	408(T1), T2
+	MOVW	T2, 408(T0)
+
+	MOVW	412(T1), T2
+	MOVW	T2, 412(T0)
+
+	MOVW	416(T1), T2
+	MOVW	T2, 416(T0)
+
+	MOVW	420(T1), T2
+	MOVW	T2, 420(T0)
+
+	MOVW	424(T1), T2
+	MOVW	T2, 424(T0)
+
+	MOVW	428(T1), T2
+	MOVW	T2, 428(T0)
+
+	MOVW	432(T1), T2
+	MOVW	T2, 432(T0)
+
+	MOVW	436(T1), T2
+	MOVW	T2, 436(T0)
+
+	MOVW	440(T1), T2
+	MOVW	T2, 440(T0)
+
+	MOVW	444(T1), T2
+	MOVW	T2, 444(T0)
+
+	MOVW	448(T1), T2
+	MOVW	T2, 448(T0)
+
+	MOVW	452(T1), T2
+	MOVW	T2, 452(T0)
+
+	MOVW	456(T1), T2
+	MOVW	T2, 456(T0)
+
+	MOVW	460(T1), T2
+	MOVW	T2, 460(T0)
+
+	MOVW	464(T1), T2
+	MOVW	T2, 464(T0)
+
+	MOVW	468(T1), T2
+	MOVW	T2, 468(T0)
+
+	MOVW	472(T1), T2
+	MOVW	T2, 472(T0)
+
+	MOVW	476(T1), T2
+	MOVW	T2, 476(T0)
+
+	MOVW	480(T1), T2
+	MOVW	T2, 480(T0)
+
+	MOVW	484(T1), T2
+	MOVW	T2, 484(T0)
+
+	MOVW	488(T1), T2
+	MOVW	T2, 488(T0)
+
+	MOVW	492(T1), T2
+	MOVW	T2, 492(T0)
+
+	MOVW	496(T1), T2
+	MOVW	T2, 496(T0)
+
+	MOVW	500(T1), T2
+	MOVW	T2, 500(T0)
+
+	MOVW	504(T1), T2
+	MOVW	T2, 504(T0)
+
+	MOVW	508(T1), T2
+	MOVW	T2, 508(T0)
+
+	RET
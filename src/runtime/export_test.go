@@ -245,3 +245,42 @@ func CountPagesInUse() (pagesInUse, counted uintptr) {
 
 	return
 }
+
+func RISCVCPUInfoISA(cpuinfo string) string {
+	return string(riscvCPUInfoISA([]byte(cpuinfo)))
+}
+
+// RISCVHWCapISA returns the extensions that riscvHWCapISA finds in
+// hwcap, as a comma-separated list.
+func RISCVHWCapISA(hwcap uintptr) string {
+	return riscvISAString(riscvHWCapISA(hwcap))
+}
+
+// ParseRISCVISA returns the extensions that parseRISCVISA finds in
+// isa, as a comma-separated list.
+func ParseRISCVISA(isa string, letters bool) string {
+	return riscvISAString(parseRISCVISA([]byte(isa), letters))
+}
+
+func riscvISAString(e riscvISA) string {
+	var exts string
+	for _, x := range []struct {
+		name string
+		has  bool
+	}{
+		{"c", e.c},
+		{"zba", e.zba},
+		{"zbb", e.zbb},
+		{"zbs", e.zbs},
+		{"zknh", e.zknh},
+	} {
+		if !x.has {
+			continue
+		}
+		if exts != "" {
+			exts += ","
+		}
+		exts += x.name
+	}
+	return exts
+}
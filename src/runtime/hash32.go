@@ -6,7 +6,7 @@
 //   xxhash: https://code.google.com/p/xxhash/
 // cityhash: https://code.google.com/p/cityhash/
 
-// +build 386 arm mips mipsle
+// +build 386 arm mips mipsle riscv32
 
 package runtime
 
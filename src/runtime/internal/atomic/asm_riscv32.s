@@ -0,0 +1,44 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build riscv32
+
+#include "textflag.h"
+
+TEXT ·Cas(SB), NOSPLIT, $0-13
+	MOV	ptr+0(FP), A0
+	MOVW	old+4(FP), A1
+	MOVW	new+8(FP), A2
+cas:
+	LRW	(A0), T0
+	BNE	T0, A1, fail
+	SCW	A2, (A0), T1
+	BNE	T1, ZERO, cas
+	MOV	$1, A0
+	MOVB	A0, ret+12(FP)
+	RET
+fail:
+	MOVB	ZERO, ret+12(FP)
+	RET
+
+TEXT ·Casp1(SB), NOSPLIT, $0-13
+	JMP	·Cas(SB)
+
+TEXT ·Casuintptr(SB),NOSPLIT,$0-13
+	JMP	·Cas(SB)
+
+TEXT ·Storeuintptr(SB),NOSPLIT,$0-8
+	JMP	·Store(SB)
+
+TEXT ·Loaduintptr(SB),NOSPLIT,$0-8
+	JMP	·Load(SB)
+
+TEXT ·Loaduint(SB),NOSPLIT,$0-8
+	JMP	·Load(SB)
+
+TEXT ·Loadint64(SB),NOSPLIT,$0-12
+	JMP	·Load64(SB)
+
+TEXT ·Xaddint64(SB),NOSPLIT,$0-20
+	JMP	·Xadd64(SB)
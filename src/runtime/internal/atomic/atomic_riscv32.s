@@ -0,0 +1,95 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+// func Load(ptr *uint32) uint32
+TEXT ·Load(SB),NOSPLIT,$-4-8
+	MOV	ptr+0(FP), A0
+	LRW	(A0), A0
+	MOVW	A0, ret+4(FP)
+	RET
+
+// func Loadp(ptr unsafe.Pointer) unsafe.Pointer
+TEXT ·Loadp(SB),NOSPLIT,$0-8
+	JMP	·Load(SB)
+
+// func StorepNoWB(ptr unsafe.Pointer, val unsafe.Pointer)
+TEXT ·StorepNoWB(SB), NOSPLIT, $0-8
+	JMP	·Store(SB)
+
+// func Store(ptr *uint32, val uint32)
+TEXT ·Store(SB), NOSPLIT, $0-8
+	MOV	ptr+0(FP), A0
+	MOVW	val+4(FP), A1
+	AMOSWAPW	A1, (A0), ZERO
+	RET
+
+// func Xchg(ptr *uint32, new uint32) uint32
+TEXT ·Xchg(SB), NOSPLIT, $0-12
+	MOV	ptr+0(FP), A0
+	MOVW	new+4(FP), A1
+	AMOSWAPW	A1, (A0), A1
+	MOVW	A1, ret+8(FP)
+	RET
+
+// Atomically:
+//      *val += delta;
+//      return *val;
+
+// func Xadd(ptr *uint32, delta int32) uint32
+TEXT ·Xadd(SB), NOSPLIT, $0-12
+	MOV	ptr+0(FP), A0
+	MOVW	delta+4(FP), A1
+	AMOADDW	A1, (A0), A2
+	ADD	A2, A1, A0
+	MOVW	A0, ret+8(FP)
+	RET
+
+// func Xadduintptr(ptr *uintptr, delta uintptr) uintptr
+TEXT ·Xadduintptr(SB), NOSPLIT, $0-12
+	JMP	·Xadd(SB)
+
+// func Xchguintptr(ptr *uintptr, new uintptr) uintptr
+TEXT ·Xchguintptr(SB), NOSPLIT, $0-12
+	JMP	·Xchg(SB)
+
+// func And8(ptr *uint8, val uint8)
+TEXT ·And8(SB), NOSPLIT, $0-5
+	MOV	ptr+0(FP), A0
+	MOVBU	val+4(FP), A1
+	AND	$3, A0, A2
+	AND	$-4, A0
+	SLL	$3, A2
+	XOR	$255, A1
+	SLL	A2, A1
+	XOR	$-1, A1
+	AMOANDW	A1, (A0), ZERO
+	RET
+
+// func Or8(ptr *uint8, val uint8)
+TEXT ·Or8(SB), NOSPLIT, $0-5
+	MOV	ptr+0(FP), A0
+	MOVBU	val+4(FP), A1
+	AND	$3, A0, A2
+	AND	$-4, A0
+	SLL	$3, A2
+	SLL	A2, A1
+	AMOORW	A1, (A0), ZERO
+	RET
+
+// func spinLock(state *uint32)
+TEXT ·spinLock(SB),NOSPLIT,$0-4
+	MOV	state+0(FP), A0
+	MOV	$1, A1
+again:
+	AMOSWAPW	A1, (A0), A2
+	BNE	A2, ZERO, again
+	RET
+
+// func spinUnlock(state *uint32)
+TEXT ·spinUnlock(SB),NOSPLIT,$0-4
+	MOV	state+0(FP), A0
+	AMOSWAPW	ZERO, (A0), ZERO
+	RET
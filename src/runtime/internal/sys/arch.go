@@ -15,5 +15,6 @@ const (
 	MIPS64
 	PPC64
 	RISCV
+	RISCV32
 	S390X
 )
@@ -9,7 +9,7 @@ const (
 	BigEndian     = 0
 	CacheLineSize = 64   // TODO(prattmic)
 	PhysPageSize  = 4096 // TODO(prattmic)
-	PCQuantum     = 4
+	PCQuantum     = 2    // compressed instructions
 	Int64Align    = 8
 	HugePageSize  = 1 << 21
 	MinFrameSize  = 8
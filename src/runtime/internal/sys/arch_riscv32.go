@@ -0,0 +1,18 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package sys
+
+const (
+	ArchFamily    = RISCV32
+	BigEndian     = 0
+	CacheLineSize = 64   // TODO(prattmic)
+	PhysPageSize  = 4096 // TODO(prattmic)
+	PCQuantum     = 2    // compressed instructions
+	Int64Align    = 4
+	HugePageSize  = 1 << 22
+	MinFrameSize  = 4
+)
+
+type Uintreg uint32
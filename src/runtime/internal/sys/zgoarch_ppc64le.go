@@ -21,6 +21,7 @@ const GoarchMips64p32 = 0
 const GoarchMips64p32le = 0
 const GoarchPpc = 0
 const GoarchRiscv = 0
+const GoarchRiscv32 = 0
 const GoarchS390 = 0
 const GoarchS390x = 0
 const GoarchSparc = 0
@@ -0,0 +1,63 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build riscv riscv32
+
+#include "textflag.h"
+#include "asm_riscvx.h"
+
+// void runtime·memclrNoHeapPointers(void*, uintptr)
+TEXT runtime·memclrNoHeapPointers(SB),NOSPLIT,$0-PTRSIZE_2
+	MOV	ptr+0(FP), A0
+	MOV	n+PTRSIZE(FP), A1
+	ADD	A0, A1, A2	// end
+
+	// If ptr is word-aligned, clear words straight away, whatever
+	// the size.
+	AND	$(PTRSIZE-1), A0, T0
+	BEQ	T0, ZERO, aligned
+
+	// If less than sixteen bytes, do one byte at a time.
+	SLTU	$16, A1, T0
+	BNE	T0, ZERO, bytes
+
+	// Do one byte at a time until word-aligned.
+	ADD	$(PTRSIZE-1), A0, T1
+	AND	$-PTRSIZE, T1
+align:
+	MOVB	ZERO, (A0)
+	ADD	$1, A0
+	BNE	A0, T1, align
+
+aligned:
+	// Do four words at a time as long as there is room.
+	ADD	$(1-4*PTRSIZE), A2, T1
+loop4:
+	BGEU	A0, T1, words
+	MOV	ZERO, 0(A0)
+	MOV	ZERO, PTRSIZE(A0)
+	MOV	ZERO, (2*PTRSIZE)(A0)
+	MOV	ZERO, (3*PTRSIZE)(A0)
+	ADD	$(4*PTRSIZE), A0
+	JMP	loop4
+
+	// Then a word at a time.
+words:
+	ADD	$(1-PTRSIZE), A2, T1
+loop1:
+	BGEU	A0, T1, bytes
+	MOV	ZERO, (A0)
+	ADD	$PTRSIZE, A0
+	JMP	loop1
+
+	// Finish off the remaining bytes.
+bytes:
+	BEQ	A0, A2, done
+byteloop:
+	MOVB	ZERO, (A0)
+	ADD	$1, A0
+	BNE	A0, A2, byteloop
+
+done:
+	RET
@@ -0,0 +1,276 @@
+// Copyright 2016 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build riscv riscv32
+
+#include "textflag.h"
+#include "asm_riscvx.h"
+
+// Misaligned accesses trap and are emulated on most RISC-V systems,
+// which is much slower than a byte at a time. So once to is aligned,
+// if from is not, each word stored is put together from two aligned
+// loads with shifts.
+
+// void runtime·memmove(void*, void*, uintptr)
+TEXT runtime·memmove(SB),NOSPLIT,$-0-PTRSIZE_3
+	MOV	to+0(FP), A0
+	MOV	from+PTRSIZE(FP), A1
+	MOV	n+(2*PTRSIZE)(FP), A2
+	BEQ	A0, A1, done
+	ADD	A1, A2, A3	// end of from
+
+	// If the destination is ahead of the source, start at the end of the
+	// buffer and go backward.
+	BLTU	A1, A0, b
+f:
+
+	// If both pointers are word-aligned, copy words straight away,
+	// whatever the size.
+	OR	A0, A1, T0
+	AND	$(PTRSIZE-1), T0
+	BEQ	T0, ZERO, f_copy
+
+	// If less than sixteen bytes, do one byte at a time.
+	SLTU	$16, A2, T0
+	BNE	T0, ZERO, f_bytes
+
+	// Do one byte at a time until to is word-aligned.
+	ADD	$(PTRSIZE-1), A0, T5
+	AND	$-PTRSIZE, T5
+	BEQ	A0, T5, f_aligned
+f_align:
+	MOVB	(A1), T1
+	MOVB	T1, (A0)
+	ADD	$1, A0
+	ADD	$1, A1
+	BNE	A0, T5, f_align
+
+f_aligned:
+	AND	$(PTRSIZE-1), A1, T0
+	BNE	T0, ZERO, f_shift
+
+	// Do four words at a time as long as there is room.
+f_copy:
+	ADD	$(1-4*PTRSIZE), A3, T5
+f_loop4:
+	BGEU	A1, T5, f_words
+	MOV	0(A1), T0
+	MOV	PTRSIZE(A1), T1
+	MOV	(2*PTRSIZE)(A1), T2
+	MOV	(3*PTRSIZE)(A1), T3
+	MOV	T0, 0(A0)
+	MOV	T1, PTRSIZE(A0)
+	MOV	T2, (2*PTRSIZE)(A0)
+	MOV	T3, (3*PTRSIZE)(A0)
+	ADD	$(4*PTRSIZE), A0
+	ADD	$(4*PTRSIZE), A1
+	JMP	f_loop4
+
+	// Then a word at a time.
+f_words:
+	ADD	$(1-PTRSIZE), A3, T5
+f_loop1:
+	BGEU	A1, T5, f_bytes
+	MOV	(A1), T0
+	MOV	T0, (A0)
+	ADD	$PTRSIZE, A0
+	ADD	$PTRSIZE, A1
+	JMP	f_loop1
+
+	// from is not aligned. Each word stored is the top of the
+	// previous aligned word of from and the bottom of the next.
+	// The loops step the aligned from, T3, which is always T0
+	// bytes behind from.
+f_shift:
+	SLL	$3, T0, T1	// right shift
+	SUB	T1, ZERO, T2	// left shift, mod the register width
+	AND	$-PTRSIZE, A1, T3	// aligned from
+	MOV	(T3), T4
+
+	// Four words at a time as long as there is room.
+	ADD	$(1-4*PTRSIZE), A3, T5
+	SUB	T0, T5
+	BGEU	T3, T5, f_shift1
+f_loop_shift4:
+	MOV	PTRSIZE(T3), A4
+	MOV	(2*PTRSIZE)(T3), A5
+	MOV	(3*PTRSIZE)(T3), A6
+	MOV	(4*PTRSIZE)(T3), A7
+	SRL	T1, T4
+	SLL	T2, A4, A2
+	OR	A2, T4
+	MOV	T4, 0(A0)
+	SRL	T1, A4
+	SLL	T2, A5, A2
+	OR	A2, A4
+	MOV	A4, PTRSIZE(A0)
+	SRL	T1, A5
+	SLL	T2, A6, A2
+	OR	A2, A5
+	MOV	A5, (2*PTRSIZE)(A0)
+	SRL	T1, A6
+	SLL	T2, A7, A2
+	OR	A2, A6
+	MOV	A6, (3*PTRSIZE)(A0)
+	MOV	A7, T4
+	ADD	$(4*PTRSIZE), A0
+	ADD	$(4*PTRSIZE), T3
+	BLTU	T3, T5, f_loop_shift4
+
+	// Then a word at a time.
+f_shift1:
+	ADD	$(1-PTRSIZE), A3, T5
+	SUB	T0, T5
+	BGEU	T3, T5, f_shift_done
+f_loop_shift:
+	MOV	PTRSIZE(T3), A4
+	SRL	T1, T4
+	SLL	T2, A4, A5
+	OR	A5, T4
+	MOV	T4, (A0)
+	MOV	A4, T4
+	ADD	$PTRSIZE, A0
+	ADD	$PTRSIZE, T3
+	BLTU	T3, T5, f_loop_shift
+f_shift_done:
+	ADD	T0, T3, A1
+
+	// Finish off the remaining bytes.
+f_bytes:
+	BEQ	A1, A3, done
+f_byteloop:
+	MOVB	(A1), T0
+	MOVB	T0, (A0)
+	ADD	$1, A0
+	ADD	$1, A1
+	BNE	A1, A3, f_byteloop
+	JMP	done
+
+b:
+	// Unless the buffers overlap, forward is just as good.
+	BGEU	A0, A3, f
+	ADD	A0, A2, A4	// end of to
+
+	// If both ends are word-aligned, copy words straight away,
+	// whatever the size.
+	OR	A3, A4, T0
+	AND	$(PTRSIZE-1), T0
+	BEQ	T0, ZERO, b_copy
+
+	// If less than sixteen bytes, do one byte at a time.
+	SLTU	$16, A2, T0
+	BNE	T0, ZERO, b_bytes
+
+	// Do one byte at a time until to+n is word-aligned.
+	AND	$-PTRSIZE, A4, T5
+	BEQ	A4, T5, b_aligned
+b_align:
+	ADD	$-1, A3
+	ADD	$-1, A4
+	MOVB	(A3), T1
+	MOVB	T1, (A4)
+	BNE	A4, T5, b_align
+
+b_aligned:
+	AND	$(PTRSIZE-1), A3, T0
+	BNE	T0, ZERO, b_shift
+
+	// Do four words at a time as long as there is room.
+b_copy:
+	ADD	$(4*PTRSIZE-1), A1, T5
+b_loop4:
+	BGEU	T5, A3, b_words
+	ADD	$-(4*PTRSIZE), A3
+	ADD	$-(4*PTRSIZE), A4
+	MOV	(3*PTRSIZE)(A3), T0
+	MOV	(2*PTRSIZE)(A3), T1
+	MOV	PTRSIZE(A3), T2
+	MOV	0(A3), T3
+	MOV	T0, (3*PTRSIZE)(A4)
+	MOV	T1, (2*PTRSIZE)(A4)
+	MOV	T2, PTRSIZE(A4)
+	MOV	T3, 0(A4)
+	JMP	b_loop4
+
+	// Then a word at a time.
+b_words:
+	ADD	$(PTRSIZE-1), A1, T5
+b_loop1:
+	BGEU	T5, A3, b_bytes
+	ADD	$-PTRSIZE, A3
+	ADD	$-PTRSIZE, A4
+	MOV	(A3), T0
+	MOV	T0, (A4)
+	JMP	b_loop1
+
+	// from+n is not aligned. Each word stored is the top of the
+	// previous aligned word of from and the bottom of the next.
+	// As above, T3 is always T0 bytes behind from+n. to is no longer
+	// needed, so A0 is scratch.
+b_shift:
+	SLL	$3, T0, T1	// right shift
+	SUB	T1, ZERO, T2	// left shift, mod the register width
+	AND	$-PTRSIZE, A3, T3	// aligned from+n
+	MOV	(T3), T4
+
+	// Four words at a time as long as there is room.
+	ADD	$(4*PTRSIZE-1), A1, T5
+	SUB	T0, T5
+	BGEU	T5, T3, b_shift1
+b_loop_shift4:
+	MOV	-PTRSIZE(T3), A5
+	MOV	-(2*PTRSIZE)(T3), A6
+	MOV	-(3*PTRSIZE)(T3), A7
+	MOV	-(4*PTRSIZE)(T3), A2
+	SLL	T2, T4
+	SRL	T1, A5, A0
+	OR	A0, T4
+	MOV	T4, -PTRSIZE(A4)
+	SLL	T2, A5
+	SRL	T1, A6, A0
+	OR	A0, A5
+	MOV	A5, -(2*PTRSIZE)(A4)
+	SLL	T2, A6
+	SRL	T1, A7, A0
+	OR	A0, A6
+	MOV	A6, -(3*PTRSIZE)(A4)
+	SLL	T2, A7
+	SRL	T1, A2, A0
+	OR	A0, A7
+	MOV	A7, -(4*PTRSIZE)(A4)
+	MOV	A2, T4
+	ADD	$-(4*PTRSIZE), A4
+	ADD	$-(4*PTRSIZE), T3
+	BLTU	T5, T3, b_loop_shift4
+
+	// Then a word at a time.
+b_shift1:
+	ADD	$(PTRSIZE-1), A1, T5
+	SUB	T0, T5
+	BGEU	T5, T3, b_shift_done
+b_loop_shift:
+	MOV	-PTRSIZE(T3), A5
+	SLL	T2, T4
+	SRL	T1, A5, A6
+	OR	A6, T4
+	MOV	T4, -PTRSIZE(A4)
+	MOV	A5, T4
+	ADD	$-PTRSIZE, A4
+	ADD	$-PTRSIZE, T3
+	BLTU	T5, T3, b_loop_shift
+b_shift_done:
+	ADD	T0, T3, A3
+
+	// Finish off the remaining bytes.
+b_bytes:
+	BEQ	A3, A1, done
+b_byteloop:
+	ADD	$-1, A3
+	ADD	$-1, A4
+	MOVB	(A3), T0
+	MOVB	T0, (A4)
+	BNE	A3, A1, b_byteloop
+
+done:
+	RET
@@ -38,6 +38,8 @@ func main() {
 	gen("arm64", notags, zeroARM64, copyARM64)
 	gen("ppc64x", tagsPPC64x, zeroPPC64x, copyPPC64x)
 	gen("mips64x", tagsMIPS64x, zeroMIPS64x, copyMIPS64x)
+	gen("riscv", tagsRISCV, zeroRISCV, copyRISCV)
+	gen("riscv32", tagsRISCV32, zeroRISCV32, copyRISCV32)
 }
 
 func gen(arch string, tags, zero, copy func(io.Writer)) {
@@ -216,3 +218,69 @@ func zeroMIPS64x(w io.Writer) {
 func copyMIPS64x(w io.Writer) {
 	fmt.Fprintln(w, "// TODO: Implement runtime·duffcopy.")
 }
+
+func tagsRISCV(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// +build riscv")
+	fmt.Fprintln(w)
+}
+
+// The RISC-V routines do not update their pointer registers. Instead
+// each store uses a fixed offset, and the compiler biases the pointers
+// so that the first store executed lands on the start of the memory.
+// None of these instructions has a compressed form, so every entry has
+// the same size whether or not the assembler emits RVC instructions.
+
+func zeroRISCV(w io.Writer) {
+	// ZERO: always zero
+	// T0: ptr to memory to be zeroed, minus 8 times the number of
+	// dwords skipped by jumping into the middle of duffzero
+	fmt.Fprintln(w, "TEXT runtime·duffzero(SB), NOSPLIT, $0-0")
+	for i := 0; i < 128; i++ {
+		fmt.Fprintf(w, "\tMOV\tZERO, %d(T0)\n", 8*i)
+	}
+	fmt.Fprintln(w, "\tRET")
+}
+
+func copyRISCV(w io.Writer) {
+	// T0: ptr to destination memory, biased as for duffzero
+	// T1: ptr to source memory, biased as for duffzero
+	// T2: scratch space
+	fmt.Fprintln(w, "TEXT runtime·duffcopy(SB), NOSPLIT, $0-0")
+	for i := 0; i < 128; i++ {
+		fmt.Fprintf(w, "\tMOV\t%d(T1), T2\n", 8*i)
+		fmt.Fprintf(w, "\tMOV\tT2, %d(T0)\n", 8*i)
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, "\tRET")
+}
+
+func tagsRISCV32(w io.Writer) {
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "// +build riscv32")
+	fmt.Fprintln(w)
+}
+
+func zeroRISCV32(w io.Writer) {
+	// ZERO: always zero
+	// T0: ptr to memory to be zeroed, minus 4 times the number of
+	// words skipped by jumping into the middle of duffzero
+	fmt.Fprintln(w, "TEXT runtime·duffzero(SB), NOSPLIT, $0-0")
+	for i := 0; i < 128; i++ {
+		fmt.Fprintf(w, "\tMOVW\tZERO, %d(T0)\n", 4*i)
+	}
+	fmt.Fprintln(w, "\tRET")
+}
+
+func copyRISCV32(w io.Writer) {
+	// T0: ptr to destination memory, biased as for duffzero
+	// T1: ptr to source memory, biased as for duffzero
+	// T2: scratch space
+	fmt.Fprintln(w, "TEXT runtime·duffcopy(SB), NOSPLIT, $0-0")
+	for i := 0; i < 128; i++ {
+		fmt.Fprintf(w, "\tMOVW\t%d(T1), T2\n", 4*i)
+		fmt.Fprintf(w, "\tMOVW\tT2, %d(T0)\n", 4*i)
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintln(w, "\tRET")
+}
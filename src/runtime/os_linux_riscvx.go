@@ -0,0 +1,104 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build linux
+// +build riscv riscv32
+
+package runtime
+
+import (
+	"runtime/internal/sys"
+	"unsafe"
+)
+
+type facilities struct {
+	_       [sys.CacheLineSize]byte
+	hasC    bool // compressed instructions
+	hasZba  bool // address generation
+	hasZbb  bool // basic bit manipulation
+	hasZbs  bool // single-bit instructions
+	hasZknh bool // SHA-2 hash instructions
+	_       [sys.CacheLineSize]byte
+}
+
+// cpu can be tested at runtime in go assembler code to check for
+// a certain extension, for example:
+//	  ·cpu+facilities_hasZbb(SB) for checking the availability of Zbb.
+// IndexByte and cmpbody use Zbb this way, and crypto/sha256 and
+// crypto/sha512 use Zknh through support_zknh. memmove and memclr
+// use only base instructions, so they have nothing to select.
+var cpu facilities
+
+var hwcap uintptr // set by archauxv
+
+var procCPUInfo = []byte("/proc/cpuinfo\x00")
+
+// cpuinit fills in cpu. The single-letter extensions come from
+// AT_HWCAP. Multi-letter extensions such as Zbb are not reported
+// there, so they come from the isa line in /proc/cpuinfo, which is
+// also used for the single-letter ones if AT_HWCAP is missing.
+// cpuinit reports which of the two kinds of extensions it could
+// find out about.
+func cpuinit() (letters, exts bool) {
+	letters = hwcap != 0
+	e := riscvHWCapISA(hwcap)
+	cpu.hasC = e.c
+	cpu.hasZba = e.zba
+	cpu.hasZbb = e.zbb
+	cpu.hasZbs = e.zbs
+
+	// This runs before the heap exists, so read into the stack.
+	var buf [4096]byte
+	fd := open(&procCPUInfo[0], 0 /* O_RDONLY */, 0)
+	if fd < 0 {
+		return letters, false
+	}
+	n := read(fd, noescape(unsafe.Pointer(&buf[0])), int32(len(buf)))
+	closefd(fd)
+	if n <= 0 {
+		return letters, false
+	}
+	isa := riscvCPUInfoISA(buf[:n])
+	if isa == nil {
+		return letters, false
+	}
+	e = parseRISCVISA(isa, !letters)
+	cpu.hasC = cpu.hasC || e.c
+	cpu.hasZba = cpu.hasZba || e.zba
+	cpu.hasZbb = cpu.hasZbb || e.zbb
+	cpu.hasZbs = cpu.hasZbs || e.zbs
+	cpu.hasZknh = cpu.hasZknh || e.zknh
+	return true, true
+}
+
+// checkgoriscv fills in cpu and makes sure that the CPU has the
+// extensions that the binary was built to use, as recorded by the
+// linker in goriscv. Without this check, a mismatch would show up
+// later as a SIGILL somewhere in the program.
+func checkgoriscv() {
+	letters, exts := cpuinit()
+	support_zknh = cpu.hasZknh
+	for s := goriscv; len(s) > 0; {
+		i := 0
+		for i < len(s) && s[i] != ',' {
+			i++
+		}
+		ext := s[:i]
+		if i < len(s) {
+			i++
+		}
+		s = s[i:]
+
+		switch {
+		case ext == "b" && exts && !(cpu.hasZba && cpu.hasZbb && cpu.hasZbs):
+			print("runtime: this CPU has no B extension (Zba, Zbb and Zbs), so it cannot run\n")
+		case ext == "c" && letters && !cpu.hasC:
+			print("runtime: this CPU has no C extension, so it cannot run\n")
+		default:
+			continue
+		}
+		print("this GORISCV=", goriscv, " binary. Recompile without ", ext, " in GORISCV.\n")
+		exit(1)
+	}
+}
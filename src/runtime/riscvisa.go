@@ -0,0 +1,111 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+// The RISC-V AT_HWCAP and ISA string parsing used by cpuinit on
+// linux/riscv and linux/riscv32. It is here, rather than in
+// os_linux_riscvx.go, so that it can be tested on any host.
+
+// riscvISA is the set of RISC-V extensions that the runtime looks for.
+type riscvISA struct {
+	c    bool // compressed instructions
+	zba  bool // address generation
+	zbb  bool // basic bit manipulation
+	zbs  bool // single-bit instructions
+	zknh bool // SHA-2 hash instructions
+}
+
+const (
+	// AT_HWCAP has one bit for each single-letter extension.
+	_HWCAP_ISA_B = 1 << ('b' - 'a')
+	_HWCAP_ISA_C = 1 << ('c' - 'a')
+)
+
+// riscvHWCapISA returns the extensions that hwcap, the value of
+// AT_HWCAP, reports.
+func riscvHWCapISA(hwcap uintptr) (e riscvISA) {
+	e.c = hwcap&_HWCAP_ISA_C != 0
+	if hwcap&_HWCAP_ISA_B != 0 {
+		e.zba = true
+		e.zbb = true
+		e.zbs = true
+	}
+	return
+}
+
+// riscvCPUInfoISA returns the value of the first isa line in b,
+// such as rv64imafdc_zba_zbb, or nil if there is none.
+func riscvCPUInfoISA(b []byte) []byte {
+	for len(b) > 0 {
+		i := 0
+		for i < len(b) && b[i] != '\n' {
+			i++
+		}
+		line := b[:i]
+		if i < len(b) {
+			i++
+		}
+		b = b[i:]
+
+		if len(line) < 3 || string(line[:3]) != "isa" {
+			continue
+		}
+		line = line[3:]
+		for len(line) > 0 && (line[0] == ' ' || line[0] == '\t') {
+			line = line[1:]
+		}
+		if len(line) == 0 || line[0] != ':' {
+			continue
+		}
+		line = line[1:]
+		for len(line) > 0 && line[0] == ' ' {
+			line = line[1:]
+		}
+		return line
+	}
+	return nil
+}
+
+// parseRISCVISA returns the extensions named in an ISA string like
+// rv64imafdc_zba_zbb. The single-letter extensions are only
+// included if letters is set.
+func parseRISCVISA(isa []byte, letters bool) (e riscvISA) {
+	if len(isa) < 4 || isa[0] != 'r' || isa[1] != 'v' {
+		return
+	}
+	isa = isa[4:] // rv32 or rv64
+	for len(isa) > 0 && isa[0] != '_' {
+		if letters {
+			switch isa[0] {
+			case 'b':
+				e.zba = true
+				e.zbb = true
+				e.zbs = true
+			case 'c':
+				e.c = true
+			}
+		}
+		isa = isa[1:]
+	}
+	for len(isa) > 0 {
+		isa = isa[1:] // '_'
+		i := 0
+		for i < len(isa) && isa[i] != '_' {
+			i++
+		}
+		ext := isa[:i]
+		isa = isa[i:]
+		if string(ext) == "zba" {
+			e.zba = true
+		} else if string(ext) == "zbb" {
+			e.zbb = true
+		} else if string(ext) == "zbs" {
+			e.zbs = true
+		} else if string(ext) == "zknh" {
+			e.zknh = true
+		}
+	}
+	return
+}
@@ -0,0 +1,77 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime_test
+
+import (
+	. "runtime"
+	"testing"
+)
+
+var hwcapISATests = []struct {
+	hwcap uintptr
+	exts  string
+}{
+	{0, ""},
+	{1<<('i'-'a') | 1<<('m'-'a') | 1<<('a'-'a') | 1<<('f'-'a') | 1<<('d'-'a'), ""},
+	{1 << ('c' - 'a'), "c"},
+	{1 << ('b' - 'a'), "zba,zbb,zbs"},
+	{1<<('b'-'a') | 1<<('c'-'a') | 1<<('v'-'a'), "c,zba,zbb,zbs"},
+}
+
+func TestRISCVHWCapISA(t *testing.T) {
+	for _, tt := range hwcapISATests {
+		if got := RISCVHWCapISA(tt.hwcap); got != tt.exts {
+			t.Errorf("RISCVHWCapISA(%#x) = %q, want %q", tt.hwcap, got, tt.exts)
+		}
+	}
+}
+
+var cpuInfoISATests = []struct {
+	cpuinfo string
+	isa     string
+}{
+	{"", ""},
+	{"processor\t: 0\nhart\t\t: 0\nisa\t\t: rv64imafdc\nmmu\t\t: sv39\n", "rv64imafdc"},
+	{"processor\t: 0\nisa\t\t: rv64imafdc_zba_zbb\n\nprocessor\t: 1\nisa\t\t: rv64imafdc\n", "rv64imafdc_zba_zbb"},
+	{"isa: rv32imac", "rv32imac"},
+	{"isa : rv64gc\n", "rv64gc"},
+	{"isabel\t: rv64gc\nisa\t: rv64g\n", "rv64g"},
+	{"hart isa\t: rv64gc\n", ""},
+	{"mmu\t\t: sv39\n", ""},
+}
+
+func TestRISCVCPUInfoISA(t *testing.T) {
+	for _, tt := range cpuInfoISATests {
+		if got := RISCVCPUInfoISA(tt.cpuinfo); got != tt.isa {
+			t.Errorf("RISCVCPUInfoISA(%q) = %q, want %q", tt.cpuinfo, got, tt.isa)
+		}
+	}
+}
+
+var parseISATests = []struct {
+	isa     string
+	letters bool
+	exts    string
+}{
+	{"", true, ""},
+	{"x86", true, ""},
+	{"rv64imafd", true, ""},
+	{"rv64imafdc", true, "c"},
+	{"rv64imafdc", false, ""},
+	{"rv32imacb", true, "c,zba,zbb,zbs"},
+	{"rv64imafdc_zba_zbb", true, "c,zba,zbb"},
+	{"rv64imafdc_zba_zbb", false, "zba,zbb"},
+	{"rv64imafd_zicsr_zifencei_zbs_zknh", true, "zbs,zknh"},
+	{"rv64imafd_zbbx_zknhx", true, ""},
+	{"rv64gc_", true, "c"},
+}
+
+func TestParseRISCVISA(t *testing.T) {
+	for _, tt := range parseISATests {
+		if got := ParseRISCVISA(tt.isa, tt.letters); got != tt.exts {
+			t.Errorf("ParseRISCVISA(%q, %v) = %q, want %q", tt.isa, tt.letters, got, tt.exts)
+		}
+	}
+}
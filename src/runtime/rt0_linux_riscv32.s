@@ -0,0 +1,14 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+TEXT _rt0_riscv32_linux(SB),NOSPLIT,$0
+	MOV	0(X2), A0	// argc
+	ADD	$4, X2, A1	// argv
+	JMP	main(SB)
+
+TEXT main(SB),NOSPLIT,$0
+	MOV	$runtime·rt0_go(SB), T0
+	JALR	ZERO, T0
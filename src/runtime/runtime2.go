@@ -749,8 +749,13 @@ var (
 	support_bmi1      bool
 	support_bmi2      bool
 
-	goarm                uint8 // set by cmd/link on arm systems
-	framepointer_enabled bool  // set by cmd/link
+	// Set on startup on riscv systems, for assembly outside the runtime
+	// that cannot use the offsets into cpu from go_asm.h.
+	support_zknh bool
+
+	goarm                uint8  // set by cmd/link on arm systems
+	goriscv              string // set by cmd/link on riscv systems
+	framepointer_enabled bool   // set by cmd/link
 )
 
 // Set by the linker so the runtime can determine the buildmode.
@@ -3,7 +3,7 @@
 // license that can be found in the LICENSE file.
 
 // +build linux
-// +build riscv
+// +build riscv riscv32
 
 package runtime
 
@@ -63,9 +63,9 @@ func (c *sigctxt) preparePanic(sig uint32, gp *g) {
 	// functions are correctly handled. This smashes
 	// the stack frame but we're not going back there
 	// anyway.
-	sp := c.sp() - sys.PtrSize
+	sp := c.sigsp() - sys.PtrSize
 	c.set_sp(sp)
-	*(*uint64)(unsafe.Pointer(uintptr(sp))) = c.ra()
+	*(*uintptr)(unsafe.Pointer(sp)) = c.siglr()
 
 	pc := gp.sigpc
 
@@ -81,10 +81,10 @@ func (c *sigctxt) preparePanic(sig uint32, gp *g) {
 	// probably a call to a nil func: the RA
 	// is more useful in the stack trace.
 	if pc != 0 {
-		c.set_ra(uint64(pc))
+		c.set_ra(pc)
 	}
 
 	// In case we are panicking from external C code
-	c.set_gp(uint64(uintptr(unsafe.Pointer(gp))))
-	c.set_pc(uint64(funcPC(sigpanic)))
+	c.set_s11(uintptr(unsafe.Pointer(gp)))
+	c.set_pc(funcPC(sigpanic))
 }
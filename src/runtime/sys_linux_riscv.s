@@ -202,24 +202,68 @@ TEXT runtime·mincore(SB),NOSPLIT,$-8-28
 
 // func walltime() (sec int64, nsec int32)
 TEXT runtime·walltime(SB),NOSPLIT,$24-12
+	// Be careful. We're calling a function with the C calling
+	// convention here, on the goroutine stack. The timespec lives
+	// at 8(X2), above our saved RA, and the vDSO code must fit in
+	// the space left below the stack guard. Hope that's enough!
+	MOV	runtime·__vdso_clock_gettime_sym(SB), T0
+	BEQ	T0, ZERO, fallback
 	MOV	$0, A0 // CLOCK_REALTIME
-	MOV	X2, A1
+	ADD	$8, X2, A1
+	MOV	X2, S1	// callee-saved, preserved across the call
+	AND	$~15, X2	// alignment for gcc ABI
+	JALR	RA, T0
+	MOV	S1, X2
+	MOV	8(X2), T0	// sec
+	MOV	16(X2), T1	// nsec
+	MOV	T0, sec+0(FP)
+	MOVW	T1, nsec+8(FP)
+	RET
+fallback:
+	MOV	runtime·__vdso_gettimeofday_sym(SB), T0
+	BEQ	T0, ZERO, syscall
+	ADD	$8, X2, A0
+	MOV	$0, A1
+	MOV	X2, S1	// callee-saved, preserved across the call
+	AND	$~15, X2	// alignment for gcc ABI
+	JALR	RA, T0
+	MOV	S1, X2
+	MOV	8(X2), T0	// sec
+	MOV	16(X2), T1	// usec
+	MOV	$1000, T2
+	MUL	T2, T1
+	MOV	T0, sec+0(FP)
+	MOVW	T1, nsec+8(FP)
+	RET
+syscall:
+	MOV	$0, A0 // CLOCK_REALTIME
+	ADD	$8, X2, A1
 	MOV	$SYS_clock_gettime, A7
 	ECALL
-	MOV	0(X2), T0	// sec
-	MOV	8(X2), T1	// nsec
+	MOV	8(X2), T0	// sec
+	MOV	16(X2), T1	// nsec
 	MOV	T0, sec+0(FP)
 	MOVW	T1, nsec+8(FP)
 	RET
 
 // func nanotime() int64
 TEXT runtime·nanotime(SB),NOSPLIT,$24-8
+	// See the comment in walltime about the C calling convention.
 	MOV	$1, A0 // CLOCK_MONOTONIC
-	MOV	X2, A1
+	ADD	$8, X2, A1
+	MOV	runtime·__vdso_clock_gettime_sym(SB), T0
+	BEQ	T0, ZERO, fallback
+	MOV	X2, S1	// callee-saved, preserved across the call
+	AND	$~15, X2	// alignment for gcc ABI
+	JALR	RA, T0
+	MOV	S1, X2
+	JMP	done
+fallback:
 	MOV	$SYS_clock_gettime, A7
 	ECALL
-	MOV	0(X2), T0	// sec
-	MOV	8(X2), T1	// nsec
+done:
+	MOV	8(X2), T0	// sec
+	MOV	16(X2), T1	// nsec
 	// sec is in T0, nsec in T1
 	// return nsec in T0
 	MOV	$1000000000, T2
@@ -276,9 +320,72 @@ TEXT runtime·sigtramp(SB),NOSPLIT,$24
 	RET
 
 // func cgoSigtramp()
-TEXT runtime·cgoSigtramp(SB),NOSPLIT,$0
-	MOV	$runtime·sigtramp(SB), T1
-	JALR	ZERO, T1
+TEXT runtime·cgoSigtramp(SB),NOSPLIT,$-8
+	// If no traceback function, do usual sigtramp.
+	MOV	runtime·cgoTraceback(SB), T0
+	BEQ	T0, ZERO, sigtramp
+
+	// If no traceback support function, which means that
+	// runtime/cgo was not linked in, do usual sigtramp.
+	MOV	_cgo_callers(SB), T0
+	BEQ	T0, ZERO, sigtramp
+
+	// Figure out if we are currently in a cgo call.
+	// If not, just do usual sigtramp.
+	// The signal may have interrupted C code, so g comes from the
+	// C thread-local slot rather than the g register.
+	MOV	runtime·tls_g(SB), T0
+	ADD	TP, T0
+	MOV	(T0), T0
+	BEQ	T0, ZERO, sigtrampnog	// g == nil
+	MOV	g_m(T0), T0
+	BEQ	T0, ZERO, sigtramp	// g.m == nil
+	MOVW	m_ncgo(T0), T1
+	BEQ	T1, ZERO, sigtramp	// g.m.ncgo == 0
+	MOV	m_curg(T0), T1
+	BEQ	T1, ZERO, sigtramp	// g.m.curg == nil
+	MOV	g_syscallsp(T1), T1
+	BEQ	T1, ZERO, sigtramp	// g.m.curg.syscallsp == 0
+	MOV	m_cgoCallers(T0), A4
+	BEQ	A4, ZERO, sigtramp	// g.m.cgoCallers == nil
+	MOVW	m_cgoCallersUse(T0), T1
+	BNE	T1, ZERO, sigtramp	// g.m.cgoCallersUse != 0
+
+	// Jump to a function in runtime/cgo.
+	// That function, written in C, will call the user's traceback
+	// function with proper unwind info, and will then call back here.
+	// The first three arguments, and the fifth, are already in registers.
+	// Set the two remaining arguments now.
+	MOV	runtime·cgoTraceback(SB), A3
+	MOV	$runtime·sigtramp(SB), A5
+	MOV	_cgo_callers(SB), T0
+	JALR	ZERO, T0
+
+sigtramp:
+	MOV	$runtime·sigtramp(SB), T0
+	JALR	ZERO, T0
+
+sigtrampnog:
+	// Signal arrived on a non-Go thread. If this is SIGPROF, get a
+	// stack trace.
+	MOV	$27, T1	// 27 == SIGPROF
+	BNE	A0, T1, sigtramp
+
+	// Lock sigprofCallersUse.
+	MOV	$runtime·sigprofCallersUse(SB), T0
+	MOV	$1, T1
+	WORD	$0x0e62a3af	// AMOSWAP.W.AQRL T1, (T0), T2
+	BNE	T2, ZERO, sigtramp	// Skip stack trace if already locked.
+
+	// Jump to the traceback function in runtime/cgo.
+	// It will call back to sigprofNonGo, which will ignore the
+	// arguments passed in registers.
+	// First three arguments to traceback function are in registers already.
+	MOV	runtime·cgoTraceback(SB), A3
+	MOV	$runtime·sigprofCallers(SB), A4
+	MOV	$runtime·sigprofNonGo(SB), A5
+	MOV	_cgo_callers(SB), T0
+	JALR	ZERO, T0
 
 // func mmap(addr unsafe.Pointer, n uintptr, prot, flags, fd int32, off uint32) unsafe.Pointer
 TEXT runtime·mmap(SB),NOSPLIT,$-8
@@ -0,0 +1,447 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//
+// System calls and other sys.stuff for riscv32, Linux
+//
+
+#include "textflag.h"
+#include "go_asm.h"
+
+#define AT_FDCWD -100
+
+// riscv32 has no 32-bit time_t system calls, so the runtime uses
+// the time64 variants and prlimit64. setitimer has no time64 variant
+// and remains; the runtime only uses it for small profiling intervals.
+#define SYS_clock_gettime64	403
+#define SYS_clock_nanosleep_time64	407
+#define SYS_clone		220
+#define SYS_close		57
+#define SYS_epoll_create1	20
+#define SYS_epoll_ctl		21
+#define SYS_epoll_pwait		22
+#define SYS_exit		93
+#define SYS_exit_group		94
+#define SYS_fcntl64		25
+#define SYS_futex_time64	422
+#define SYS_getpid		172
+#define SYS_gettid		178
+#define SYS_kill		129
+#define SYS_madvise		233
+#define SYS_mincore		232
+#define SYS_mmap2		222
+#define SYS_munmap		215
+#define SYS_openat		56
+#define SYS_prlimit64		261
+#define SYS_read		63
+#define SYS_rt_sigaction	134
+#define SYS_rt_sigprocmask	135
+#define SYS_sched_getaffinity	123
+#define SYS_sched_yield		124
+#define SYS_setitimer		103
+#define SYS_sigaltstack		132
+#define SYS_tkill		130
+#define SYS_write		64
+
+#define ERR_ABORT \
+	MOV	$-4096, T0 \
+	BLTU	A0, T0, 2(PC) \
+	WORD $0
+
+#define ERR_RETURN_M1(slot) \
+	MOV	$-4096, T0 \
+	BGEU	T0, A0, 2(PC) \
+	MOV	$-1, A0 \
+	MOV	A0, slot(FP)
+
+#define ERR_RETURN_ABS(slot) \
+	MOV	$-4096, T0 \
+	BGEU	T0, A0, 2(PC) \
+	SUB	A0, ZERO, A0 \
+	MOV	A0, slot(FP)
+
+// func exit(code int32)
+TEXT runtime·exit(SB),NOSPLIT,$-4-4
+	MOV	code+0(FP), A0
+	MOV	$SYS_exit_group, A7
+	ECALL
+	RET
+
+// func open(name *byte, mode, perm int32) int32
+TEXT runtime·open(SB),NOSPLIT,$-4-16
+	MOV	$AT_FDCWD, A0
+	MOV	name+0(FP), A1
+	MOV	mode+4(FP), A2
+	MOV	perm+8(FP), A3
+	MOV	$SYS_openat, A7
+	ECALL
+	ERR_RETURN_M1(ret+12)
+	RET
+
+// func closefd(fd int32) int32
+TEXT runtime·closefd(SB),NOSPLIT,$-4-8
+	MOV	fd+0(FP), A0
+	MOV	$SYS_close, A7
+	ECALL
+	ERR_RETURN_M1(ret+4)
+	RET
+
+// func write(fd uintptr, p unsafe.Pointer, n int32) int32
+TEXT runtime·write(SB),NOSPLIT,$-4-16
+	MOV	fd+0(FP), A0
+	MOV	p+4(FP), A1
+	MOV	n+8(FP), A2
+	MOV	$SYS_write, A7
+	ECALL
+	ERR_RETURN_M1(ret+12)
+	RET
+
+// func read(fd int32, p unsafe.Pointer, n int32) int32
+TEXT runtime·read(SB),NOSPLIT,$-4-16
+	MOV	fd+0(FP), A0
+	MOV	p+4(FP), A1
+	MOV	n+8(FP), A2
+	MOV	$SYS_read, A7
+	ECALL
+	ERR_RETURN_M1(ret+12)
+	RET
+
+// func getrlimit(kind int32, limit unsafe.Pointer) int32
+// limit must point to a struct rlimit64, with 64-bit fields.
+TEXT runtime·getrlimit(SB),NOSPLIT,$-4-12
+	MOV	ZERO, A0	// this process
+	MOV	kind+0(FP), A1
+	MOV	ZERO, A2
+	MOV	limit+4(FP), A3
+	MOV	$SYS_prlimit64, A7
+	ECALL
+	MOV	A0, ret+8(FP)
+	RET
+
+// func usleep(usec uint32)
+TEXT runtime·usleep(SB),NOSPLIT,$16-4
+	MOV	usec+0(FP), A0
+	MOV	$1000000, A1
+	DIVU	A1, A0, A2
+	MOV	A2, 4(X2)	// sec
+	MOV	ZERO, 8(X2)
+	REMU	A1, A0, A3
+	MOV	$1000, A1
+	MUL	A1, A3
+	MOV	A3, 12(X2)	// nsec
+	MOV	ZERO, 16(X2)
+	MOV	$1, A0	// CLOCK_MONOTONIC
+	MOV	ZERO, A1	// relative
+	ADD	$4, X2, A2
+	MOV	ZERO, A3
+	MOV	$SYS_clock_nanosleep_time64, A7
+	ECALL
+	RET
+
+// func gettid() uint32
+TEXT runtime·gettid(SB),NOSPLIT,$0-4
+	MOV	$SYS_gettid, A7
+	ECALL
+	MOV	A0, ret+0(FP)
+	RET
+
+// func raise(sig uint32)
+TEXT runtime·raise(SB),NOSPLIT,$-4
+	MOV	$SYS_gettid, A7
+	ECALL
+	// MOV	A0, A0	// arg 1 tid
+	MOV	sig+0(FP), A1	// arg 2
+	MOV	$SYS_tkill, A7
+	ECALL
+	RET
+
+// func raiseproc(sig uint32)
+TEXT runtime·raiseproc(SB),NOSPLIT,$-4
+	MOV	$SYS_getpid, A7
+	ECALL
+	// MOV	A0, A0		// arg 1 pid
+	MOV	sig+0(FP), A1	// arg 2
+	MOV	$SYS_kill, A7
+	ECALL
+	RET
+
+// func setitimer(mode int32, new, old *itimerval)
+TEXT runtime·setitimer(SB),NOSPLIT,$-4-12
+	MOV	mode+0(FP), A0
+	MOV	new+4(FP), A1
+	MOV	old+8(FP), A2
+	MOV	$SYS_setitimer, A7
+	ECALL
+	RET
+
+// func mincore(addr unsafe.Pointer, n uintptr, dst *byte) int32
+TEXT runtime·mincore(SB),NOSPLIT,$-4-16
+	MOV	addr+0(FP), A0
+	MOV	n+4(FP), A1
+	MOV	dst+8(FP), A2
+	MOV	$SYS_mincore, A7
+	ECALL
+	MOV	A0, ret+12(FP)
+	RET
+
+// func walltime() (sec int64, nsec int32)
+TEXT runtime·walltime(SB),NOSPLIT,$16-12
+	MOV	$0, A0 // CLOCK_REALTIME
+	ADD	$4, X2, A1
+	MOV	$SYS_clock_gettime64, A7
+	ECALL
+	MOV	4(X2), T0	// sec, low word
+	MOV	8(X2), T1	// sec, high word
+	MOV	12(X2), T2	// nsec
+	MOV	T0, sec_lo+0(FP)
+	MOV	T1, sec_hi+4(FP)
+	MOV	T2, nsec+8(FP)
+	RET
+
+// func nanotime() int64
+TEXT runtime·nanotime(SB),NOSPLIT,$16-8
+	MOV	$1, A0 // CLOCK_MONOTONIC
+	ADD	$4, X2, A1
+	MOV	$SYS_clock_gettime64, A7
+	ECALL
+	MOV	4(X2), T0	// sec, low word
+	MOV	12(X2), T1	// nsec
+	// Compute sec*1e9 + nsec as a 64-bit value in T3:T2.
+	MOV	$1000000000, T2
+	MOV	8(X2), T5	// sec, high word
+	MUL	T2, T5, T5
+	MULHU	T2, T0, T3
+	ADD	T5, T3
+	MUL	T2, T0, T2
+	ADD	T1, T2
+	SLTU	T1, T2, T4	// carry out of the low word
+	ADD	T4, T3
+	MOV	T2, ret_lo+0(FP)
+	MOV	T3, ret_hi+4(FP)
+	RET
+
+// func rtsigprocmask(how int32, new, old *sigset, size int32)
+TEXT runtime·rtsigprocmask(SB),NOSPLIT,$-4-16
+	MOV	how+0(FP), A0
+	MOV	new+4(FP), A1
+	MOV	old+8(FP), A2
+	MOV	size+12(FP), A3
+	MOV	$SYS_rt_sigprocmask, A7
+	ECALL
+	ERR_ABORT
+	RET
+
+// func rt_sigaction(sig uintptr, new, old *sigactiont, size uintptr) int32
+TEXT runtime·rt_sigaction(SB),NOSPLIT,$-4-20
+	MOV	sig+0(FP), A0
+	MOV	new+4(FP), A1
+	MOV	old+8(FP), A2
+	MOV	size+12(FP), A3
+	MOV	$SYS_rt_sigaction, A7
+	ECALL
+	MOV	A0, ret+16(FP)
+	RET
+
+// func sigfwd(fn uintptr, sig uint32, info *siginfo, ctx unsafe.Pointer)
+TEXT runtime·sigfwd(SB),NOSPLIT,$0-16
+	MOV	sig+4(FP), A0
+	MOV	info+8(FP), A1
+	MOV	ctx+12(FP), A2
+	MOV	fn+0(FP), T1
+	JALR	RA, T1
+	RET
+
+// func sigtramp(ureg, note unsafe.Pointer)
+TEXT runtime·sigtramp(SB),NOSPLIT,$12
+	// this might be called in external code context,
+	// where g is not set.
+	// first save A0, because runtime·load_g will clobber it
+	MOV	A0, 4(X2)
+	MOVBU	runtime·iscgo(SB), A0
+	BEQ	A0, ZERO, 2(PC)
+	CALL	runtime·load_g(SB)
+
+	MOV	A1, 8(X2)
+	MOV	A2, 12(X2)
+	MOV	$runtime·sigtrampgo(SB), A0
+	JALR	RA, A0
+	RET
+
+// func cgoSigtramp()
+TEXT runtime·cgoSigtramp(SB),NOSPLIT,$-4
+	JMP	runtime·sigtramp(SB)
+
+// func mmap(addr unsafe.Pointer, n uintptr, prot, flags, fd int32, off uint32) unsafe.Pointer
+TEXT runtime·mmap(SB),NOSPLIT,$-4
+	MOV	addr+0(FP), A0
+	MOV	n+4(FP), A1
+	MOV	prot+8(FP), A2
+	MOV	flags+12(FP), A3
+	MOV	fd+16(FP), A4
+	MOV	off+20(FP), A5
+	SRL	$12, A5	// mmap2 takes the offset in 4096-byte units
+	MOV	$SYS_mmap2, A7
+	ECALL
+	ERR_RETURN_ABS(ret+24)
+	RET
+
+// func munmap(addr unsafe.Pointer, n uintptr)
+TEXT runtime·munmap(SB),NOSPLIT,$-4
+	MOV	addr+0(FP), A0
+	MOV	n+4(FP), A1
+	MOV	$SYS_munmap, A7
+	ECALL
+	ERR_ABORT
+	RET
+
+// func madvise(addr unsafe.Pointer, n uintptr, flags int32)
+TEXT runtime·madvise(SB),NOSPLIT,$-4
+	MOV	addr+0(FP), A0
+	MOV	n+4(FP), A1
+	MOV	flags+8(FP), A2
+	MOV	$SYS_madvise, A7
+	ECALL
+	// ignore failure - maybe pages are locked
+	RET
+
+// func futex(addr unsafe.Pointer, op int32, val uint32, ts, addr2 unsafe.Pointer, val3 uint32) int32
+TEXT runtime·futex(SB),NOSPLIT,$-4
+	MOV	addr+0(FP), A0
+	MOV	op+4(FP), A1
+	MOV	val+8(FP), A2
+	MOV	ts+12(FP), A3
+	MOV	addr2+16(FP), A4
+	MOV	val3+20(FP), A5
+	MOV	$SYS_futex_time64, A7
+	ECALL
+	MOV	A0, ret+24(FP)
+	RET
+
+// func clone(flags int32, stk, mp, gp, fn unsafe.Pointer) int32
+TEXT runtime·clone(SB),NOSPLIT,$-4
+	MOV	flags+0(FP), A0
+	MOV	stk+4(FP), A1
+
+	// Copy mp, gp, fn off parent stack for use by child.
+	MOV	mp+8(FP), T0
+	MOV	gp+12(FP), T1
+	MOV	fn+16(FP), T2
+
+	MOV	T0, -4(A1)
+	MOV	T1, -8(A1)
+	MOV	T2, -12(A1)
+	MOV	$1234, T0
+	MOV	T0, -16(A1)
+
+	MOV	$SYS_clone, A7
+	ECALL
+
+	// In parent, return.
+	BEQ	ZERO, A0, child
+	MOV	ZERO, ret+20(FP)
+	RET
+child:
+
+	// In child, on new stack.
+	MOV	-16(X2), T0
+	MOV	$1234, A0
+	BEQ	A0, T0, good
+	WORD	$0	//crash
+good:
+
+	// Initialize m->procid to Linux tid
+	MOV	$SYS_gettid, A7
+	ECALL
+
+	MOV	-12(X2), T2     // fn
+	MOV	-8(X2), T1      // g
+	MOV	-4(X2), T0      // m
+
+	BEQ	ZERO, T0, nog
+	BEQ	ZERO, T1, nog
+
+	MOV	A0, m_procid(T0)
+	MOV	ZERO, (m_procid+4)(T0)
+
+	// In child, set up new stack
+	MOV	T0, g_m(T1)
+	MOV	T1, g
+
+nog:
+	// Call fn
+	JALR	RA, T2
+	WORD	$0
+
+// func sigaltstack(new, old *stackt)
+TEXT runtime·sigaltstack(SB),NOSPLIT,$-4
+	MOV	new+0(FP), A0
+	MOV	old+4(FP), A1
+	MOV	$SYS_sigaltstack, A7
+	ECALL
+	ERR_ABORT
+	RET
+
+// func osyield()
+TEXT runtime·osyield(SB),NOSPLIT,$-4
+	MOV	$SYS_sched_yield, A7
+	ECALL
+	RET
+
+// func sched_getaffinity(pid, len uintptr, buf *uintptr) int32
+TEXT runtime·sched_getaffinity(SB),NOSPLIT,$-4
+	MOV	pid+0(FP), A0
+	MOV	len+4(FP), A1
+	MOV	buf+8(FP), A2
+	MOV	$SYS_sched_getaffinity, A7
+	ECALL
+	MOV	A0, ret+12(FP)
+	RET
+
+// func epollcreate(size int32) int32
+TEXT runtime·epollcreate(SB),NOSPLIT,$-4
+	MOV	$0, A0
+	MOV	$SYS_epoll_create1, A7
+	ECALL
+	MOV	A0, ret+4(FP)
+	RET
+
+// func epollcreate1(flags int32) int32
+TEXT runtime·epollcreate1(SB),NOSPLIT,$-4
+	MOV	flags+0(FP), A0
+	MOV	$SYS_epoll_create1, A7
+	ECALL
+	MOV	A0, ret+4(FP)
+	RET
+
+// func epollctl(epfd, op, fd int32, ev *epollevent) int32
+TEXT runtime·epollctl(SB),NOSPLIT,$-4
+	MOV	epfd+0(FP), A0
+	MOV	op+4(FP), A1
+	MOV	fd+8(FP), A2
+	MOV	ev+12(FP), A3
+	MOV	$SYS_epoll_ctl, A7
+	ECALL
+	MOV	A0, ret+16(FP)
+	RET
+
+// func epollwait(epfd int32, ev *epollevent, nev, timeout int32) int32
+TEXT runtime·epollwait(SB),NOSPLIT,$-4
+	MOV	epfd+0(FP), A0
+	MOV	ev+4(FP), A1
+	MOV	nev+8(FP), A2
+	MOV	timeout+12(FP), A3
+	MOV	$0, A4
+	MOV	$SYS_epoll_pwait, A7
+	ECALL
+	MOV	A0, ret+16(FP)
+	RET
+
+// func closeonexec(int32)
+TEXT runtime·closeonexec(SB),NOSPLIT,$-4
+	MOV	fd+0(FP), A0  // fd
+	MOV	$2, A1	// F_SETFD
+	MOV	$1, A2	// FD_CLOEXEC
+	MOV	$SYS_fcntl64, A7
+	ECALL
+	RET
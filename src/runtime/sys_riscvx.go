@@ -2,11 +2,14 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// +build riscv
+// +build riscv riscv32
 
 package runtime
 
-import "unsafe"
+import (
+	"runtime/internal/sys"
+	"unsafe"
+)
 
 // adjust Gobuf as if it executed a call to fn with context ctxt
 // and then did an immediate Gosave.
@@ -14,6 +17,9 @@ func gostartcall(buf *gobuf, fn, ctxt unsafe.Pointer) {
 	if buf.lr != 0 {
 		throw("invalid use of gostartcall")
 	}
+	// buf.pc is goexit+PCQuantum. The assembler never compresses
+	// goexit's first instruction, so the next one is 4 bytes in.
+	buf.pc += 4 - sys.PCQuantum
 	buf.lr = buf.pc
 	buf.pc = uintptr(fn)
 	buf.ctxt = ctxt
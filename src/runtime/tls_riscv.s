@@ -9,10 +9,25 @@
 
 // If !iscgo, this is a no-op.
 //
-// NOTE: mcall() assumes this clobbers only R23 (REGTMP).
-// FIXME: cgo
+// NOTE: mcall() assumes this clobbers only TMP (T6).
 TEXT runtime·save_g(SB),NOSPLIT,$-8-0
+	MOVBU	runtime·iscgo(SB), TMP
+	BEQ	TMP, ZERO, nocgo
+
+	MOV	runtime·tls_g(SB), TMP
+	ADD	TP, TMP
+	MOV	g, (TMP)
+
+nocgo:
 	RET
 
+// Only called when iscgo. Clobbers TMP (T6).
 TEXT runtime·load_g(SB),NOSPLIT,$-8-0
+	MOV	runtime·tls_g(SB), TMP
+	ADD	TP, TMP
+	MOV	(TMP), g
 	RET
+
+// tls_g is the offset of the g slot from the C thread pointer (TP).
+// It is set by _cgo_init.
+GLOBL runtime·tls_g+0(SB), NOPTR, $8
@@ -0,0 +1,33 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "go_asm.h"
+#include "go_tls.h"
+#include "funcdata.h"
+#include "textflag.h"
+
+// If !iscgo, this is a no-op.
+//
+// NOTE: mcall() assumes this clobbers only TMP (T6).
+TEXT runtime·save_g(SB),NOSPLIT,$-4-0
+	MOVBU	runtime·iscgo(SB), TMP
+	BEQ	TMP, ZERO, nocgo
+
+	MOV	runtime·tls_g(SB), TMP
+	ADD	TP, TMP
+	MOV	g, (TMP)
+
+nocgo:
+	RET
+
+// Only called when iscgo. Clobbers TMP (T6).
+TEXT runtime·load_g(SB),NOSPLIT,$-4-0
+	MOV	runtime·tls_g(SB), TMP
+	ADD	TP, TMP
+	MOV	(TMP), g
+	RET
+
+// tls_g is the offset of the g slot from the C thread pointer (TP).
+// It is set by _cgo_init.
+GLOBL runtime·tls_g+0(SB), NOPTR, $4
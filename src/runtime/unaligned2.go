@@ -2,7 +2,7 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// +build arm mips mipsle mips64 mips64le
+// +build arm mips mipsle mips64 mips64le riscv32
 
 package runtime
 
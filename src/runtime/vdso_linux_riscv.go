@@ -0,0 +1,20 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+// The RISC-V vDSO first appeared in Linux 4.15.
+var vdso_linux_version = version_key{"LINUX_4.15", 0xae77f75}
+
+var sym_keys = []symbol_key{
+	{"__vdso_gettimeofday", 0x315ca59, &__vdso_gettimeofday_sym},
+	{"__vdso_clock_gettime", 0xd35ec75, &__vdso_clock_gettime_sym},
+}
+
+// There is no vsyscall page on riscv. Without a vDSO,
+// walltime and nanotime fall back to the clock_gettime syscall.
+var (
+	__vdso_gettimeofday_sym  uintptr = 0
+	__vdso_clock_gettime_sym uintptr = 0
+)
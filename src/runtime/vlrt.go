@@ -23,7 +23,7 @@
 // OUT OF OR IN CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN
 // THE SOFTWARE.
 
-// +build arm 386 mips mipsle
+// +build arm 386 mips mipsle riscv32
 
 package runtime
 
@@ -198,8 +198,8 @@ func dodiv(n, d uint64) (q, r uint64) {
 		return slowdodiv(n, d)
 	}
 
-	if GOARCH == "mips" || GOARCH == "mipsle" {
-		// No _div64by32 on mips and using only _mul64by32 doesn't bring much benefit
+	if GOARCH == "mips" || GOARCH == "mipsle" || GOARCH == "riscv32" {
+		// No _div64by32 on mips or riscv32 and using only _mul64by32 doesn't bring much benefit
 		return slowdodiv(n, d)
 	}
 
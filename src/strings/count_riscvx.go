@@ -0,0 +1,21 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build riscv riscv32
+
+package strings
+
+// Count counts the number of non-overlapping instances of sep in s.
+// If sep is an empty string, Count returns 1 + the number of Unicode code points in s.
+func Count(s, sep string) int {
+	if len(sep) == 1 {
+		return countByte(s, sep[0])
+	}
+	return countGeneric(s, sep)
+}
+
+//go:noescape
+
+// countByte returns the number of instances of c in s.
+func countByte(s string, c byte) int // ../runtime/asm_$GOARCH.s
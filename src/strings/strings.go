@@ -72,9 +72,8 @@ func hashStrRev(sep string) (uint32, uint32) {
 	return hash, pow
 }
 
-// Count counts the number of non-overlapping instances of sep in s.
-// If sep is an empty string, Count returns 1 + the number of Unicode code points in s.
-func Count(s, sep string) int {
+// countGeneric actually implements Count
+func countGeneric(s, sep string) int {
 	n := 0
 	// special cases
 	if len(sep) == 0 {
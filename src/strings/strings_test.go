@@ -1355,6 +1355,8 @@ var CountTests = []struct {
 	{"equal", "equal", 1},
 	{"abc1231231123q", "123", 3},
 	{"11111", "11", 2},
+	{"x-y-x-yyxx-xy-yy-xxxx--x", "x", 10},
+	{"x-y-x-yyxx-xy-yy-xxxx--x", "-", 8},
 }
 
 func TestCount(t *testing.T) {
@@ -0,0 +1,116 @@
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+#include "textflag.h"
+
+//
+// System calls for riscv32, Linux
+//
+
+// FIXME call entersyscall/exitsyscall once the runtime is working
+// func Syscall(trap, a1, a2, a3 uintptr) (r1, r2, err uintptr)
+TEXT ·Syscall(SB),NOSPLIT,$0-28
+	CALL	runtime·entersyscall(SB)
+	MOV	a1+4(FP), A0
+	MOV	a2+8(FP), A1
+	MOV	a3+12(FP), A2
+	MOV	$0, A3
+	MOV	$0, A4
+	MOV	$0, A5
+	MOV	$0, A6
+	MOV	trap+0(FP), A7	// syscall entry
+	ECALL
+	MOV	$-4096, T0
+	BLTU	T0, A0, err
+	MOV	A0, r1+16(FP)	// r1
+	MOV	A1, r2+20(FP)	// r2
+	MOV	ZERO, err+24(FP)	// errno
+	CALL	runtime·exitsyscall(SB)
+	RET
+err:
+	MOV	$-1, T0
+	MOV	T0, r1+16(FP)	// r1
+	MOV	ZERO, r2+20(FP)	// r2
+	SUB	A0, ZERO, A0
+	MOV	A0, err+24(FP)	// errno
+	CALL	runtime·exitsyscall(SB)
+	RET
+
+// func Syscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2, err uintptr)
+TEXT ·Syscall6(SB),NOSPLIT,$0-40
+	CALL	runtime·entersyscall(SB)
+	MOV	a1+4(FP), A0
+	MOV	a2+8(FP), A1
+	MOV	a3+12(FP), A2
+	MOV	a4+16(FP), A3
+	MOV	a5+20(FP), A4
+	MOV	a6+24(FP), A5
+	MOV	$0, A6
+	MOV	trap+0(FP), A7	// syscall entry
+	ECALL
+	MOV	$-4096, T0
+	BLTU	T0, A0, err
+	MOV	A0, r1+28(FP)	// r1
+	MOV	A1, r2+32(FP)	// r2
+	MOV	ZERO, err+36(FP)	// errno
+	CALL	runtime·exitsyscall(SB)
+	RET
+err:
+	MOV	$-1, T0
+	MOV	T0, r1+28(FP)	// r1
+	MOV	ZERO, r2+32(FP)	// r2
+	SUB	A0, ZERO, A0
+	MOV	A0, err+36(FP)	// errno
+	CALL	runtime·exitsyscall(SB)
+	RET
+
+// func RawSyscall(trap, a1, a2, a3 uintptr) (r1, r2, err uintptr)
+TEXT ·RawSyscall(SB),NOSPLIT,$0-28
+	MOV	a1+4(FP), A0
+	MOV	a2+8(FP), A1
+	MOV	a3+12(FP), A2
+	MOV	$0, A3
+	MOV	$0, A4
+	MOV	$0, A5
+	MOV	$0, A6
+	MOV	trap+0(FP), A7	// syscall entry
+	ECALL
+	MOV	$-4096, T0
+	BLTU	T0, A0, err
+	MOV	A0, r1+16(FP)	// r1
+	MOV	A1, r2+20(FP)	// r2
+	MOV	ZERO, err+24(FP)	// errno
+	RET
+err:
+	MOV	$-1, T0
+	MOV	T0, r1+16(FP)	// r1
+	MOV	ZERO, r2+20(FP)	// r2
+	SUB	A0, ZERO, A0
+	MOV	A0, err+24(FP)	// errno
+	RET
+
+// func RawSyscall6(trap, a1, a2, a3, a4, a5, a6 uintptr) (r1, r2, err uintptr)
+TEXT ·RawSyscall6(SB),NOSPLIT,$0-40
+	MOV	a1+4(FP), A0
+	MOV	a2+8(FP), A1
+	MOV	a3+12(FP), A2
+	MOV	a4+16(FP), A3
+	MOV	a5+20(FP), A4
+	MOV	a6+24(FP), A5
+	MOV	$0, A6
+	MOV	trap+0(FP), A7	// syscall entry
+	ECALL
+	MOV	$-4096, T0
+	BLTU	T0, A0, err
+	MOV	A0, r1+28(FP)	// r1
+	MOV	A1, r2+32(FP)	// r2
+	MOV	ZERO, err+36(FP)	// errno
+	RET
+err:
+	MOV	$-1, T0
+	MOV	T0, r1+28(FP)	// r1
+	MOV	ZERO, r2+32(FP)	// r2
+	SUB	A0, ZERO, A0
+	MOV	A0, err+36(FP)	// errno
+	RET
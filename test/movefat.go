@@ -0,0 +1,91 @@
+// run
+
+// Copyright 2017 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Test large copies of values that live in the outgoing argument
+// area, such as call results, which a call to memmove would
+// overwrite before reading them.
+
+package main
+
+import "fmt"
+
+type big [200]int64
+type bigger [1000]int64
+type bytes [1001]byte
+
+//go:noinline
+func mkbig(x int64) (r big) {
+	for i := range r {
+		r[i] = x + int64(i)
+	}
+	return
+}
+
+//go:noinline
+func mkbigger(x int64) (r bigger) {
+	for i := range r {
+		r[i] = x * int64(i)
+	}
+	return
+}
+
+//go:noinline
+func mkbytes(x byte) (r bytes) {
+	for i := range r {
+		r[i] = x ^ byte(i)
+	}
+	return
+}
+
+//go:noinline
+func storebig(p *big, x int64) {
+	*p = mkbig(x)
+}
+
+//go:noinline
+func storebigger(p *bigger, x int64) {
+	*p = mkbigger(x)
+}
+
+//go:noinline
+func storebytes(p *bytes, x byte) {
+	*p = mkbytes(x)
+}
+
+var gbig big
+
+func main() {
+	var b big
+	storebig(&b, 7)
+	for i, v := range b {
+		if v != 7+int64(i) {
+			panic(fmt.Sprintf("big[%d] = %d, want %d", i, v, 7+int64(i)))
+		}
+	}
+
+	gbig = mkbig(3)
+	for i, v := range gbig {
+		if v != 3+int64(i) {
+			panic(fmt.Sprintf("gbig[%d] = %d, want %d", i, v, 3+int64(i)))
+		}
+	}
+
+	p := new(bigger)
+	storebigger(p, 5)
+	for i, v := range p {
+		if v != 5*int64(i) {
+			panic(fmt.Sprintf("bigger[%d] = %d, want %d", i, v, 5*int64(i)))
+		}
+	}
+
+	q := new(bytes)
+	storebytes(q, 0x5a)
+	for i, v := range q {
+		if v != 0x5a^byte(i) {
+			panic(fmt.Sprintf("bytes[%d] = %#x, want %#x", i, v, 0x5a^byte(i)))
+		}
+	}
+}